@@ -0,0 +1,29 @@
+//go:build !stringx_debug
+
+package stringx
+
+// generation counts mutations of a String, it is empty unless built with
+// 'stringx_debug' tag, see 'stringx_debug.go'
+type generation struct{}
+
+// borrow is held by iterators and Views aliasing the payload of a String, it
+// is empty unless built with 'stringx_debug' tag
+type borrow struct{}
+
+// bump is called on each mutation of String
+func (g *generation) bump() {}
+
+func (s *String) borrow(what string) borrow {
+	return borrow{}
+}
+
+// check panics if the borrowed String is mutated since borrowed
+func (b borrow) check() {}
+
+// lend is called when payload of String escapes as a byte slice or string,
+// what names the method lending it
+func (s *String) lend(what string) {}
+
+// reclaim is called on each mutation of String, after bump and before it
+// writes
+func (s *String) reclaim() {}
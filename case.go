@@ -0,0 +1,378 @@
+package stringx
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run -C internal/gen . case ../../case_table.go
+
+// Locale selects language-specific rules of case mapping, see
+// SpecialCasing.txt in Unicode Character Database
+type Locale int
+
+const (
+	// LocaleRoot applies language-insensitive rules only
+	LocaleRoot Locale = iota
+	// LocaleTurkish maps dotted and dotless I, i.e. 'i' <-> 'İ' and 'ı' <-> 'I'
+	LocaleTurkish
+	// LocaleAzeri shares case rules with LocaleTurkish
+	LocaleAzeri
+	// LocaleLithuanian keeps the dot of 'i' and 'j' when accents are above
+	LocaleLithuanian
+)
+
+func (l Locale) turkic() bool {
+	return l == LocaleTurkish || l == LocaleAzeri
+}
+
+type caseMapping struct {
+	r rune
+	// mapping is caseRunes[off:off+n]
+	off uint16
+	n   uint8
+}
+
+// lookupCase appends mapping of r in table to dst, and reports whether r is
+// found in table
+func lookupCase(dst []rune, table []caseMapping, r rune) ([]rune, bool) {
+	lo, hi := 0, len(table)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch e := &table[m]; {
+		case r < e.r:
+			hi = m
+		case r > e.r:
+			lo = m + 1
+		default:
+			return append(dst, caseRunes[e.off:int(e.off)+int(e.n)]...), true
+		}
+	}
+	return dst, false
+}
+
+// isCased reports whether r has property Cased
+func isCased(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsLower(r) || unicode.IsTitle(r) ||
+		unicode.Is(unicode.Other_Lowercase, r) || unicode.Is(unicode.Other_Uppercase, r)
+}
+
+// isCaseIgnorable reports whether r has property Case_Ignorable
+func isCaseIgnorable(r rune) bool {
+	switch r {
+	// Word_Break=MidLetter, MidNumLet or Single_Quote
+	case '\'', '.', ':', 0x00B7, 0x0387, 0x055F, 0x05F4, 0x2018, 0x2019,
+		0x2024, 0x2027, 0xFE13, 0xFE52, 0xFE55, 0xFF07, 0xFF0E, 0xFF1A:
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk)
+}
+
+// isFinalSigma reports whether the sigma at runes[i] is at the end of a word,
+// i.e. preceded by 'Cased Case_Ignorable*', and not followed by
+// 'Case_Ignorable* Cased'
+func isFinalSigma(runes []rune, i int) bool {
+	preceded := false
+	for j := i - 1; j >= 0; j-- {
+		if isCased(runes[j]) {
+			preceded = true
+			break
+		}
+		if !isCaseIgnorable(runes[j]) {
+			break
+		}
+	}
+	if !preceded {
+		return false
+	}
+
+	for _, r := range runes[i+1:] {
+		if isCased(r) {
+			return false
+		}
+		if !isCaseIgnorable(r) {
+			break
+		}
+	}
+	return true
+}
+
+// isMoreAbove reports whether runes[i] is followed by a combining class 230
+// rune, with no starter in between
+func isMoreAbove(runes []rune, i int) bool {
+	for _, r := range runes[i+1:] {
+		switch combiningClass(r) {
+		case 0:
+			return false
+		case 230:
+			return true
+		}
+	}
+	return false
+}
+
+// isAfter reports whether runes[i] follows a rune matching f, with no rune
+// of combining class 0 or 230 in between
+func isAfter(runes []rune, i int, f func(r rune) bool) bool {
+	for j := i - 1; j >= 0; j-- {
+		if f(runes[j]) {
+			return true
+		}
+		if ccc := combiningClass(runes[j]); ccc == 0 || ccc == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+// isBeforeDot reports whether runes[i] is followed by U+0307, with no rune
+// of combining class 0 or 230 in between
+func isBeforeDot(runes []rune, i int) bool {
+	for _, r := range runes[i+1:] {
+		if r == 0x0307 {
+			return true
+		}
+		if ccc := combiningClass(r); ccc == 0 || ccc == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+func isSoftDotted(r rune) bool {
+	return unicode.Is(unicode.Soft_Dotted, r)
+}
+
+func isCapitalI(r rune) bool {
+	return r == 'I'
+}
+
+// CaseMapper maps cases of String with the rules of a Locale, the zero value
+// of CaseMapper uses LocaleRoot
+type CaseMapper struct {
+	locale Locale
+}
+
+func NewCaseMapper(locale Locale) CaseMapper {
+	return CaseMapper{locale: locale}
+}
+
+func (m CaseMapper) Locale() Locale {
+	return m.locale
+}
+
+func (m CaseMapper) upper(runes []rune) []rune {
+	mapped := make([]rune, 0, len(runes))
+	for i, r := range runes {
+		switch {
+		case m.locale.turkic() && r == 'i':
+			mapped = append(mapped, 0x0130)
+		case m.locale == LocaleLithuanian && r == 0x0307 && isAfter(runes, i, isSoftDotted):
+			// remove dot above after soft-dotted letters
+		default:
+			var ok bool
+			if mapped, ok = lookupCase(mapped, upperTable[:], r); !ok {
+				mapped = append(mapped, unicode.ToUpper(r))
+			}
+		}
+	}
+	return mapped
+}
+
+func (m CaseMapper) lower(runes []rune) []rune {
+	mapped := make([]rune, 0, len(runes))
+	for i, r := range runes {
+		switch {
+		case r == 0x03A3 && isFinalSigma(runes, i):
+			mapped = append(mapped, 0x03C2)
+		case m.locale.turkic() && r == 0x0130:
+			mapped = append(mapped, 'i')
+		case m.locale.turkic() && r == 0x0307 && isAfter(runes, i, isCapitalI):
+			// remove dot above after 'I', 'I' itself is mapped to 'i' below
+		case m.locale.turkic() && r == 'I' && !isBeforeDot(runes, i):
+			mapped = append(mapped, 0x0131)
+		case m.locale == LocaleLithuanian && (r == 'I' || r == 'J' || r == 0x012E) && isMoreAbove(runes, i):
+			mapped = append(mapped, unicode.ToLower(r), 0x0307)
+		case m.locale == LocaleLithuanian && r == 0x00CC:
+			mapped = append(mapped, 'i', 0x0307, 0x0300)
+		case m.locale == LocaleLithuanian && r == 0x00CD:
+			mapped = append(mapped, 'i', 0x0307, 0x0301)
+		case m.locale == LocaleLithuanian && r == 0x0128:
+			mapped = append(mapped, 'i', 0x0307, 0x0303)
+		default:
+			var ok bool
+			if mapped, ok = lookupCase(mapped, lowerTable[:], r); !ok {
+				mapped = append(mapped, unicode.ToLower(r))
+			}
+		}
+	}
+	return mapped
+}
+
+// foldRune appends full case folding of r to dst
+func (m CaseMapper) foldRune(dst []rune, r rune) []rune {
+	if r < utf8.RuneSelf {
+		if m.locale.turkic() && r == 'I' {
+			return append(dst, 0x0131)
+		}
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		return append(dst, r)
+	}
+
+	if m.locale.turkic() && r == 0x0130 {
+		return append(dst, 'i')
+	}
+
+	dst, ok := lookupCase(dst, foldTable[:], r)
+	if !ok {
+		dst = append(dst, unicode.ToLower(r))
+	}
+	return dst
+}
+
+func (m CaseMapper) fold(b []byte) []rune {
+	folded := make([]rune, 0, len(b))
+	for i := 0; i < len(b); {
+		r, n := utf8.DecodeRune(b[i:])
+		folded = m.foldRune(folded, r)
+		i += n
+	}
+	return folded
+}
+
+// ToUpper maps String to upper case in place, with full case mapping, e.g.
+// 'ß' is mapped to "SS"
+func (m CaseMapper) ToUpper(s *String) {
+	s.copycheck()
+	s.setRunes(m.upper(s.RuneSlice()))
+}
+
+// ToLower maps String to lower case in place, with full case mapping, and
+// final sigma is mapped to 'ς'
+func (m CaseMapper) ToLower(s *String) {
+	s.copycheck()
+	s.setRunes(m.lower(s.RuneSlice()))
+}
+
+// FoldCase applies full case folding to String in place, two strings are
+// equal without case if they are equal after folding
+func (m CaseMapper) FoldCase(s *String) {
+	s.copycheck()
+	s.setRunes(m.fold(s.payload()))
+}
+
+func (m CaseMapper) equalFold(a, b []byte) bool {
+	if isASCII(a) && isASCII(b) && !m.locale.turkic() {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := 0; i < len(a); i++ {
+			if lowerASCII(a[i]) != lowerASCII(b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return compareRunes(m.fold(a), m.fold(b)) == 0
+}
+
+func (m CaseMapper) EqualFold(s, other *String) bool {
+	return m.equalFold(s.payload(), other.payload())
+}
+
+// CompareFold compares two Strings without case, by code points of their
+// case folding
+func (m CaseMapper) CompareFold(s, other *String) int {
+	return compareRunes(m.fold(s.payload()), m.fold(other.payload()))
+}
+
+// FindFold returns the byte index of the first instance of pat in String
+// without case, or -1 if pat is not present. A match always starts and ends
+// on rune boundaries of String.
+func (m CaseMapper) FindFold(s *String, pat string) int {
+	p := m.fold(stringToBytes(pat))
+	if len(p) == 0 {
+		return 0
+	}
+
+	payload := s.payload()
+	folded := make([]rune, 0, len(p)+utf8.UTFMax)
+
+	for start := 0; start < len(payload); {
+		folded = folded[:0]
+		for i := start; i < len(payload) && len(folded) < len(p); {
+			r, n := utf8.DecodeRune(payload[i:])
+			folded = m.foldRune(folded, r)
+			i += n
+		}
+
+		if len(folded) == len(p) && compareRunes(folded, p) == 0 {
+			return start
+		}
+
+		_, n := utf8.DecodeRune(payload[start:])
+		start += n
+	}
+
+	return -1
+}
+
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+func compareRunes(a, b []rune) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// FoldCase applies full case folding to String in place, see CaseMapper.FoldCase
+func (s *String) FoldCase() {
+	CaseMapper{}.FoldCase(s)
+}
+
+// EqualFold reports whether String and other are equal under full case folding
+func (s *String) EqualFold(other *String) bool {
+	return CaseMapper{}.EqualFold(s, other)
+}
+
+func (s *String) EqualFoldString(str string) bool {
+	return CaseMapper{}.equalFold(s.payload(), stringToBytes(str))
+}
+
+func (s *String) CompareFold(other *String) int {
+	return CaseMapper{}.CompareFold(s, other)
+}
+
+func (s *String) CompareFoldString(str string) int {
+	m := CaseMapper{}
+	return compareRunes(m.fold(s.payload()), m.fold(stringToBytes(str)))
+}
+
+func (s *String) FindFold(pat string) int {
+	return CaseMapper{}.FindFold(s, pat)
+}
+
+func (s *String) ContainsFold(sub string) bool {
+	return s.FindFold(sub) >= 0
+}
@@ -0,0 +1,475 @@
+// Code generated by internal/gen from Unicode 17.0.0 data. DO NOT EDIT.
+
+package stringx
+
+// foldTable holds full case folding (status C and F of CaseFolding.txt)
+// of runes, which differs from unicode.ToLower
+var foldTable = [...]caseMapping{
+	{0x00B5, 0, 1},
+	{0x00DF, 1, 2},
+	{0x0130, 3, 2},
+	{0x0149, 5, 2},
+	{0x017F, 7, 1},
+	{0x01F0, 8, 2},
+	{0x0345, 10, 1},
+	{0x0390, 11, 3},
+	{0x03B0, 14, 3},
+	{0x03C2, 17, 1},
+	{0x03D0, 18, 1},
+	{0x03D1, 19, 1},
+	{0x03D5, 20, 1},
+	{0x03D6, 21, 1},
+	{0x03F0, 22, 1},
+	{0x03F1, 23, 1},
+	{0x03F5, 24, 1},
+	{0x0587, 25, 2},
+	{0x13A0, 27, 1},
+	{0x13A1, 28, 1},
+	{0x13A2, 29, 1},
+	{0x13A3, 30, 1},
+	{0x13A4, 31, 1},
+	{0x13A5, 32, 1},
+	{0x13A6, 33, 1},
+	{0x13A7, 34, 1},
+	{0x13A8, 35, 1},
+	{0x13A9, 36, 1},
+	{0x13AA, 37, 1},
+	{0x13AB, 38, 1},
+	{0x13AC, 39, 1},
+	{0x13AD, 40, 1},
+	{0x13AE, 41, 1},
+	{0x13AF, 42, 1},
+	{0x13B0, 43, 1},
+	{0x13B1, 44, 1},
+	{0x13B2, 45, 1},
+	{0x13B3, 46, 1},
+	{0x13B4, 47, 1},
+	{0x13B5, 48, 1},
+	{0x13B6, 49, 1},
+	{0x13B7, 50, 1},
+	{0x13B8, 51, 1},
+	{0x13B9, 52, 1},
+	{0x13BA, 53, 1},
+	{0x13BB, 54, 1},
+	{0x13BC, 55, 1},
+	{0x13BD, 56, 1},
+	{0x13BE, 57, 1},
+	{0x13BF, 58, 1},
+	{0x13C0, 59, 1},
+	{0x13C1, 60, 1},
+	{0x13C2, 61, 1},
+	{0x13C3, 62, 1},
+	{0x13C4, 63, 1},
+	{0x13C5, 64, 1},
+	{0x13C6, 65, 1},
+	{0x13C7, 66, 1},
+	{0x13C8, 67, 1},
+	{0x13C9, 68, 1},
+	{0x13CA, 69, 1},
+	{0x13CB, 70, 1},
+	{0x13CC, 71, 1},
+	{0x13CD, 72, 1},
+	{0x13CE, 73, 1},
+	{0x13CF, 74, 1},
+	{0x13D0, 75, 1},
+	{0x13D1, 76, 1},
+	{0x13D2, 77, 1},
+	{0x13D3, 78, 1},
+	{0x13D4, 79, 1},
+	{0x13D5, 80, 1},
+	{0x13D6, 81, 1},
+	{0x13D7, 82, 1},
+	{0x13D8, 83, 1},
+	{0x13D9, 84, 1},
+	{0x13DA, 85, 1},
+	{0x13DB, 86, 1},
+	{0x13DC, 87, 1},
+	{0x13DD, 88, 1},
+	{0x13DE, 89, 1},
+	{0x13DF, 90, 1},
+	{0x13E0, 91, 1},
+	{0x13E1, 92, 1},
+	{0x13E2, 93, 1},
+	{0x13E3, 94, 1},
+	{0x13E4, 95, 1},
+	{0x13E5, 96, 1},
+	{0x13E6, 97, 1},
+	{0x13E7, 98, 1},
+	{0x13E8, 99, 1},
+	{0x13E9, 100, 1},
+	{0x13EA, 101, 1},
+	{0x13EB, 102, 1},
+	{0x13EC, 103, 1},
+	{0x13ED, 104, 1},
+	{0x13EE, 105, 1},
+	{0x13EF, 106, 1},
+	{0x13F0, 107, 1},
+	{0x13F1, 108, 1},
+	{0x13F2, 109, 1},
+	{0x13F3, 110, 1},
+	{0x13F4, 111, 1},
+	{0x13F5, 112, 1},
+	{0x13F8, 107, 1},
+	{0x13F9, 108, 1},
+	{0x13FA, 109, 1},
+	{0x13FB, 110, 1},
+	{0x13FC, 111, 1},
+	{0x13FD, 112, 1},
+	{0x1C80, 113, 1},
+	{0x1C81, 114, 1},
+	{0x1C82, 115, 1},
+	{0x1C83, 116, 1},
+	{0x1C84, 117, 1},
+	{0x1C85, 117, 1},
+	{0x1C86, 118, 1},
+	{0x1C87, 119, 1},
+	{0x1C88, 120, 1},
+	{0x1E96, 121, 2},
+	{0x1E97, 123, 2},
+	{0x1E98, 125, 2},
+	{0x1E99, 127, 2},
+	{0x1E9A, 129, 2},
+	{0x1E9B, 131, 1},
+	{0x1E9E, 1, 2},
+	{0x1F50, 132, 2},
+	{0x1F52, 134, 3},
+	{0x1F54, 137, 3},
+	{0x1F56, 140, 3},
+	{0x1F80, 143, 2},
+	{0x1F81, 145, 2},
+	{0x1F82, 147, 2},
+	{0x1F83, 149, 2},
+	{0x1F84, 151, 2},
+	{0x1F85, 153, 2},
+	{0x1F86, 155, 2},
+	{0x1F87, 157, 2},
+	{0x1F88, 143, 2},
+	{0x1F89, 145, 2},
+	{0x1F8A, 147, 2},
+	{0x1F8B, 149, 2},
+	{0x1F8C, 151, 2},
+	{0x1F8D, 153, 2},
+	{0x1F8E, 155, 2},
+	{0x1F8F, 157, 2},
+	{0x1F90, 159, 2},
+	{0x1F91, 161, 2},
+	{0x1F92, 163, 2},
+	{0x1F93, 165, 2},
+	{0x1F94, 167, 2},
+	{0x1F95, 169, 2},
+	{0x1F96, 171, 2},
+	{0x1F97, 173, 2},
+	{0x1F98, 159, 2},
+	{0x1F99, 161, 2},
+	{0x1F9A, 163, 2},
+	{0x1F9B, 165, 2},
+	{0x1F9C, 167, 2},
+	{0x1F9D, 169, 2},
+	{0x1F9E, 171, 2},
+	{0x1F9F, 173, 2},
+	{0x1FA0, 175, 2},
+	{0x1FA1, 177, 2},
+	{0x1FA2, 179, 2},
+	{0x1FA3, 181, 2},
+	{0x1FA4, 183, 2},
+	{0x1FA5, 185, 2},
+	{0x1FA6, 187, 2},
+	{0x1FA7, 189, 2},
+	{0x1FA8, 175, 2},
+	{0x1FA9, 177, 2},
+	{0x1FAA, 179, 2},
+	{0x1FAB, 181, 2},
+	{0x1FAC, 183, 2},
+	{0x1FAD, 185, 2},
+	{0x1FAE, 187, 2},
+	{0x1FAF, 189, 2},
+	{0x1FB2, 191, 2},
+	{0x1FB3, 193, 2},
+	{0x1FB4, 195, 2},
+	{0x1FB6, 197, 2},
+	{0x1FB7, 199, 3},
+	{0x1FBC, 193, 2},
+	{0x1FBE, 10, 1},
+	{0x1FC2, 202, 2},
+	{0x1FC3, 204, 2},
+	{0x1FC4, 206, 2},
+	{0x1FC6, 208, 2},
+	{0x1FC7, 210, 3},
+	{0x1FCC, 204, 2},
+	{0x1FD2, 213, 3},
+	{0x1FD3, 11, 3},
+	{0x1FD6, 216, 2},
+	{0x1FD7, 218, 3},
+	{0x1FE2, 221, 3},
+	{0x1FE3, 14, 3},
+	{0x1FE4, 224, 2},
+	{0x1FE6, 226, 2},
+	{0x1FE7, 228, 3},
+	{0x1FF2, 231, 2},
+	{0x1FF3, 233, 2},
+	{0x1FF4, 235, 2},
+	{0x1FF6, 237, 2},
+	{0x1FF7, 239, 3},
+	{0x1FFC, 233, 2},
+	{0xAB70, 27, 1},
+	{0xAB71, 28, 1},
+	{0xAB72, 29, 1},
+	{0xAB73, 30, 1},
+	{0xAB74, 31, 1},
+	{0xAB75, 32, 1},
+	{0xAB76, 33, 1},
+	{0xAB77, 34, 1},
+	{0xAB78, 35, 1},
+	{0xAB79, 36, 1},
+	{0xAB7A, 37, 1},
+	{0xAB7B, 38, 1},
+	{0xAB7C, 39, 1},
+	{0xAB7D, 40, 1},
+	{0xAB7E, 41, 1},
+	{0xAB7F, 42, 1},
+	{0xAB80, 43, 1},
+	{0xAB81, 44, 1},
+	{0xAB82, 45, 1},
+	{0xAB83, 46, 1},
+	{0xAB84, 47, 1},
+	{0xAB85, 48, 1},
+	{0xAB86, 49, 1},
+	{0xAB87, 50, 1},
+	{0xAB88, 51, 1},
+	{0xAB89, 52, 1},
+	{0xAB8A, 53, 1},
+	{0xAB8B, 54, 1},
+	{0xAB8C, 55, 1},
+	{0xAB8D, 56, 1},
+	{0xAB8E, 57, 1},
+	{0xAB8F, 58, 1},
+	{0xAB90, 59, 1},
+	{0xAB91, 60, 1},
+	{0xAB92, 61, 1},
+	{0xAB93, 62, 1},
+	{0xAB94, 63, 1},
+	{0xAB95, 64, 1},
+	{0xAB96, 65, 1},
+	{0xAB97, 66, 1},
+	{0xAB98, 67, 1},
+	{0xAB99, 68, 1},
+	{0xAB9A, 69, 1},
+	{0xAB9B, 70, 1},
+	{0xAB9C, 71, 1},
+	{0xAB9D, 72, 1},
+	{0xAB9E, 73, 1},
+	{0xAB9F, 74, 1},
+	{0xABA0, 75, 1},
+	{0xABA1, 76, 1},
+	{0xABA2, 77, 1},
+	{0xABA3, 78, 1},
+	{0xABA4, 79, 1},
+	{0xABA5, 80, 1},
+	{0xABA6, 81, 1},
+	{0xABA7, 82, 1},
+	{0xABA8, 83, 1},
+	{0xABA9, 84, 1},
+	{0xABAA, 85, 1},
+	{0xABAB, 86, 1},
+	{0xABAC, 87, 1},
+	{0xABAD, 88, 1},
+	{0xABAE, 89, 1},
+	{0xABAF, 90, 1},
+	{0xABB0, 91, 1},
+	{0xABB1, 92, 1},
+	{0xABB2, 93, 1},
+	{0xABB3, 94, 1},
+	{0xABB4, 95, 1},
+	{0xABB5, 96, 1},
+	{0xABB6, 97, 1},
+	{0xABB7, 98, 1},
+	{0xABB8, 99, 1},
+	{0xABB9, 100, 1},
+	{0xABBA, 101, 1},
+	{0xABBB, 102, 1},
+	{0xABBC, 103, 1},
+	{0xABBD, 104, 1},
+	{0xABBE, 105, 1},
+	{0xABBF, 106, 1},
+	{0xFB00, 242, 2},
+	{0xFB01, 244, 2},
+	{0xFB02, 246, 2},
+	{0xFB03, 248, 3},
+	{0xFB04, 251, 3},
+	{0xFB05, 254, 2},
+	{0xFB06, 254, 2},
+	{0xFB13, 256, 2},
+	{0xFB14, 258, 2},
+	{0xFB15, 260, 2},
+	{0xFB16, 262, 2},
+	{0xFB17, 264, 2},
+}
+
+// upperTable holds full uppercase mapping of runes, which differs from
+// unicode.ToUpper, i.e. unconditional mappings of SpecialCasing.txt
+var upperTable = [...]caseMapping{
+	{0x00DF, 266, 2},
+	{0x0149, 268, 2},
+	{0x01F0, 270, 2},
+	{0x0390, 272, 3},
+	{0x03B0, 275, 3},
+	{0x0587, 278, 2},
+	{0x1E96, 280, 2},
+	{0x1E97, 282, 2},
+	{0x1E98, 284, 2},
+	{0x1E99, 286, 2},
+	{0x1E9A, 288, 2},
+	{0x1F50, 290, 2},
+	{0x1F52, 292, 3},
+	{0x1F54, 295, 3},
+	{0x1F56, 298, 3},
+	{0x1F80, 301, 2},
+	{0x1F81, 303, 2},
+	{0x1F82, 305, 2},
+	{0x1F83, 307, 2},
+	{0x1F84, 309, 2},
+	{0x1F85, 311, 2},
+	{0x1F86, 313, 2},
+	{0x1F87, 315, 2},
+	{0x1F88, 301, 2},
+	{0x1F89, 303, 2},
+	{0x1F8A, 305, 2},
+	{0x1F8B, 307, 2},
+	{0x1F8C, 309, 2},
+	{0x1F8D, 311, 2},
+	{0x1F8E, 313, 2},
+	{0x1F8F, 315, 2},
+	{0x1F90, 317, 2},
+	{0x1F91, 319, 2},
+	{0x1F92, 321, 2},
+	{0x1F93, 323, 2},
+	{0x1F94, 325, 2},
+	{0x1F95, 327, 2},
+	{0x1F96, 329, 2},
+	{0x1F97, 331, 2},
+	{0x1F98, 317, 2},
+	{0x1F99, 319, 2},
+	{0x1F9A, 321, 2},
+	{0x1F9B, 323, 2},
+	{0x1F9C, 325, 2},
+	{0x1F9D, 327, 2},
+	{0x1F9E, 329, 2},
+	{0x1F9F, 331, 2},
+	{0x1FA0, 333, 2},
+	{0x1FA1, 335, 2},
+	{0x1FA2, 337, 2},
+	{0x1FA3, 339, 2},
+	{0x1FA4, 341, 2},
+	{0x1FA5, 343, 2},
+	{0x1FA6, 345, 2},
+	{0x1FA7, 347, 2},
+	{0x1FA8, 333, 2},
+	{0x1FA9, 335, 2},
+	{0x1FAA, 337, 2},
+	{0x1FAB, 339, 2},
+	{0x1FAC, 341, 2},
+	{0x1FAD, 343, 2},
+	{0x1FAE, 345, 2},
+	{0x1FAF, 347, 2},
+	{0x1FB2, 349, 2},
+	{0x1FB3, 351, 2},
+	{0x1FB4, 353, 2},
+	{0x1FB6, 355, 2},
+	{0x1FB7, 357, 3},
+	{0x1FBC, 351, 2},
+	{0x1FC2, 360, 2},
+	{0x1FC3, 362, 2},
+	{0x1FC4, 364, 2},
+	{0x1FC6, 366, 2},
+	{0x1FC7, 368, 3},
+	{0x1FCC, 362, 2},
+	{0x1FD2, 371, 3},
+	{0x1FD3, 272, 3},
+	{0x1FD6, 374, 2},
+	{0x1FD7, 376, 3},
+	{0x1FE2, 379, 3},
+	{0x1FE3, 275, 3},
+	{0x1FE4, 382, 2},
+	{0x1FE6, 384, 2},
+	{0x1FE7, 386, 3},
+	{0x1FF2, 389, 2},
+	{0x1FF3, 391, 2},
+	{0x1FF4, 393, 2},
+	{0x1FF6, 395, 2},
+	{0x1FF7, 397, 3},
+	{0x1FFC, 391, 2},
+	{0xFB00, 400, 2},
+	{0xFB01, 402, 2},
+	{0xFB02, 404, 2},
+	{0xFB03, 406, 3},
+	{0xFB04, 409, 3},
+	{0xFB05, 412, 2},
+	{0xFB06, 412, 2},
+	{0xFB13, 414, 2},
+	{0xFB14, 416, 2},
+	{0xFB15, 418, 2},
+	{0xFB16, 420, 2},
+	{0xFB17, 422, 2},
+}
+
+// lowerTable holds full lowercase mapping of runes, which differs from
+// unicode.ToLower, i.e. unconditional mappings of SpecialCasing.txt
+var lowerTable = [...]caseMapping{
+	{0x0130, 3, 2},
+}
+
+var caseRunes = [...]rune{
+	0x03BC, 0x0073, 0x0073, 0x0069, 0x0307, 0x02BC, 0x006E, 0x0073,
+	0x006A, 0x030C, 0x03B9, 0x03B9, 0x0308, 0x0301, 0x03C5, 0x0308,
+	0x0301, 0x03C3, 0x03B2, 0x03B8, 0x03C6, 0x03C0, 0x03BA, 0x03C1,
+	0x03B5, 0x0565, 0x0582, 0x13A0, 0x13A1, 0x13A2, 0x13A3, 0x13A4,
+	0x13A5, 0x13A6, 0x13A7, 0x13A8, 0x13A9, 0x13AA, 0x13AB, 0x13AC,
+	0x13AD, 0x13AE, 0x13AF, 0x13B0, 0x13B1, 0x13B2, 0x13B3, 0x13B4,
+	0x13B5, 0x13B6, 0x13B7, 0x13B8, 0x13B9, 0x13BA, 0x13BB, 0x13BC,
+	0x13BD, 0x13BE, 0x13BF, 0x13C0, 0x13C1, 0x13C2, 0x13C3, 0x13C4,
+	0x13C5, 0x13C6, 0x13C7, 0x13C8, 0x13C9, 0x13CA, 0x13CB, 0x13CC,
+	0x13CD, 0x13CE, 0x13CF, 0x13D0, 0x13D1, 0x13D2, 0x13D3, 0x13D4,
+	0x13D5, 0x13D6, 0x13D7, 0x13D8, 0x13D9, 0x13DA, 0x13DB, 0x13DC,
+	0x13DD, 0x13DE, 0x13DF, 0x13E0, 0x13E1, 0x13E2, 0x13E3, 0x13E4,
+	0x13E5, 0x13E6, 0x13E7, 0x13E8, 0x13E9, 0x13EA, 0x13EB, 0x13EC,
+	0x13ED, 0x13EE, 0x13EF, 0x13F0, 0x13F1, 0x13F2, 0x13F3, 0x13F4,
+	0x13F5, 0x0432, 0x0434, 0x043E, 0x0441, 0x0442, 0x044A, 0x0463,
+	0xA64B, 0x0068, 0x0331, 0x0074, 0x0308, 0x0077, 0x030A, 0x0079,
+	0x030A, 0x0061, 0x02BE, 0x1E61, 0x03C5, 0x0313, 0x03C5, 0x0313,
+	0x0300, 0x03C5, 0x0313, 0x0301, 0x03C5, 0x0313, 0x0342, 0x1F00,
+	0x03B9, 0x1F01, 0x03B9, 0x1F02, 0x03B9, 0x1F03, 0x03B9, 0x1F04,
+	0x03B9, 0x1F05, 0x03B9, 0x1F06, 0x03B9, 0x1F07, 0x03B9, 0x1F20,
+	0x03B9, 0x1F21, 0x03B9, 0x1F22, 0x03B9, 0x1F23, 0x03B9, 0x1F24,
+	0x03B9, 0x1F25, 0x03B9, 0x1F26, 0x03B9, 0x1F27, 0x03B9, 0x1F60,
+	0x03B9, 0x1F61, 0x03B9, 0x1F62, 0x03B9, 0x1F63, 0x03B9, 0x1F64,
+	0x03B9, 0x1F65, 0x03B9, 0x1F66, 0x03B9, 0x1F67, 0x03B9, 0x1F70,
+	0x03B9, 0x03B1, 0x03B9, 0x03AC, 0x03B9, 0x03B1, 0x0342, 0x03B1,
+	0x0342, 0x03B9, 0x1F74, 0x03B9, 0x03B7, 0x03B9, 0x03AE, 0x03B9,
+	0x03B7, 0x0342, 0x03B7, 0x0342, 0x03B9, 0x03B9, 0x0308, 0x0300,
+	0x03B9, 0x0342, 0x03B9, 0x0308, 0x0342, 0x03C5, 0x0308, 0x0300,
+	0x03C1, 0x0313, 0x03C5, 0x0342, 0x03C5, 0x0308, 0x0342, 0x1F7C,
+	0x03B9, 0x03C9, 0x03B9, 0x03CE, 0x03B9, 0x03C9, 0x0342, 0x03C9,
+	0x0342, 0x03B9, 0x0066, 0x0066, 0x0066, 0x0069, 0x0066, 0x006C,
+	0x0066, 0x0066, 0x0069, 0x0066, 0x0066, 0x006C, 0x0073, 0x0074,
+	0x0574, 0x0576, 0x0574, 0x0565, 0x0574, 0x056B, 0x057E, 0x0576,
+	0x0574, 0x056D, 0x0053, 0x0053, 0x02BC, 0x004E, 0x004A, 0x030C,
+	0x0399, 0x0308, 0x0301, 0x03A5, 0x0308, 0x0301, 0x0535, 0x0552,
+	0x0048, 0x0331, 0x0054, 0x0308, 0x0057, 0x030A, 0x0059, 0x030A,
+	0x0041, 0x02BE, 0x03A5, 0x0313, 0x03A5, 0x0313, 0x0300, 0x03A5,
+	0x0313, 0x0301, 0x03A5, 0x0313, 0x0342, 0x1F08, 0x0399, 0x1F09,
+	0x0399, 0x1F0A, 0x0399, 0x1F0B, 0x0399, 0x1F0C, 0x0399, 0x1F0D,
+	0x0399, 0x1F0E, 0x0399, 0x1F0F, 0x0399, 0x1F28, 0x0399, 0x1F29,
+	0x0399, 0x1F2A, 0x0399, 0x1F2B, 0x0399, 0x1F2C, 0x0399, 0x1F2D,
+	0x0399, 0x1F2E, 0x0399, 0x1F2F, 0x0399, 0x1F68, 0x0399, 0x1F69,
+	0x0399, 0x1F6A, 0x0399, 0x1F6B, 0x0399, 0x1F6C, 0x0399, 0x1F6D,
+	0x0399, 0x1F6E, 0x0399, 0x1F6F, 0x0399, 0x1FBA, 0x0399, 0x0391,
+	0x0399, 0x0386, 0x0399, 0x0391, 0x0342, 0x0391, 0x0342, 0x0399,
+	0x1FCA, 0x0399, 0x0397, 0x0399, 0x0389, 0x0399, 0x0397, 0x0342,
+	0x0397, 0x0342, 0x0399, 0x0399, 0x0308, 0x0300, 0x0399, 0x0342,
+	0x0399, 0x0308, 0x0342, 0x03A5, 0x0308, 0x0300, 0x03A1, 0x0313,
+	0x03A5, 0x0342, 0x03A5, 0x0308, 0x0342, 0x1FFA, 0x0399, 0x03A9,
+	0x0399, 0x038F, 0x0399, 0x03A9, 0x0342, 0x03A9, 0x0342, 0x0399,
+	0x0046, 0x0046, 0x0046, 0x0049, 0x0046, 0x004C, 0x0046, 0x0046,
+	0x0049, 0x0046, 0x0046, 0x004C, 0x0053, 0x0054, 0x0544, 0x0546,
+	0x0544, 0x0535, 0x0544, 0x053B, 0x054E, 0x0546, 0x0544, 0x053D,
+}
@@ -0,0 +1,106 @@
+package stringx
+
+import "testing"
+
+var caseData = []struct {
+	locale       Locale
+	s            string
+	upper, lower string
+}{
+	{LocaleRoot, "straße", "STRASSE", "straße"},
+	{LocaleRoot, "ﬁnal", "FINAL", "ﬁnal"},
+	{LocaleRoot, "ΌΣΟΣ ΣΑ", "ΌΣΟΣ ΣΑ", "όσος σα"},
+	{LocaleRoot, "İstanbul", "İSTANBUL", "i̇stanbul"},
+	{LocaleRoot, "你好 World", "你好 WORLD", "你好 world"},
+	{LocaleTurkish, "istanbul", "İSTANBUL", "istanbul"},
+	{LocaleTurkish, "DİYARBAKIR", "DİYARBAKIR", "diyarbakır"},
+	{LocaleAzeri, "İ", "İ", "i"},
+	{LocaleLithuanian, "Ì", "Ì", "i̇̀"},
+	{LocaleLithuanian, "Í", "Í", "i̇́"},
+	{LocaleLithuanian, "i̇́", "Í", "i̇́"},
+}
+
+func TestCaseMapper(t *testing.T) {
+	var s String
+	for _, data := range caseData {
+		m := NewCaseMapper(data.locale)
+
+		s.FromString(data.s)
+		m.ToUpper(&s)
+		if !s.EqualToString(data.upper) {
+			t.Errorf("CaseMapper: to upper failed: locale=%d before=%+q after=%+q expect=%+q",
+				data.locale, data.s, s.String(), data.upper)
+		}
+
+		s.FromString(data.s)
+		m.ToLower(&s)
+		if !s.EqualToString(data.lower) {
+			t.Errorf("CaseMapper: to lower failed: locale=%d before=%+q after=%+q expect=%+q",
+				data.locale, data.s, s.String(), data.lower)
+		}
+	}
+}
+
+var foldData = [][]string{
+	{"Straße", "STRASSE"},
+	{"ΌΣΟΣ", "όσος"},
+	{"ﬃ", "FFI"},
+	{"Kelvin", "Kelvin"},
+	{"ᏣᎳᎩ", "ꮳꮃꭹ"},
+	{"你好World", "你好WORLD"},
+}
+
+func TestString_EqualFold(t *testing.T) {
+	var s, other String
+	for _, data := range foldData {
+		s.FromString(data[0])
+		other.FromString(data[1])
+		if !s.EqualFold(&other) || !s.EqualFoldString(data[1]) {
+			t.Errorf("String: equal fold failed: a=%s b=%s", data[0], data[1])
+		}
+		if s.CompareFold(&other) != 0 || s.CompareFoldString(data[1]) != 0 {
+			t.Errorf("String: compare fold failed: a=%s b=%s", data[0], data[1])
+		}
+
+		s.FoldCase()
+		other.FoldCase()
+		if !s.EqualTo(&other) {
+			t.Errorf("String: fold case failed: a=%s b=%s", s.String(), other.String())
+		}
+	}
+
+	s.FromString("abc")
+	if s.EqualFoldString("abd") || s.CompareFoldString("ABD") >= 0 {
+		t.Errorf("String: fold comparison reports wrong order")
+	}
+}
+
+func TestString_FindFold(t *testing.T) {
+	var s String
+	s.FromString("Die STRASSE in München")
+	for _, data := range []struct {
+		pat   string
+		index int
+	}{
+		{"straße", 4},
+		{"MÜNCHEN", 15},
+		{"in", 12},
+		{"berlin", -1},
+		{"", 0},
+	} {
+		if i := s.FindFold(data.pat); i != data.index {
+			t.Errorf("String: find fold failed: pattern=%s index=%d expect=%d",
+				data.pat, i, data.index)
+		}
+	}
+
+	if !s.ContainsFold("SSE IN") {
+		t.Errorf("String: contains fold failed")
+	}
+
+	var tr String
+	tr.FromString("KIRMIZI")
+	if NewCaseMapper(LocaleTurkish).FindFold(&tr, "kırmızı") != 0 || tr.ContainsFold("kırmızı") {
+		t.Errorf("CaseMapper: turkic fold failed")
+	}
+}
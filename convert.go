@@ -10,12 +10,17 @@ func stringToBytes(s string) []byte {
 	return []byte(s)
 }
 
+func bytesToString(b []byte) string {
+	return string(b)
+}
+
 func (s *String) toString() string {
-	if s.len == 0 {
+	payload := s.payload()
+	if len(payload) == 0 {
 		return ""
 	}
 
-	return string(s.payload())
+	return string(payload)
 }
 
 // UnsafeString in 'convert.go' is safe, it is just for preventing compile issue
@@ -0,0 +1,178 @@
+package stringx
+
+import (
+	"runtime"
+	"sync/atomic"
+	"unsafe"
+)
+
+// minSharedSize is the least payload shared by Clone, CloneInto, Index and
+// ReplaceToNew, smaller payloads are cheaper to copy
+const minSharedSize = 64
+
+// Clone, CloneInto, Index and ReplaceToNew share the buffer of String
+// instead of copying it. Strings sharing a buffer count the same sharedBuf,
+// and the buffer is only read while it is shared. The first write to any of
+// them copies the payload into a buffer of its own, see unshare, unless it
+// is the last one holding the buffer.
+type sharedBuf struct {
+	refs atomic.Int32
+}
+
+// shareRef is the reference of a String to a sharedBuf, which is released by
+// drop, or by the garbage collector once String is gone, so that dropped
+// clones don't make the others copy
+type shareRef struct {
+	buf      *sharedBuf
+	released atomic.Bool
+	// pinned is true once the buffer is lent, see pin
+	pinned atomic.Bool
+}
+
+func newShareRef(buf *sharedBuf) *shareRef {
+	ref := &shareRef{buf: buf}
+	runtime.SetFinalizer(ref, (*shareRef).release)
+	return ref
+}
+
+func (ref *shareRef) release() {
+	if ref.released.CompareAndSwap(false, true) {
+		ref.buf.refs.Add(-1)
+	}
+}
+
+// unshareable is shared of a String whose buffer mustn't be shared, like
+// mapped memory which is gone after Close
+var unshareable = new(shareRef)
+
+// lentOwned is shared of a String whose own buffer is lent, see pin, it is
+// written in place but never shared
+var lentOwned = new(shareRef)
+
+// shareable reports whether the buffer of ref may be shared by one more
+// String, nil is the ref of a buffer owned by String alone
+func (ref *shareRef) shareable() bool {
+	return ref == nil || ref != unshareable && ref != lentOwned && !ref.pinned.Load()
+}
+
+// pin is called when String lends its buffer by Bytes, UnsafeString or
+// Freeze. A lent buffer is never shared by Clone and the like, and String
+// never releases a buffer it shares already, even once it is collected, so
+// the others copy the buffer before writing it. The next write of String
+// ends the loan.
+func (s *String) pin() {
+	for {
+		ref := s.shared.Load()
+		if ref == nil {
+			// others may have shared the buffer concurrently
+			if s.shared.CompareAndSwap(nil, lentOwned) {
+				return
+			}
+			continue
+		}
+
+		if ref != unshareable && ref != lentOwned && !ref.pinned.Swap(true) {
+			runtime.SetFinalizer(ref, nil)
+		}
+		return
+	}
+}
+
+// shareInto makes target hold payload[l:r] of String, which shares the buffer
+// of String if it is large enough. Like other reads, it is safe to call
+// concurrently.
+func (s *String) shareInto(target *String, l, r int) {
+	payload := s.payload()
+	ref := s.shared.Load()
+
+	// by-value copies and uninitialized Strings don't own their buffers, and
+	// a small slice mustn't keep a large buffer alive
+	share := s.self == unsafe.Pointer(s) && r-l >= minSharedSize && 2*(r-l) >= s.cap
+	if share && ref == nil {
+		buf := new(sharedBuf)
+		buf.refs.Store(1)
+		ref = newShareRef(buf)
+		// others may have shared or lent the buffer concurrently
+		if !s.shared.CompareAndSwap(nil, ref) {
+			ref.release()
+			ref = s.shared.Load()
+		}
+	}
+
+	if !share || !ref.shareable() {
+		mem := make([]byte, r-l)
+		copy(mem, payload[l:r])
+		target.build(mem, r-l, r-l)
+		return
+	}
+	ref.buf.refs.Add(1)
+
+	// limit capacity, so target never sees bytes beyond its payload
+	target.build(payload[l:r:r], r-l, r-l)
+	target.shared.Store(newShareRef(ref.buf))
+}
+
+// unshare is called right before String writes its buffer in place, a shared
+// buffer is copied into a new buffer of capacity bytes, which reports true.
+// There is no copy if the others have released the buffer.
+func (s *String) unshare(capacity int) bool {
+	ref := s.shared.Load()
+	if ref == nil {
+		return false
+	}
+
+	if ref == lentOwned || ref != unshareable && ref.buf.refs.Load() == 1 {
+		s.drop()
+		return false
+	}
+
+	mem := make([]byte, capacity)
+	copy(mem, s.payload())
+	s.drop()
+	s.mem = mem
+	s.cap = capacity
+	return true
+}
+
+// detach is called before String overwrites its whole payload, a shared
+// buffer is released without copying, and replaced by a fresh buffer of the
+// same capacity
+func (s *String) detach() {
+	if s.shared.Load() == nil {
+		return
+	}
+
+	capacity := s.cap
+	s.drop()
+	s.mem, s.cap = nil, 0
+	if capacity > 0 {
+		s.mem = getBuffer(capacity)
+		s.cap = len(s.mem)
+	}
+}
+
+// ensure makes room for n more bytes in a buffer owned by String, it is
+// called right before String writes bytes in place
+func (s *String) ensure(n int) {
+	if s.len+n > s.cap {
+		s.grow(s.len + n - s.cap)
+	} else {
+		s.unshare(s.cap)
+	}
+}
+
+// drop releases the shared buffer without copying it, String must get a
+// new buffer before it is written again
+func (s *String) drop() {
+	if ref := s.shared.Swap(nil); ref != nil && ref != unshareable && ref != lentOwned {
+		ref.release()
+	}
+}
+
+// IsShared reports whether String shares its buffer with other Strings,
+// which happens after Clone, CloneInto, Index or ReplaceToNew, a shared
+// buffer is copied on the first write
+func (s *String) IsShared() bool {
+	ref := s.shared.Load()
+	return ref != nil && ref != unshareable && ref != lentOwned && ref.buf.refs.Load() > 1
+}
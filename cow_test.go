@@ -0,0 +1,305 @@
+package stringx
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// cowText is large enough to be shared, see minSharedSize
+var cowText = strings.Repeat("copy on write ", 8)
+
+func TestCow_Clone(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+
+	cl := s.Clone()
+	if !s.IsShared() || !cl.IsShared() || &s.payload()[0] != &cl.payload()[0] {
+		t.Fatalf("Cow: Clone doesn't share buffer")
+	}
+
+	cl.PushString("!")
+	if s.IsShared() || cl.IsShared() || !s.EqualToString(cowText) || !cl.EqualToString(cowText+"!") {
+		t.Errorf("Cow: mutate clone failed: String=%s clone=%s", s.String(), cl.String())
+	}
+
+	other := s.Clone()
+	s.ToUpper()
+	if !s.EqualToString(strings.ToUpper(cowText)) || !other.EqualToString(cowText) {
+		t.Errorf("Cow: mutate origin failed: String=%s clone=%s", s.String(), other.String())
+	}
+
+	// the last holder writes in place
+	ptr := &other.payload()[0]
+	other.TrimPrefix("copy")
+	if other.IsShared() || &other.payload()[0] != ptr || !other.EqualToString(cowText[4:]) {
+		t.Errorf("Cow: last holder copies buffer: clone=%s", other.String())
+	}
+
+	// a small payload is copied
+	var small String
+	small.FromString("small")
+	if small.Clone().IsShared() || small.IsShared() {
+		t.Errorf("Cow: Clone shares small buffer")
+	}
+}
+
+func TestCow_Bytes(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+	cl := s.Clone()
+
+	// writing through Bytes never changes clones
+	b := cl.Bytes()
+	b[0] = 'C'
+	if cl.IsShared() || !s.EqualToString(cowText) || !cl.EqualToString("C"+cowText[1:]) {
+		t.Errorf("Cow: write through Bytes changes others: String=%s clone=%s", s.String(), cl.String())
+	}
+
+	sub := s.Index(0, len(cowText)-1)
+	s.Bytes()[0] = 'c'
+	if !sub.EqualToString(cowText[:len(cowText)-1]) {
+		t.Errorf("Cow: write through Bytes changes Index: index=%s", sub.String())
+	}
+}
+
+func TestCow_BytesThenClone(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+
+	// a buffer lent by Bytes is never shared
+	p := s.Bytes()
+	cl := s.Clone()
+	p[0] = 'X'
+	if cl.IsShared() || !cl.EqualToString(cowText) || !s.EqualToString("X"+cowText[1:]) {
+		t.Errorf("Cow: write through Bytes changes later clone: String=%s clone=%s", s.String(), cl.String())
+	}
+
+	// the next write ends the loan
+	s.Push('!')
+	if !s.Clone().IsShared() {
+		t.Errorf("Cow: buffer is never shared after loan of Bytes")
+	}
+}
+
+// runFinalizers drops Strings which are unreachable, and runs their finalizers
+func runFinalizers() {
+	for i := 0; i < 4; i++ {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCow_FreezePinned(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+
+	// the frozen clone is collected, but its string is still alive
+	str := s.Clone().Freeze()
+	runFinalizers()
+
+	s.ToUpper()
+	if str != cowText || !s.EqualToString(strings.ToUpper(cowText)) {
+		t.Errorf("Cow: write of shared buffer changes frozen string: string=%s", str)
+	}
+
+	// a frozen buffer is never shared
+	var frozen String
+	frozen.FromString(cowText)
+	str = frozen.Freeze()
+	cl := frozen.Clone()
+	cl.ToUpper()
+	if cl.IsShared() || str != cowText {
+		t.Errorf("Cow: clone of frozen String shares buffer: string=%s", str)
+	}
+
+	// neither is the memory of Interned
+	interned := NewInterner(1).InternString(cowText)
+	cl = interned.ToString().Clone()
+	runFinalizers()
+	cl.ToUpper()
+	if interned.String() != cowText {
+		t.Errorf("Cow: write of clone changes Interned: interned=%s", interned.String())
+	}
+}
+
+func TestCow_NoCopy(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+	ptr := &s.payload()[0]
+
+	// nothing is written, so the shared buffer is not copied
+	cl := s.Clone()
+	s.Replace("absent", "x")
+	s.TrimPrefix("absent")
+	s.TrimSuffix("write ")
+	s.TruncateWidth(1000, "…")
+	if !s.IsShared() || &s.payload()[0] != ptr {
+		t.Errorf("Cow: no-op mutation copies shared buffer")
+	}
+
+	// Reset releases the shared buffer without copying
+	capacity := s.Capacity()
+	s.Reset()
+	if s.IsShared() || cl.IsShared() || !s.IsEmpty() || s.Capacity() < capacity || !cl.EqualToString(cowText) {
+		t.Errorf("Cow: Reset failed: capacity=%d clone=%s", s.Capacity(), cl.String())
+	}
+
+	// whole content rebuilds never touch the clone
+	s.FromString(cowText)
+	other := s.Clone()
+	s.FromString("rebuilt")
+	other.Mask('*', 0, 4)
+	if !s.EqualToString("rebuilt") || !cl.EqualToString(cowText) || other.Len() != len(cowText) {
+		t.Errorf("Cow: rebuild failed: String=%s clone=%s", s.String(), cl.String())
+	}
+
+	// growing a shared buffer copies once into the new capacity
+	grown := cl.Clone()
+	grown.PushString("!")
+	if grown.IsShared() || grown.Capacity() < len(cowText)+1 || !grown.EqualToString(cowText+"!") {
+		t.Errorf("Cow: grow of shared buffer failed: capacity=%d", grown.Capacity())
+	}
+}
+
+func TestCow_Release(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+	s.Reserve(1)
+	ptr := &s.payload()[0]
+
+	// clones dropped without mutation release the buffer once collected
+	for i := 0; i < 4; i++ {
+		_ = s.Clone().Len()
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); s.IsShared() && time.Now().Before(deadline); {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+
+	s.PushString("!")
+	if s.IsShared() || &s.payload()[0] != ptr || !s.EqualToString(cowText+"!") {
+		t.Errorf("Cow: dropped clones keep buffer shared")
+	}
+}
+
+func TestCow_IndexAndReplace(t *testing.T) {
+	var s String
+	s.FromString("hello, " + cowText)
+
+	sub := s.Index(7, s.Len())
+	if !sub.IsShared() || &sub.payload()[0] != &s.payload()[7] || sub.Capacity() != len(cowText) {
+		t.Fatalf("Cow: Index doesn't share buffer: capacity=%d", sub.Capacity())
+	}
+
+	// a small slice is copied, which never keeps the buffer alive
+	if word := s.Index(0, 5); word.IsShared() || !word.EqualToString("hello") {
+		t.Errorf("Cow: Index shares small slice: index=%s", word.String())
+	}
+
+	// pushing to a slice never overwrites bytes after it
+	s.TruncateGraphemes(5)
+	sub.PushString("!")
+	s.PushString(", gophers")
+	if !sub.EqualToString(cowText+"!") || !s.EqualToString("hello, gophers") {
+		t.Errorf("Cow: Index mutate failed: String=%s index=%s", s.String(), sub.String())
+	}
+
+	s.FromString(cowText)
+	same := s.ReplaceToNew("absent", "x")
+	if !same.IsShared() || !same.EqualToString(cowText) {
+		t.Errorf("Cow: ReplaceToNew without match doesn't share buffer")
+	}
+	if replaced := s.ReplaceToNew("copy", "read"); replaced.IsShared() || !replaced.EqualToString(strings.ReplaceAll(cowText, "copy", "read")) {
+		t.Errorf("Cow: ReplaceToNew failed: String=%s", replaced.String())
+	}
+}
+
+func TestCow_CloneInto(t *testing.T) {
+	var s, target String
+	s.FromString(cowText)
+	target.FromString("target")
+
+	s.CloneInto(&target)
+	if !target.IsShared() || !target.EqualToString(cowText) {
+		t.Fatalf("Cow: CloneInto failed: target=%s", target.String())
+	}
+
+	// cloning into itself is a no-op
+	s.CloneInto(&s)
+	s.Push('!')
+	if !s.EqualToString(cowText+"!") || !target.EqualToString(cowText) {
+		t.Errorf("Cow: CloneInto mutate failed: String=%s target=%s", s.String(), target.String())
+	}
+
+	// a frozen String shares its buffer, the clone is not frozen
+	_ = s.Freeze()
+	cl := s.Clone()
+	cl.Push('?')
+	if cl.IsFrozen() || !cl.EqualToString(cowText+"!?") || !s.EqualToString(cowText+"!") {
+		t.Errorf("Cow: clone of frozen String failed: clone=%s", cl.String())
+	}
+}
+
+func TestCow_Recycle(t *testing.T) {
+	s := NewCapacity(100)
+	s.PushString(cowText)
+	cl := s.Clone()
+
+	before := ReadPoolStats()
+	s.Recycle()
+	after := ReadPoolStats()
+	if after.RetainedBytes != before.RetainedBytes || after.Drops != before.Drops {
+		t.Errorf("Cow: Recycle pools a shared buffer: before=%+v after=%+v", before, after)
+	}
+	if cl.IsShared() || !cl.EqualToString(cowText) {
+		t.Errorf("Cow: Recycle breaks clone: clone=%s", cl.String())
+	}
+}
+
+func TestCow_Mapped(t *testing.T) {
+	m, err := OpenMapped(writeTemp(t, cowText))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cl := m.ToString().Clone()
+	sub := m.ToString().Index(0, 4)
+	_ = m.Close()
+
+	if cl.IsShared() || !cl.EqualToString(cowText) || !sub.EqualToString("copy") {
+		t.Errorf("Cow: clone of Mapped doesn't survive Close: clone=%s", cl.String())
+	}
+}
+
+func TestCow_Concurrent(t *testing.T) {
+	var s String
+	s.FromString(cowText)
+
+	// clones are taken and mutated concurrently
+	clones := make([]*String, 8)
+	var wg sync.WaitGroup
+	for i := range clones {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cl := s.Clone()
+			cl.PushString(strconv.Itoa(i))
+			clones[i] = cl
+		}(i)
+	}
+	wg.Wait()
+
+	for i, cl := range clones {
+		if !cl.EqualToString(cowText + strconv.Itoa(i)) {
+			t.Errorf("Cow: concurrent mutate failed: clone=%s", cl.String())
+		}
+	}
+	if !s.EqualToString(cowText) {
+		t.Errorf("Cow: concurrent mutate changes origin: String=%s", s.String())
+	}
+}
@@ -0,0 +1,644 @@
+package stringx
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// EditOp is the operation of an Edit
+type EditOp int
+
+const (
+	EditEqual EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "Equal"
+	case EditDelete:
+		return "Delete"
+	case EditInsert:
+		return "Insert"
+	default:
+		return "EditOp(" + strconv.Itoa(int(op)) + ")"
+	}
+}
+
+// Edit is one line of an edit script. Old and New are 0-based line indexes of
+// Line in the old and new Strings, for a deleted line New is the index of the
+// next line in the new String, and vice versa for an inserted line.
+// NoNewline is true for the last line of a String which has no terminator,
+// such a line never equals to the same line with a terminator.
+type Edit struct {
+	Op        EditOp
+	Old       int
+	New       int
+	Line      *String
+	NoNewline bool
+}
+
+// EditScript is a sequence of Edits turning one String into another
+type EditScript []Edit
+
+// collectLines returns the lines of s, line terminators are dropped just like
+// the Lines iterator does, noNewline reports whether the last line has no
+// terminator
+func collectLines(s *String) (lines []*String, noNewline bool) {
+	for it := s.Lines(); it.Next(); {
+		lines = append(lines, it.Value())
+	}
+	payload := s.payload()
+	return lines, len(payload) > 0 && payload[len(payload)-1] != '\n'
+}
+
+// Diff returns the shortest edit script turning String into other line by
+// line, which is computed with the Myers algorithm. Lines are compared
+// without their terminators, so '\r\n' equals to '\n', but a last line
+// without terminator differs from one with it, see Edit.
+func (s *String) Diff(other *String) EditScript {
+	a, aNoNewline := collectLines(s)
+	b, bNoNewline := collectLines(other)
+
+	// map lines to integers, so the algorithm compares ints rather than bytes
+	type key struct {
+		line      string
+		noNewline bool
+	}
+	ids := make(map[key]int)
+	intern := func(lines []*String, noNewline bool) []int {
+		x := make([]int, len(lines))
+		for i, line := range lines {
+			k := key{string(line.payload()), noNewline && i == len(lines)-1}
+			id, ok := ids[k]
+			if !ok {
+				id = len(ids)
+				ids[k] = id
+			}
+			x[i] = id
+		}
+		return x
+	}
+	x, y := intern(a, aNoNewline), intern(b, bNoNewline)
+
+	var prefix, suffix int
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	for suffix < len(x)-prefix && suffix < len(y)-prefix &&
+		x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	script := make(EditScript, 0, len(a)+len(b)-prefix-suffix)
+	for i := 0; i < prefix; i++ {
+		script = append(script, Edit{Op: EditEqual})
+	}
+	for _, op := range myers(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix]) {
+		script = append(script, Edit{Op: op})
+	}
+
+	script = fixIndexes(script, a, b, suffix)
+	for k, edit := range script {
+		script[k].NoNewline = edit.Op != EditInsert && aNoNewline && edit.Old == len(a)-1 ||
+			edit.Op != EditDelete && bNoNewline && edit.New == len(b)-1
+	}
+	return script
+}
+
+// fixIndexes assigns line indexes to script in order, and appends the common
+// suffix of a and b
+func fixIndexes(script EditScript, a, b []*String, suffix int) EditScript {
+	var i, j int
+	for k := range script {
+		script[k].Old, script[k].New = i, j
+		switch script[k].Op {
+		case EditEqual:
+			script[k].Line = a[i]
+			i++
+			j++
+		case EditDelete:
+			script[k].Line = a[i]
+			i++
+		case EditInsert:
+			script[k].Line = b[j]
+			j++
+		}
+	}
+
+	for ; suffix > 0; suffix-- {
+		script = append(script, Edit{Op: EditEqual, Old: i, New: j, Line: a[i]})
+		i++
+		j++
+	}
+
+	return script
+}
+
+// myers returns the operations of the shortest edit script from a to b
+func myers(a, b []int) []EditOp {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+
+	// v[off+k] is the furthest x reached on diagonal k
+	off := n + m + 1
+	v := make([]int, 2*off+1)
+
+	// trace[d] holds v[off-d-1:off+d+2] before the d-th step, which covers
+	// diagonals reachable in d-1 steps
+	var trace [][]int
+
+	var d int
+search:
+	for d = 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v[off-d-1:off+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[off+k-1] < v[off+k+1] {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	ops := make([]EditOp, 0, n+m)
+	x, y := n, m
+	for ; d >= 0; d-- {
+		prev := trace[d]
+		at := func(k int) int { return prev[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || k != d && at(k-1) < at(k+1) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		if d == 0 {
+			prevX, prevY = 0, 0
+		}
+
+		for x > prevX && y > prevY {
+			ops = append(ops, EditEqual)
+			x--
+			y--
+		}
+
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, EditInsert)
+			} else {
+				ops = append(ops, EditDelete)
+			}
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
+
+// HasChanges reports whether EditScript contains any insertion or deletion
+func (e EditScript) HasChanges() bool {
+	for _, edit := range e {
+		if edit.Op != EditEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// Unified renders EditScript in the unified diff format, with context lines
+// of unchanged lines around each hunk. An EditScript without changes renders
+// to an empty String.
+func (e EditScript) Unified(oldName, newName string, context int) *String {
+	var out String
+	out.Init()
+
+	if !e.HasChanges() {
+		return &out
+	}
+
+	if context < 0 {
+		context = 0
+	}
+
+	out.PushString("--- " + oldName + "\n")
+	out.PushString("+++ " + newName + "\n")
+
+	var floor int
+	for i := 0; i < len(e); {
+		for i < len(e) && e[i].Op == EditEqual {
+			i++
+		}
+		if i == len(e) {
+			break
+		}
+
+		start := i - context
+		if start < floor {
+			start = floor
+		}
+
+		// extend the hunk while equal runs between changes are short enough
+		end := i
+		for {
+			for end < len(e) && e[end].Op != EditEqual {
+				end++
+			}
+			run := end
+			for run < len(e) && e[run].Op == EditEqual {
+				run++
+			}
+			if run < len(e) && run-end <= 2*context {
+				end = run
+				continue
+			}
+			if end+context < run {
+				end += context
+			} else {
+				end = run
+			}
+			break
+		}
+
+		e[start:end].writeHunk(&out)
+		floor, i = end, end
+	}
+
+	return &out
+}
+
+func (e EditScript) writeHunk(out *String) {
+	var oldLines, newLines int
+	for _, edit := range e {
+		if edit.Op != EditInsert {
+			oldLines++
+		}
+		if edit.Op != EditDelete {
+			newLines++
+		}
+	}
+
+	out.PushString("@@ -" + hunkRange(e[0].Old, oldLines) +
+		" +" + hunkRange(e[0].New, newLines) + " @@\n")
+
+	for _, edit := range e {
+		switch edit.Op {
+		case EditEqual:
+			out.Push(' ')
+		case EditDelete:
+			out.Push('-')
+		case EditInsert:
+			out.Push('+')
+		}
+		out.PushBytes(edit.Line.payload())
+		out.Push('\n')
+		if edit.NoNewline {
+			out.PushString("\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats a range like GNU diff, start is 0-based, and an empty
+// range starts at the line before it
+func hunkRange(start, lines int) string {
+	switch lines {
+	case 0:
+		return strconv.Itoa(start) + ",0"
+	case 1:
+		return strconv.Itoa(start + 1)
+	default:
+		return strconv.Itoa(start+1) + "," + strconv.Itoa(lines)
+	}
+}
+
+// UnifiedDiff returns the unified diff from String to other, see
+// EditScript.Unified
+func (s *String) UnifiedDiff(other *String, oldName, newName string, context int) *String {
+	return s.Diff(other).Unified(oldName, newName, context)
+}
+
+// PatchError is returned by ApplyPatch when a hunk can't be applied
+type PatchError struct {
+	// Hunk is the 1-based index of the rejected hunk
+	Hunk int
+	// Line is the 1-based line of the old String the hunk should start at
+	Line int
+}
+
+func (e *PatchError) Error() string {
+	return fmt.Sprintf("stringx: hunk #%d at line %d rejected: context does not match", e.Hunk, e.Line)
+}
+
+type hunk struct {
+	oldStart int
+	oldLines int
+	newStart int
+	newLines int
+	// ops and text are lines of hunk body, op is one of ' ', '-' and '+'
+	ops  []byte
+	text []string
+	// noNewline marks lines followed by '\ No newline at end of file'
+	noNewline []bool
+}
+
+// parseHunkRange parses 'start[,lines]' of a hunk header
+func parseHunkRange(r string) (start, lines int, err error) {
+	lines = 1
+	if i := bytes.IndexByte(stringToBytes(r), ','); i >= 0 {
+		if lines, err = strconv.Atoi(r[i+1:]); err != nil {
+			return 0, 0, err
+		}
+		r = r[:i]
+	}
+	start, err = strconv.Atoi(r)
+	return start, lines, err
+}
+
+func parseHunkHeader(line string) (h hunk, ok bool) {
+	var fields []string
+	for _, f := range bytes.Fields(stringToBytes(line)) {
+		fields = append(fields, string(f))
+	}
+	if len(fields) < 4 || fields[0] != "@@" || fields[3] != "@@" ||
+		len(fields[1]) < 2 || fields[1][0] != '-' || len(fields[2]) < 2 || fields[2][0] != '+' {
+		return h, false
+	}
+
+	var err1, err2 error
+	h.oldStart, h.oldLines, err1 = parseHunkRange(fields[1][1:])
+	h.newStart, h.newLines, err2 = parseHunkRange(fields[2][1:])
+	return h, err1 == nil && err2 == nil && h.oldLines >= 0 && h.newLines >= 0
+}
+
+// parseUnified parses hunks of a unified diff, file headers and any other
+// text before the first hunk are ignored
+func parseUnified(patch *String) ([]hunk, error) {
+	var (
+		hunks          []hunk
+		cur            *hunk
+		oldRem, newRem int
+		lineno         int
+		// bodyEnd is the line number of the last line of hunk body
+		bodyEnd int
+	)
+
+	for it := patch.Lines(); it.Next(); {
+		line := it.Value().String()
+		lineno++
+
+		if cur == nil || oldRem == 0 && newRem == 0 {
+			// '\ No newline at end of file' of the last line of hunk
+			if cur != nil && lineno == bodyEnd+1 && len(line) > 0 && line[0] == '\\' {
+				cur.noNewline[len(cur.noNewline)-1] = true
+				continue
+			}
+			if len(line) >= 2 && line[:2] == "@@" {
+				h, ok := parseHunkHeader(line)
+				if !ok {
+					return nil, fmt.Errorf("stringx: malformed hunk header at line %d of patch: %q", lineno, line)
+				}
+				hunks = append(hunks, h)
+				cur = &hunks[len(hunks)-1]
+				oldRem, newRem = cur.oldLines, cur.newLines
+			}
+			continue
+		}
+
+		// some tools strip the only space of an empty context line
+		op, text := byte(' '), ""
+		if len(line) > 0 {
+			op, text = line[0], line[1:]
+		}
+
+		switch op {
+		case ' ':
+			oldRem--
+			newRem--
+		case '-':
+			oldRem--
+		case '+':
+			newRem--
+		case '\\':
+			// '\ No newline at end of file' of the line before
+			if n := len(cur.noNewline); n > 0 {
+				cur.noNewline[n-1] = true
+			}
+			continue
+		default:
+			return nil, fmt.Errorf("stringx: malformed hunk line at line %d of patch: %q", lineno, line)
+		}
+
+		if oldRem < 0 || newRem < 0 {
+			return nil, fmt.Errorf("stringx: hunk longer than its header at line %d of patch", lineno)
+		}
+
+		cur.ops = append(cur.ops, op)
+		cur.text = append(cur.text, text)
+		cur.noNewline = append(cur.noNewline, false)
+		bodyEnd = lineno
+	}
+
+	if cur != nil && (oldRem != 0 || newRem != 0) {
+		return nil, fmt.Errorf("stringx: unexpected end of patch in hunk #%d", len(hunks))
+	}
+
+	return hunks, nil
+}
+
+// sides returns lines of hunk before applied, after dropping up to fuzz
+// context lines from both the beginning and the end, ops[lo:hi] are the
+// lines left
+func (h *hunk) sides(fuzz int) (before []string, lo, hi int) {
+	lo, hi = 0, len(h.ops)
+	for lo < fuzz && lo < hi && h.ops[lo] == ' ' {
+		lo++
+	}
+	for len(h.ops)-hi < fuzz && hi > lo && h.ops[hi-1] == ' ' {
+		hi--
+	}
+
+	for i := lo; i < hi; i++ {
+		if h.ops[i] != '+' {
+			before = append(before, h.text[i])
+		}
+	}
+
+	return before, lo, hi
+}
+
+// patchLine is a line of String patched by ApplyPatch, eol is its terminator,
+// which is empty for the last line without a newline
+type patchLine struct {
+	text string
+	eol  string
+}
+
+// splitLines splits b into lines with their terminators, a line is compared
+// without its terminator, just like the Lines iterator returns it
+func splitLines(b []byte) []patchLine {
+	var lines []patchLine
+	for len(b) > 0 {
+		end := bytes.IndexByte(b, '\n')
+		if end < 0 {
+			end = len(b)
+		} else {
+			end++
+		}
+
+		text := b[:end]
+		eol := len(text)
+		if eol > 0 && text[eol-1] == '\n' {
+			eol--
+		}
+		if eol > 0 && text[eol-1] == '\r' {
+			eol--
+		}
+
+		lines = append(lines, patchLine{text: string(text[:eol]), eol: string(text[eol:])})
+		b = b[end:]
+	}
+	return lines
+}
+
+func linesEqual(lines []patchLine, at int, expect []string) bool {
+	if at < 0 || at+len(expect) > len(lines) {
+		return false
+	}
+	for i, line := range expect {
+		if lines[at+i].text != line {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyPatch applies a unified diff to String in place. A hunk is searched
+// around its recorded position, shifted by the offset of previous hunks, so
+// it still applies after lines are added or removed elsewhere. If it doesn't
+// match exactly, up to fuzz context lines at its beginning and end are
+// ignored, as GNU patch does. If any hunk is rejected, String is unchanged,
+// and a *PatchError is returned.
+//
+// Lines are matched without their terminators. Lines of String keep their
+// own terminators, and added lines take the terminator of the first line of
+// String, so CRLF text stays CRLF, except for an added line followed by
+// '\ No newline at end of file' in patch.
+func (s *String) ApplyPatch(patch *String, fuzz int) error {
+	s.copycheck()
+
+	hunks, err := parseUnified(patch)
+	if err != nil {
+		return err
+	}
+
+	lines := splitLines(s.payload())
+	result := make([]patchLine, 0, len(lines))
+
+	eol := "\n"
+	if len(lines) > 0 && lines[0].eol != "" {
+		eol = lines[0].eol
+	}
+
+	// floor is the first line not consumed by previous hunks, and offset is
+	// how far previous hunks were shifted from their recorded position
+	var floor, offset int
+
+	for i := range hunks {
+		h := &hunks[i]
+
+		expect := h.oldStart - 1 + offset
+		if h.oldLines == 0 {
+			// an empty range starts at the line before it
+			expect++
+		}
+
+		var (
+			at, want int
+			lo, hi   int
+			before   []string
+		)
+		at = -1
+	search:
+		for f := 0; f <= fuzz; f++ {
+			before, lo, hi = h.sides(f)
+			want = expect + lo
+
+			for d := 0; want-d >= floor || want+d+len(before) <= len(lines); d++ {
+				if linesEqual(lines, want-d, before) && want-d >= floor {
+					at = want - d
+					break search
+				}
+				if d > 0 && linesEqual(lines, want+d, before) && want+d >= floor {
+					at = want + d
+					break search
+				}
+			}
+		}
+
+		if at < 0 {
+			return &PatchError{Hunk: i + 1, Line: h.oldStart}
+		}
+
+		result = append(result, lines[floor:at]...)
+
+		// context lines are taken from String with their terminators
+		k := at
+		for j := lo; j < hi; j++ {
+			switch h.ops[j] {
+			case ' ':
+				result = append(result, lines[k])
+				k++
+			case '-':
+				k++
+			case '+':
+				line := patchLine{text: h.text[j], eol: eol}
+				if h.noNewline[j] {
+					line.eol = ""
+				}
+				result = append(result, line)
+			}
+		}
+
+		offset += at - want
+		floor = at + len(before)
+	}
+
+	result = append(result, lines[floor:]...)
+
+	s.Reset()
+	for i, line := range result {
+		s.PushString(line.text)
+		// a line without newline is only the last one
+		if line.eol == "" && i < len(result)-1 {
+			line.eol = eol
+		}
+		s.PushString(line.eol)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package stringx
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestString_UnifiedDiff(t *testing.T) {
+	var a, b String
+	a.FromString("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n")
+	b.FromString("a\nB\nc\nd\ne\nf\ng\nh\nj\nk\n")
+
+	expect := "--- old\n+++ new\n" +
+		"@@ -1,4 +1,4 @@\n a\n-b\n+B\n c\n d\n" +
+		"@@ -7,4 +7,4 @@\n g\n h\n-i\n j\n+k\n"
+	if diff := a.UnifiedDiff(&b, "old", "new", 2); !diff.EqualToString(expect) {
+		t.Errorf("String: unified diff failed: diff=%q expect=%q", diff.String(), expect)
+	}
+
+	// hunks are merged when the unchanged lines between them are few
+	expect = "--- old\n+++ new\n" +
+		"@@ -1,10 +1,10 @@\n a\n-b\n+B\n c\n d\n e\n f\n g\n h\n-i\n j\n+k\n"
+	if diff := a.UnifiedDiff(&b, "old", "new", 3); !diff.EqualToString(expect) {
+		t.Errorf("String: unified diff failed: diff=%q expect=%q", diff.String(), expect)
+	}
+
+	a.FromString("")
+	b.FromString("x\ny\n")
+	expect = "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if diff := a.UnifiedDiff(&b, "old", "new", 3); !diff.EqualToString(expect) {
+		t.Errorf("String: unified diff failed: diff=%q expect=%q", diff.String(), expect)
+	}
+
+	// a last line without newline differs from the same line with it
+	a.FromString("a\nb")
+	b.FromString("a\nb\n")
+	expect = "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"
+	if diff := a.UnifiedDiff(&b, "old", "new", 3); !diff.EqualToString(expect) {
+		t.Errorf("String: unified diff failed: diff=%q expect=%q", diff.String(), expect)
+	}
+
+	if diff := b.UnifiedDiff(&b, "old", "new", 3); !diff.IsEmpty() {
+		t.Errorf("String: unified diff of equal Strings is not empty: diff=%q", diff.String())
+	}
+}
+
+func randomLines(n int) string {
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteString(string(rune('a' + rand.Intn(4))))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func TestString_Diff(t *testing.T) {
+	var a, b String
+	for i := 0; i < 300; i++ {
+		x, y := randomLines(rand.Intn(12)), randomLines(rand.Intn(12))
+		a.FromString(x)
+		b.FromString(y)
+
+		script := a.Diff(&b)
+
+		var before, after, changes []string
+		for _, edit := range script {
+			if edit.Op != EditInsert {
+				if edit.Old != len(before) {
+					t.Fatalf("String: diff old index failed: old=%q new=%q edit=%+v", x, y, edit)
+				}
+				before = append(before, edit.Line.String())
+			}
+			if edit.Op != EditDelete {
+				if edit.New != len(after) {
+					t.Fatalf("String: diff new index failed: old=%q new=%q edit=%+v", x, y, edit)
+				}
+				after = append(after, edit.Line.String())
+			}
+			if edit.Op != EditEqual {
+				changes = append(changes, edit.Op.String())
+			}
+		}
+
+		if got := strings.Join(before, ""); got != strings.ReplaceAll(x, "\n", "") {
+			t.Fatalf("String: diff doesn't cover old: old=%q new=%q", x, y)
+		}
+		if got := strings.Join(after, ""); got != strings.ReplaceAll(y, "\n", "") {
+			t.Fatalf("String: diff doesn't cover new: old=%q new=%q", x, y)
+		}
+
+		// the shortest edit script keeps a longest common subsequence
+		xs, ys := []rune(strings.ReplaceAll(x, "\n", "")), []rune(strings.ReplaceAll(y, "\n", ""))
+		if expect := len(xs) + len(ys) - 2*len(lcs(xs, ys)); len(changes) != expect {
+			t.Fatalf("String: diff is not the shortest: old=%q new=%q changes=%d expect=%d",
+				x, y, len(changes), expect)
+		}
+
+		patch := a.UnifiedDiff(&b, "a", "b", rand.Intn(4))
+		if err := a.ApplyPatch(patch, 0); err != nil {
+			t.Fatalf("String: apply patch failed: old=%q new=%q patch=%q err=%v", x, y, patch.String(), err)
+		}
+		if !a.EqualToString(y) && !(y == "" && a.IsEmpty()) {
+			t.Fatalf("String: apply patch failed: old=%q new=%q after=%q", x, y, a.String())
+		}
+	}
+
+	// missing final newlines round trip through UnifiedDiff and ApplyPatch
+	for _, pair := range [][2]string{
+		{"\nd\n\nc\nd", "c\nb\n\nc\n\n"},
+		{"a\nb", "a\nb\n"},
+		{"a\nb\n", "a\nc"},
+		{"x", ""},
+		{"", "x"},
+	} {
+		a.FromString(pair[0])
+		b.FromString(pair[1])
+		patch := a.UnifiedDiff(&b, "a", "b", 3)
+		if err := a.ApplyPatch(patch, 0); err != nil || !a.EqualToString(pair[1]) {
+			t.Errorf("String: apply patch failed: old=%q new=%q patch=%q after=%q err=%v",
+				pair[0], pair[1], patch.String(), a.String(), err)
+		}
+	}
+}
+
+func TestString_ApplyPatch(t *testing.T) {
+	var s, patch String
+
+	patch.FromString("--- old\n+++ new\n" +
+		"@@ -2,3 +2,3 @@\n b\n-c\n+C\n d\n" +
+		"@@ -8,3 +8,4 @@\n h\n i\n+I\n j\n")
+
+	// lines added before hunks shift them
+	s.FromString("0\n1\na\nb\nc\nd\ne\nf\ng\nh\ni\nj\n")
+	if err := s.ApplyPatch(&patch, 0); err != nil {
+		t.Fatalf("String: apply patch with offset failed: err=%v", err)
+	}
+	if expect := "0\n1\na\nb\nC\nd\ne\nf\ng\nh\ni\nI\nj\n"; !s.EqualToString(expect) {
+		t.Errorf("String: apply patch with offset failed: after=%q expect=%q", s.String(), expect)
+	}
+
+	// changed context lines need fuzz
+	before := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj"
+	s.FromString(before)
+	err := s.ApplyPatch(&patch, 0)
+	var patchErr *PatchError
+	if !errors.As(err, &patchErr) || patchErr.Hunk != 1 || patchErr.Line != 2 {
+		t.Fatalf("String: apply patch accepts mismatched context: err=%v", err)
+	}
+	if !s.EqualToString(before) {
+		t.Errorf("String: rejected patch changes String: after=%q", s.String())
+	}
+
+	if err := s.ApplyPatch(&patch, 1); err != nil {
+		t.Fatalf("String: apply patch with fuzz failed: err=%v", err)
+	}
+	if expect := "a\nB\nC\nd\ne\nf\ng\nh\ni\nI\nj"; !s.EqualToString(expect) {
+		t.Errorf("String: apply patch with fuzz failed: after=%q expect=%q", s.String(), expect)
+	}
+
+	// the changed line itself never matches
+	s.FromString("a\nb\nX\nd\n")
+	if err := s.ApplyPatch(&patch, 2); err == nil {
+		t.Errorf("String: apply patch accepts mismatched change: after=%q", s.String())
+	}
+
+	// line terminators are kept, added lines take the CRLF of String
+	patch.FromString("--- old\n+++ new\n@@ -1,3 +1,4 @@\n a\n-b\n+B\n+b2\n c\n")
+	s.FromString("a\r\nb\r\nc\nd")
+	if err := s.ApplyPatch(&patch, 0); err != nil {
+		t.Fatalf("String: apply patch to CRLF failed: err=%v", err)
+	}
+	if expect := "a\r\nB\r\nb2\r\nc\nd"; !s.EqualToString(expect) {
+		t.Errorf("String: apply patch to CRLF failed: after=%q expect=%q", s.String(), expect)
+	}
+
+	// added lines end with a newline, unless the patch says otherwise
+	patch.FromString("@@ -2 +2,2 @@\n d\n+e\n\\ No newline at end of file\n")
+	if err := s.ApplyPatch(&patch, 0); err != nil || !s.EqualToString("a\r\nB\r\nb2\r\nc\nd\r\ne") {
+		t.Errorf("String: apply patch without final newline failed: after=%q err=%v", s.String(), err)
+	}
+	patch.FromString("@@ -6 +6 @@\n-e\n\\ No newline at end of file\n+e\n")
+	if err := s.ApplyPatch(&patch, 0); err != nil || !s.EqualToString("a\r\nB\r\nb2\r\nc\nd\r\ne\r\n") {
+		t.Errorf("String: apply patch adding final newline failed: after=%q err=%v", s.String(), err)
+	}
+	patch.FromString("@@ -1,2 +1,2 @@\n-a\n+A\n B\n\\ No newline at end of file\n")
+	s.FromString("a\nB")
+	if err := s.ApplyPatch(&patch, 0); err != nil || !s.EqualToString("A\nB") {
+		t.Errorf("String: apply patch before last line failed: after=%q err=%v", s.String(), err)
+	}
+
+	for _, bad := range []string{
+		"@@ -1,2 +1,2 @@\n a\n",
+		"@@ -1,2 +1 @@\n a\n+b\n",
+		"@@ -x +1 @@\n",
+		"@@ -1 +1 @@\n*a\n",
+	} {
+		patch.FromString(bad)
+		if err := s.ApplyPatch(&patch, 0); err == nil {
+			t.Errorf("String: apply patch accepts malformed patch: patch=%q", bad)
+		}
+	}
+}
@@ -15,12 +15,14 @@ type Iterator[T any] interface {
 var _ Iterator[*String] = (*Lines)(nil)
 
 type Lines struct {
+	b   borrow
 	mem []byte
 	idx int
 	val *String
 }
 
 func (l *Lines) Next() (hasNext bool) {
+	l.b.check()
 	hasNext = l.idx < len(l.mem)
 	l.val = l.value()
 	return hasNext
@@ -57,12 +59,14 @@ func (l *Lines) Value() *String {
 var _ Iterator[rune] = (*Runes)(nil)
 
 type Runes struct {
+	b   borrow
 	mem []byte
 	idx int
 	val rune
 }
 
 func (r *Runes) Next() (hasNext bool) {
+	r.b.check()
 	hasNext = r.idx < len(r.mem)
 	r.val = r.value()
 	return hasNext
@@ -115,6 +119,7 @@ type ReverseRunes struct {
 }
 
 func (r *ReverseRunes) Next() (hasNext bool) {
+	r.runes.b.check()
 	hasNext = r.runes.idx < r.last
 	r.val = r.value()
 	return hasNext
@@ -149,6 +154,7 @@ func (r *ReverseRunes) Consume() []rune {
 var _ Iterator[*String] = (*Split)(nil)
 
 type Split struct {
+	b   borrow
 	mem []byte
 	idx int
 	sep []byte
@@ -156,6 +162,7 @@ type Split struct {
 }
 
 func (s *Split) Next() (hasNext bool) {
+	s.b.check()
 	hasNext = s.idx < len(s.mem)
 	s.val = s.value()
 	return hasNext
@@ -246,8 +253,10 @@ var _ Initializer[*String] = (*String)(nil)
 type StringInitializer string
 
 func (str StringInitializer) Initialize(s *String) {
+	s.mutable()
+	s.detach()
 	if s.cap < len(str) {
-		s.grow(len(str))
+		s.grow(len(str) - s.cap)
 	}
 
 	copy(s.mem[0:], str)
@@ -257,8 +266,10 @@ func (str StringInitializer) Initialize(s *String) {
 type BytesInitializer []byte
 
 func (b BytesInitializer) Initialize(s *String) {
+	s.mutable()
+	s.detach()
 	if s.cap < len(b) {
-		s.grow(len(b))
+		s.grow(len(b) - s.cap)
 	}
 
 	copy(s.mem[0:], b)
@@ -268,9 +279,11 @@ func (b BytesInitializer) Initialize(s *String) {
 type RunesInitializer []rune
 
 func (r RunesInitializer) Initialize(s *String) {
+	s.mutable()
+	s.detach()
 	l := len(r) * utf8.UTFMax
 	if s.cap < l {
-		s.grow(l)
+		s.grow(l - s.cap)
 	}
 
 	var n int
@@ -0,0 +1,199 @@
+package stringx
+
+import "unicode/utf8"
+
+// GapBuffer is an editing mode of String for cursor-local edits. Payload is
+// stored in one buffer with a gap at the cursor, inserting or deleting at the
+// cursor only touches the gap, and moving the cursor by d bytes moves d bytes
+// of payload. Batched edits near the cursor cost amortized O(1).
+//
+// The zero value of GapBuffer is an empty buffer with cursor at 0.
+type GapBuffer struct {
+	nocopy nocopy
+
+	mem []byte
+
+	// gap is mem[start:end], cursor is always at start
+	start int
+	end   int
+}
+
+// GapBuffer converts String into a GapBuffer with cursor at the end of payload
+func (s *String) GapBuffer() *GapBuffer {
+	var g GapBuffer
+	return g.FromBytes(s.payload())
+}
+
+func (g *GapBuffer) FromString(in string) *GapBuffer {
+	g.mem = make([]byte, len(in), 2*len(in)+16)
+	copy(g.mem, in)
+	g.mem = g.mem[:cap(g.mem)]
+	g.start, g.end = len(in), len(g.mem)
+	return g
+}
+
+func (g *GapBuffer) FromBytes(in []byte) *GapBuffer {
+	g.mem = make([]byte, len(in), 2*len(in)+16)
+	copy(g.mem, in)
+	g.mem = g.mem[:cap(g.mem)]
+	g.start, g.end = len(in), len(g.mem)
+	return g
+}
+
+func (g *GapBuffer) gap() int {
+	return g.end - g.start
+}
+
+// reserve makes sure gap has room for at least n bytes
+func (g *GapBuffer) reserve(n int) {
+	if g.gap() >= n {
+		return
+	}
+
+	size := 2 * len(g.mem)
+	if need := len(g.mem) - g.gap() + n; size < need {
+		size = need
+	}
+
+	mem := make([]byte, size)
+	copy(mem, g.mem[:g.start])
+	end := size - (len(g.mem) - g.end)
+	copy(mem[end:], g.mem[g.end:])
+
+	g.mem = mem
+	g.end = end
+}
+
+// Cursor returns byte offset of the cursor in payload
+func (g *GapBuffer) Cursor() int {
+	return g.start
+}
+
+// MoveTo moves cursor to byte offset i, it costs O(|i - Cursor()|)
+func (g *GapBuffer) MoveTo(i int) {
+	if i < 0 || i > g.Length() {
+		panic("GapBuffer.MoveTo: index out of range")
+	}
+
+	if i < g.start {
+		n := g.start - i
+		copy(g.mem[g.end-n:g.end], g.mem[i:g.start])
+		g.start -= n
+		g.end -= n
+	} else if i > g.start {
+		n := i - g.start
+		copy(g.mem[g.start:g.start+n], g.mem[g.end:g.end+n])
+		g.start += n
+		g.end += n
+	}
+}
+
+// MoveBy moves cursor by n bytes, backward if n is negative
+func (g *GapBuffer) MoveBy(n int) {
+	g.MoveTo(g.start + n)
+}
+
+// Insert inserts b at cursor, and moves cursor after it
+func (g *GapBuffer) Insert(b byte) {
+	g.reserve(1)
+	g.mem[g.start] = b
+	g.start++
+}
+
+func (g *GapBuffer) InsertRune(r rune) {
+	if uint32(r) < utf8.RuneSelf {
+		g.Insert(byte(r))
+		return
+	}
+
+	g.reserve(utf8.UTFMax)
+	g.start += utf8.EncodeRune(g.mem[g.start:g.end], r)
+}
+
+func (g *GapBuffer) InsertString(str string) {
+	g.reserve(len(str))
+	g.start += copy(g.mem[g.start:g.end], str)
+}
+
+func (g *GapBuffer) InsertBytes(bytes []byte) {
+	g.reserve(len(bytes))
+	g.start += copy(g.mem[g.start:g.end], bytes)
+}
+
+// Delete removes n bytes after cursor, like the 'Delete' key
+func (g *GapBuffer) Delete(n int) {
+	if n < 0 || g.end+n > len(g.mem) {
+		panic("GapBuffer.Delete: index out of range")
+	}
+	g.end += n
+}
+
+// Backspace removes n bytes before cursor, like the 'Backspace' key
+func (g *GapBuffer) Backspace(n int) {
+	if n < 0 || n > g.start {
+		panic("GapBuffer.Backspace: index out of range")
+	}
+	g.start -= n
+}
+
+// DeleteRune removes the rune after cursor, and reports its size in bytes
+func (g *GapBuffer) DeleteRune() int {
+	_, n := utf8.DecodeRune(g.mem[g.end:])
+	g.end += n
+	return n
+}
+
+// BackspaceRune removes the rune before cursor, and reports its size in bytes
+func (g *GapBuffer) BackspaceRune() int {
+	_, n := utf8.DecodeLastRune(g.mem[:g.start])
+	g.start -= n
+	return n
+}
+
+func (g *GapBuffer) Get(i int) byte {
+	if i < g.start {
+		return g.mem[i]
+	}
+	return g.mem[i+g.gap()]
+}
+
+func (g *GapBuffer) Length() int {
+	return len(g.mem) - g.gap()
+}
+
+// Len is to implement interface { Len() int }
+func (g *GapBuffer) Len() int {
+	return g.Length()
+}
+
+func (g *GapBuffer) IsEmpty() bool {
+	return g.Length() == 0
+}
+
+func (g *GapBuffer) Reset() {
+	g.start, g.end = 0, len(g.mem)
+}
+
+// Bytes returns a copy of payload without the gap
+func (g *GapBuffer) Bytes() []byte {
+	mem := make([]byte, g.Length())
+	n := copy(mem, g.mem[:g.start])
+	copy(mem[n:], g.mem[g.end:])
+	return mem
+}
+
+// ToString copies payload into a newly allocated String
+func (g *GapBuffer) ToString() *String {
+	var s String
+	mem := g.Bytes()
+	s.build(mem, len(mem), len(mem))
+	return &s
+}
+
+func (g *GapBuffer) String() string {
+	return string(g.Bytes())
+}
+
+func (g *GapBuffer) GoString() string {
+	return "\"" + g.String() + "\""
+}
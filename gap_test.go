@@ -0,0 +1,87 @@
+package stringx
+
+import (
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestGapBuffer_Edit(t *testing.T) {
+	var (
+		g   GapBuffer
+		exp []byte
+	)
+	for i := 0; i < 2000; i++ {
+		switch op := rand.Intn(5); {
+		case op == 0:
+			g.MoveTo(rand.Intn(len(exp) + 1))
+		case op == 1 && g.Cursor() < len(exp):
+			n := rand.Intn(len(exp) - g.Cursor() + 1)
+			exp = append(exp[:g.Cursor()], exp[g.Cursor()+n:]...)
+			g.Delete(n)
+		case op == 2 && g.Cursor() > 0:
+			n := rand.Intn(g.Cursor() + 1)
+			exp = append(exp[:g.Cursor()-n], exp[g.Cursor():]...)
+			g.Backspace(n)
+		default:
+			str := "abc你好"
+			at := g.Cursor()
+			exp = append(exp[:at], append([]byte(str), exp[at:]...)...)
+			g.InsertString(str)
+			if g.Cursor() != at+len(str) {
+				t.Fatalf("GapBuffer: cursor not moved after insert: cursor=%d expect=%d",
+					g.Cursor(), at+len(str))
+			}
+		}
+		if g.String() != string(exp) {
+			t.Fatalf("GapBuffer: content mismatch: buffer=%s expect=%s",
+				g.String(), string(exp))
+		}
+	}
+}
+
+func TestGapBuffer_String(t *testing.T) {
+	var s String
+	s.FromString("你好世界")
+	g := s.GapBuffer()
+	g.MoveTo(len("你好"))
+	g.InsertRune('💰')
+	g.BackspaceRune()
+	g.InsertString(", ")
+	g.DeleteRune()
+	if res := g.ToString(); !res.EqualToString("你好, 界") {
+		t.Errorf("GapBuffer: converting to String failed: got=%s expect=%s",
+			res.String(), "你好, 界")
+	}
+}
+
+func TestGapBuffer_InsertRune(t *testing.T) {
+	var g GapBuffer
+	g.FromString("ab")
+	g.MoveTo(1)
+	for _, r := range []rune{'你', -1, utf8.MaxRune + 1} {
+		g.InsertRune(r)
+	}
+	if expect := "a你\uFFFD\uFFFDb"; g.String() != expect {
+		t.Errorf("GapBuffer: InsertRune failed: got=%q expect=%q", g.String(), expect)
+	}
+}
+
+func BenchmarkGapBuffer_Insert(b *testing.B) {
+	var g GapBuffer
+	g.FromString(random(1 << 16))
+	g.MoveTo(g.Length() / 2)
+	for i := 0; i < b.N; i++ {
+		g.Insert('x')
+	}
+}
+
+func BenchmarkString_Insert(b *testing.B) {
+	var s String
+	s.FromString(random(1 << 16))
+	at := s.Length() / 2
+	for i := 0; i < b.N; i++ {
+		s.Insert(at, 'x')
+		at++
+	}
+}
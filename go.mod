@@ -1,3 +1,3 @@
 module github.com/Boyux/stringx
 
-go 1.18
+go 1.20
@@ -0,0 +1,370 @@
+package stringx
+
+import "unicode/utf8"
+
+//go:generate go run -C internal/gen . grapheme ../../grapheme_table.go
+
+// graphemeProperty is the Grapheme_Cluster_Break property of a rune, see
+// https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Break_Property_Values
+type graphemeProperty uint8
+
+const (
+	gbAny graphemeProperty = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+	gbExtendedPictographic
+	gbInCBConsonant
+)
+
+type graphemeRange struct {
+	lo, hi rune
+	prop   graphemeProperty
+}
+
+const (
+	hangulSBase  = 0xAC00
+	hangulSLast  = 0xD7A3
+	hangulTCount = 28
+)
+
+func graphemePropertyOf(r rune) graphemeProperty {
+	if ' ' <= r && r < 0x7F {
+		return gbAny
+	}
+
+	if hangulSBase <= r && r <= hangulSLast {
+		if (r-hangulSBase)%hangulTCount == 0 {
+			return gbLV
+		}
+		return gbLVT
+	}
+
+	lo, hi := 0, len(graphemeTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch g := &graphemeTable[m]; {
+		case r < g.lo:
+			hi = m
+		case r > g.hi:
+			lo = m + 1
+		default:
+			return g.prop
+		}
+	}
+
+	return gbAny
+}
+
+func isIncbExtend(r rune) bool {
+	lo, hi := 0, len(incbExtendTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch g := &incbExtendTable[m]; {
+		case r < g[0]:
+			hi = m
+		case r > g[1]:
+			lo = m + 1
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func isIncbLinker(r rune) bool {
+	lo, hi := 0, len(incbLinkerTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch g := &incbLinkerTable[m]; {
+		case r < g[0]:
+			hi = m
+		case r > g[1]:
+			lo = m + 1
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// graphemeLen returns the length in bytes of the first extended grapheme
+// cluster in b, following the rules of UAX #29
+func graphemeLen(b []byte) int {
+	if len(b) == 0 {
+		return 0
+	}
+
+	// fast path: an ASCII character followed by another ASCII character
+	// except "\r\n" is a single cluster
+	if len(b) > 1 && b[0] < utf8.RuneSelf && b[1] < utf8.RuneSelf && b[0] != '\r' {
+		return 1
+	}
+
+	r, size := utf8.DecodeRune(b)
+	prev := graphemePropertyOf(r)
+
+	var (
+		// ri counts Regional_Indicator runes in a row, for GB12 and GB13
+		ri int
+		// emoji is 1 after 'ExtPict Extend*', and 2 after 'ExtPict Extend* ZWJ', for GB11
+		emoji int
+		// incb is 1 after 'InCB=Consonant [InCB=Extend]*', and 2 if an
+		// InCB=Linker occurs after that, for GB9c
+		incb int
+	)
+
+	update := func(r rune, p graphemeProperty) {
+		if p == gbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+
+		switch {
+		case p == gbExtendedPictographic:
+			emoji = 1
+		case p == gbExtend && emoji == 1:
+		case p == gbZWJ && emoji == 1:
+			emoji = 2
+		default:
+			emoji = 0
+		}
+
+		switch {
+		case p == gbInCBConsonant:
+			incb = 1
+		case incb > 0 && isIncbLinker(r):
+			incb = 2
+		case incb > 0 && isIncbExtend(r):
+		default:
+			incb = 0
+		}
+	}
+
+	update(r, prev)
+
+	for size < len(b) {
+		r, n := utf8.DecodeRune(b[size:])
+		next := graphemePropertyOf(r)
+		if graphemeBreak(prev, next, ri, emoji, incb) {
+			break
+		}
+		update(r, next)
+		prev = next
+		size += n
+	}
+
+	return size
+}
+
+// graphemeBreak reports whether there is a cluster boundary between
+// properties prev and next
+func graphemeBreak(prev, next graphemeProperty, ri, emoji, incb int) bool {
+	switch {
+	// GB3
+	case prev == gbCR && next == gbLF:
+		return false
+	// GB4
+	case prev == gbControl || prev == gbCR || prev == gbLF:
+		return true
+	// GB5
+	case next == gbControl || next == gbCR || next == gbLF:
+		return true
+	// GB6
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT):
+		return false
+	// GB7
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT):
+		return false
+	// GB8
+	case (prev == gbLVT || prev == gbT) && next == gbT:
+		return false
+	// GB9, GB9a
+	case next == gbExtend || next == gbZWJ || next == gbSpacingMark:
+		return false
+	// GB9b
+	case prev == gbPrepend:
+		return false
+	// GB9c
+	case next == gbInCBConsonant && incb == 2:
+		return false
+	// GB11
+	case prev == gbZWJ && next == gbExtendedPictographic && emoji == 2:
+		return false
+	// GB12, GB13
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator && ri%2 == 1:
+		return false
+	}
+	// GB999
+	return true
+}
+
+var _ Iterator[*String] = (*Graphemes)(nil)
+
+// Graphemes iterates over extended grapheme clusters (user-perceived
+// characters) of String, see https://www.unicode.org/reports/tr29/
+type Graphemes struct {
+	b   borrow
+	mem []byte
+	idx int
+	val *String
+}
+
+func (g *Graphemes) Next() (hasNext bool) {
+	g.b.check()
+	hasNext = g.idx < len(g.mem)
+	g.val = g.value()
+	return hasNext
+}
+
+func (g *Graphemes) value() *String {
+	var next String
+
+	n := graphemeLen(g.mem[g.idx:])
+	next.FromBytes(g.mem[g.idx : g.idx+n])
+	g.idx += n
+
+	return &next
+}
+
+func (g *Graphemes) Value() *String {
+	return g.val
+}
+
+func (g *Graphemes) Size() (i int) {
+	for i = 0; g.Next(); i++ {
+	}
+	return i
+}
+
+func (g *Graphemes) Consume() []*String {
+	slice := make([]*String, 0)
+
+	for g.Next() {
+		slice = append(slice, g.Value())
+	}
+
+	return slice
+}
+
+func (g *Graphemes) Reverse() *ReverseGraphemes {
+	g.b.check()
+
+	// cluster boundaries can't be found by scanning backward in general
+	// (e.g. odd or even Regional_Indicator runes), so collect them first
+	bounds := []int{g.idx}
+	for i := g.idx; i < len(g.mem); {
+		i += graphemeLen(g.mem[i:])
+		bounds = append(bounds, i)
+	}
+
+	return &ReverseGraphemes{
+		b:      g.b,
+		mem:    g.mem,
+		bounds: bounds,
+	}
+}
+
+var _ Iterator[*String] = (*ReverseGraphemes)(nil)
+
+type ReverseGraphemes struct {
+	b      borrow
+	mem    []byte
+	bounds []int
+	val    *String
+}
+
+func (r *ReverseGraphemes) Next() (hasNext bool) {
+	r.b.check()
+	hasNext = len(r.bounds) > 1
+	r.val = r.value()
+	return hasNext
+}
+
+func (r *ReverseGraphemes) value() *String {
+	var next String
+
+	if len(r.bounds) < 2 {
+		next.Init()
+		return &next
+	}
+
+	last := len(r.bounds) - 1
+	next.FromBytes(r.mem[r.bounds[last-1]:r.bounds[last]])
+	r.bounds = r.bounds[:last]
+
+	return &next
+}
+
+func (r *ReverseGraphemes) Value() *String {
+	return r.val
+}
+
+func (r *ReverseGraphemes) Size() (i int) {
+	for i = 0; r.Next(); i++ {
+	}
+	return i
+}
+
+func (r *ReverseGraphemes) Consume() []*String {
+	slice := make([]*String, 0)
+
+	for r.Next() {
+		slice = append(slice, r.Value())
+	}
+
+	return slice
+}
+
+func (s *String) Graphemes() *Graphemes {
+	return &Graphemes{
+		b:   s.borrow("Graphemes"),
+		mem: s.payload(),
+		idx: 0,
+	}
+}
+
+// ReverseGraphemes reverses String by extended grapheme clusters, so emoji
+// ZWJ sequences, flags and combining marks keep intact, unlike Reverse
+func (s *String) ReverseGraphemes() {
+	s.copycheck()
+	s.mutate()
+
+	if s.len < 2 {
+		return
+	}
+
+	// NOTE: cloning is necessary, see Reverse
+	cl := s.Clone()
+	// cl shares the buffer, which is about to be written
+	s.unshare(s.cap)
+
+	var n int
+	for rev := cl.Graphemes().Reverse(); rev.Next(); {
+		n += copy(s.mem[n:], rev.Value().payload())
+	}
+}
+
+// TruncateGraphemes keeps the first n extended grapheme clusters of String
+func (s *String) TruncateGraphemes(n int) {
+	s.copycheck()
+	s.mutable()
+
+	var size int
+	for i := 0; i < n && size < s.len; i++ {
+		size += graphemeLen(s.mem[size:s.len])
+	}
+
+	s.len = size
+}
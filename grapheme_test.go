@@ -0,0 +1,84 @@
+package stringx
+
+import "testing"
+
+var graphemeData = []struct {
+	s        string
+	clusters []string
+}{
+	{"", []string{}},
+	{"abc", []string{"a", "b", "c"}},
+	{"a\r\nb", []string{"a", "\r\n", "b"}},
+	{"été", []string{"é", "t", "é"}},
+	{"🇯🇵🇺🇸🇫", []string{"🇯🇵", "🇺🇸", "🇫"}},
+	{"👨‍👩‍👧‍👦!", []string{"👨‍👩‍👧‍👦", "!"}},
+	{"👋🏽💰🐱", []string{"👋🏽", "💰", "🐱"}},
+	{"한국어", []string{"한", "국", "어"}},
+	{"각ᄀ", []string{"각", "ᄀ"}},
+	{"क्षि", []string{"क्षि"}},
+	{"\u1000\u1039\u1000", []string{"\u1000\u1039\u1000"}},
+	{"★\u200d★", []string{"★\u200d", "★"}},
+	{"a\u0590\u05C8", []string{"a", "\u0590", "\u05C8"}},
+	{"你好世界", []string{"你", "好", "世", "界"}},
+}
+
+func TestString_Graphemes(t *testing.T) {
+	var s String
+	for _, data := range graphemeData {
+		s.FromString(data.s)
+		clusters := s.Graphemes().Consume()
+		if len(clusters) != len(data.clusters) {
+			t.Errorf("Graphemes: cluster count mismatch: string=%q clusters=%d expect=%d",
+				data.s, len(clusters), len(data.clusters))
+			continue
+		}
+		for i, cluster := range clusters {
+			if !cluster.EqualToString(data.clusters[i]) {
+				t.Errorf("Graphemes: cluster mismatch: string=%q cluster=%q expect=%q",
+					data.s, cluster.String(), data.clusters[i])
+			}
+		}
+
+		rev := s.Graphemes().Reverse().Consume()
+		for i, cluster := range rev {
+			if exp := data.clusters[len(data.clusters)-1-i]; !cluster.EqualToString(exp) {
+				t.Errorf("Graphemes: reverse cluster mismatch: string=%q cluster=%q expect=%q",
+					data.s, cluster.String(), exp)
+			}
+		}
+	}
+}
+
+func TestString_ReverseGraphemes(t *testing.T) {
+	var s String
+	for _, data := range graphemeData {
+		s.FromString(data.s)
+		s.ReverseGraphemes()
+		var exp string
+		for i := len(data.clusters) - 1; i >= 0; i-- {
+			exp += data.clusters[i]
+		}
+		if !s.EqualToString(exp) {
+			t.Errorf("String: reverse graphemes failed: before=%q after=%q expect=%q",
+				data.s, s.String(), exp)
+		}
+	}
+}
+
+func TestString_TruncateGraphemes(t *testing.T) {
+	var s String
+	for _, data := range graphemeData {
+		for n := 0; n <= len(data.clusters); n++ {
+			s.FromString(data.s)
+			s.TruncateGraphemes(n)
+			var exp string
+			for _, cluster := range data.clusters[:n] {
+				exp += cluster
+			}
+			if !s.EqualToString(exp) {
+				t.Errorf("String: truncate graphemes failed: before=%q n=%d after=%q expect=%q",
+					data.s, n, s.String(), exp)
+			}
+		}
+	}
+}
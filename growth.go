@@ -0,0 +1,103 @@
+package stringx
+
+import (
+	"math"
+	"math/bits"
+	"sync/atomic"
+)
+
+// GrowthPolicy decides capacity of a String when it grows, given its current
+// capacity and the capacity it needs at least. The returned capacity mustn't
+// be less than need.
+type GrowthPolicy func(cap, need int) int
+
+// GrowPowerOfTwo rounds capacity up to the next power of two, it reallocates
+// least often but may waste nearly half of the buffer. This is the default.
+func GrowPowerOfTwo(cap, need int) int {
+	if need > math.MaxInt>>1+1 {
+		return need
+	}
+	return 1 << bits.Len(uint(need-1))
+}
+
+// GrowQuarter grows capacity by 1.25x, small Strings grow by 64 bytes at
+// least so they don't reallocate on every Push
+func GrowQuarter(cap, need int) int {
+	grown := cap + cap>>2
+	if grown < cap+64 {
+		grown = cap + 64
+	}
+	// overflowed
+	if grown < cap {
+		return need
+	}
+	if grown < need {
+		return need
+	}
+	return grown
+}
+
+// GrowExact grows capacity to exactly what is needed, it wastes no memory,
+// but a String built by many Pushes reallocates on every Push
+func GrowExact(cap, need int) int {
+	return need
+}
+
+var defaultGrowth atomic.Value
+
+func init() {
+	defaultGrowth.Store(GrowthPolicy(GrowPowerOfTwo))
+}
+
+// SetDefaultGrowthPolicy sets the policy used by Strings which have no policy
+// of their own and returns the previous one, nil resets to GrowPowerOfTwo
+func SetDefaultGrowthPolicy(policy GrowthPolicy) GrowthPolicy {
+	if policy == nil {
+		policy = GrowPowerOfTwo
+	}
+	return defaultGrowth.Swap(policy).(GrowthPolicy)
+}
+
+// SetGrowthPolicy sets the policy used when String grows, nil falls back to
+// the default policy, see SetDefaultGrowthPolicy. Clone keeps the policy.
+func (s *String) SetGrowthPolicy(policy GrowthPolicy) {
+	s.growth = policy
+}
+
+func (s *String) growthPolicy() GrowthPolicy {
+	if s.growth != nil {
+		return s.growth
+	}
+	return defaultGrowth.Load().(GrowthPolicy)
+}
+
+// Reserve makes sure at least n more bytes can be pushed into String without
+// reallocation, the new capacity is decided by GrowthPolicy
+func (s *String) Reserve(n int) {
+	s.copycheck()
+	s.mutable()
+
+	if n < 0 {
+		panic("String.Reserve: negative n")
+	}
+
+	// a shared buffer is copied as well, which would reallocate on push
+	s.ensure(n)
+}
+
+// ShrinkToFit reallocates buffer of String to fit its length, which frees
+// spare capacity left by a grown or Drained String
+func (s *String) ShrinkToFit() {
+	s.copycheck()
+	s.mutable()
+
+	if s.cap == s.len {
+		return
+	}
+
+	mem := make([]byte, s.len)
+	copy(mem, s.payload())
+	s.drop()
+	s.mem = mem
+	s.cap = s.len
+}
@@ -0,0 +1,139 @@
+package stringx
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestGrowthPolicy(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		policy    GrowthPolicy
+		cap, need int
+		expect    int
+	}{
+		{"GrowPowerOfTwo", GrowPowerOfTwo, 0, 1, 1},
+		{"GrowPowerOfTwo", GrowPowerOfTwo, 8, 9, 16},
+		{"GrowPowerOfTwo", GrowPowerOfTwo, 10, 100, 128},
+		{"GrowPowerOfTwo", GrowPowerOfTwo, math.MaxInt >> 1, math.MaxInt>>1 + 2, math.MaxInt>>1 + 2},
+		{"GrowQuarter", GrowQuarter, 0, 1, 64},
+		{"GrowQuarter", GrowQuarter, 1024, 1025, 1280},
+		{"GrowQuarter", GrowQuarter, 1024, 4096, 4096},
+		{"GrowQuarter", GrowQuarter, math.MaxInt - 10, math.MaxInt - 9, math.MaxInt - 9},
+		{"GrowExact", GrowExact, 1024, 1025, 1025},
+	} {
+		if capacity := c.policy(c.cap, c.need); capacity != c.expect {
+			t.Errorf("GrowthPolicy: %s failed: cap=%d need=%d capacity=%d expect=%d",
+				c.name, c.cap, c.need, capacity, c.expect)
+		}
+	}
+
+	// capacities beyond 2^31 on 64-bit platforms, k is a variable so the
+	// shifts compile on 32-bit platforms as well
+	if bits.UintSize == 64 {
+		k := 31
+		if capacity := GrowPowerOfTwo(1<<k, 1<<k+1); capacity != 1<<(k+1) {
+			t.Errorf("GrowthPolicy: GrowPowerOfTwo beyond 2^31 failed: capacity=%d", capacity)
+		}
+		if capacity := GrowQuarter(1<<(k+1), 1<<(k+1)+1); capacity != 5<<(k-1) {
+			t.Errorf("GrowthPolicy: GrowQuarter beyond 2^31 failed: capacity=%d", capacity)
+		}
+	}
+}
+
+func TestString_GrowMinimum(t *testing.T) {
+	for _, n := range []int{0, -1, math.MinInt} {
+		var s String
+		s.Init()
+		s.grow(n)
+		if s.Capacity() < 1 {
+			t.Errorf("String: grow of empty String failed: n=%d capacity=%d", n, s.Capacity())
+		}
+
+		s.FromString("abc")
+		capacity := s.Capacity()
+		s.grow(n)
+		if s.Capacity() <= capacity || !s.EqualToString("abc") {
+			t.Errorf("String: grow failed: n=%d capacity=%d before=%d after=%s", n, s.Capacity(), capacity, s.String())
+		}
+	}
+}
+
+func TestString_SetGrowthPolicy(t *testing.T) {
+	var s String
+	s.Init()
+	s.SetGrowthPolicy(GrowExact)
+	for i := 0; i < 100; i++ {
+		s.Push('a')
+		if s.Capacity() != s.Length() {
+			t.Fatalf("String: GrowExact failed: length=%d capacity=%d", s.Length(), s.Capacity())
+		}
+	}
+	if cloned := s.Clone(); cloned.growth == nil {
+		t.Errorf("String: Clone drops GrowthPolicy")
+	}
+
+	prev := SetDefaultGrowthPolicy(GrowQuarter)
+	defer SetDefaultGrowthPolicy(prev)
+
+	var d String
+	d.Init()
+	d.PushString("abc")
+	if d.Capacity() != 64 {
+		t.Errorf("String: default GrowthPolicy failed: capacity=%d expect=64", d.Capacity())
+	}
+
+	var bad String
+	bad.Init()
+	bad.SetGrowthPolicy(func(cap, need int) int { return cap })
+	defer func() {
+		if recover() == nil {
+			t.Errorf("String: bad GrowthPolicy doesn't panic")
+		}
+	}()
+	bad.Push('a')
+}
+
+func TestString_Reserve(t *testing.T) {
+	var s String
+	s.FromString("hello")
+	s.SetGrowthPolicy(GrowExact)
+
+	s.Reserve(3)
+	if s.Capacity() != 8 || !s.EqualToString("hello") {
+		t.Errorf("String: Reserve failed: capacity=%d payload=%s", s.Capacity(), s.String())
+	}
+
+	// enough room, no reallocation
+	mem := &s.mem[0]
+	s.Reserve(2)
+	s.PushString("!!!")
+	if &s.mem[0] != mem || !s.EqualToString("hello!!!") {
+		t.Errorf("String: Reserve reallocates: capacity=%d payload=%s", s.Capacity(), s.String())
+	}
+}
+
+func TestString_ShrinkToFit(t *testing.T) {
+	var s String
+	s.FromString("hello, world")
+	s.SetCapacity(1024)
+	s.Drain(5, 12)
+
+	s.ShrinkToFit()
+	if s.Capacity() != 5 || !s.EqualToString("hello") {
+		t.Errorf("String: ShrinkToFit failed: capacity=%d payload=%s", s.Capacity(), s.String())
+	}
+
+	s.PushString(" again")
+	if !s.EqualToString("hello again") {
+		t.Errorf("String: push after ShrinkToFit failed: payload=%s", s.String())
+	}
+
+	var empty String
+	empty.Init()
+	empty.ShrinkToFit()
+	if empty.Capacity() != 0 || !empty.IsEmpty() {
+		t.Errorf("String: ShrinkToFit empty String failed: capacity=%d", empty.Capacity())
+	}
+}
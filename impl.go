@@ -7,33 +7,32 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 	"unicode"
 	"unicode/utf16"
 	"unicode/utf8"
 )
 
+// TryFrom converts from into String, numbers and bools are formatted like
+// strconv does, and time.Time is formatted as time.RFC3339Nano, the same as
+// database/sql converts it into a string
 func (s *String) TryFrom(from any) error {
+	// numbers and times are formatted onto stack, FromBytes copies them
+	var num [40]byte
+
 	switch src := from.(type) {
 	case bool:
-		s.mem = strconv.AppendBool(s.mem[:0], src)
-		s.len = len(s.mem)
-		s.cap = len(s.mem)
+		s.FromBytes(strconv.AppendBool(num[:0], src))
 	case int, int8, int16, int32, int64:
-		s.mem = strconv.AppendInt(s.mem[:0], reflect.ValueOf(src).Int(), 10)
-		s.len = len(s.mem)
-		s.cap = len(s.mem)
+		s.FromBytes(strconv.AppendInt(num[:0], reflect.ValueOf(src).Int(), 10))
 	case uint, uint8, uint16, uint32, uint64:
-		s.mem = strconv.AppendUint(s.mem[:0], reflect.ValueOf(src).Uint(), 10)
-		s.len = len(s.mem)
-		s.cap = len(s.mem)
+		s.FromBytes(strconv.AppendUint(num[:0], reflect.ValueOf(src).Uint(), 10))
 	case float32:
-		s.mem = strconv.AppendFloat(s.mem[:0], reflect.ValueOf(src).Float(), 'g', -1, 32)
-		s.len = len(s.mem)
-		s.cap = len(s.mem)
+		s.FromBytes(strconv.AppendFloat(num[:0], float64(src), 'g', -1, 32))
 	case float64:
-		s.mem = strconv.AppendFloat(s.mem[:0], src, 'g', -1, 64)
-		s.len = len(s.mem)
-		s.cap = len(s.mem)
+		s.FromBytes(strconv.AppendFloat(num[:0], src, 'g', -1, 64))
+	case time.Time:
+		s.FromBytes(src.AppendFormat(num[:0], time.RFC3339Nano))
 	case string:
 		s.FromString(src)
 	case []byte:
@@ -47,7 +46,7 @@ func (s *String) TryFrom(from any) error {
 	case Initializer[*String]:
 		s.From(src)
 	default:
-		return fmt.Errorf("stringx: cannot convert type %s to String", reflect.TypeOf(from).String())
+		return fmt.Errorf("stringx: cannot convert type %T to String", from)
 	}
 	return nil
 }
@@ -90,23 +89,16 @@ func (s *String) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// Scan is to implement interface sql.Scanner, every kind of driver.Value is
+// converted by TryFrom, and NULL is scanned as an empty String, use
+// NullString to tell NULL from an empty String
 func (s *String) Scan(src any) error {
 	if src == nil {
-		return fmt.Errorf("stringx: cannot assign `NULL` value to String")
-	}
-
-	if str, ok := src.(string); ok {
-		mem := stringToBytesSlow(str)
-		s.FromBytes(mem)
-		return nil
-	}
-
-	if b, ok := src.([]byte); ok {
-		s.FromBytes(b)
+		s.FromBytes(nil)
 		return nil
 	}
 
-	return fmt.Errorf("stringx: cannot assign type %s to String", reflect.TypeOf(src).String())
+	return s.TryFrom(src)
 }
 
 func (s *String) Value() (driver.Value, error) {
@@ -2,8 +2,10 @@ package stringx
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"encoding/json"
 	"testing"
+	"time"
 )
 
 func TestString_Init(t *testing.T) {
@@ -53,3 +55,40 @@ func TestString_MarshalJSON2(t *testing.T) {
 		}
 	}
 }
+
+func TestString_Scan(t *testing.T) {
+	at := time.Date(2024, 2, 29, 12, 30, 0, 500, time.UTC)
+
+	for _, c := range []struct {
+		src    driver.Value
+		expect string
+	}{
+		{nil, ""},
+		{int64(-42), "-42"},
+		{float64(3.25), "3.25"},
+		{true, "true"},
+		{[]byte("bytes"), "bytes"},
+		{"中文", "中文"},
+		{at, "2024-02-29T12:30:00.0000005Z"},
+	} {
+		// scan into a zero String as well as a used one
+		var zero, used String
+		used.FromString("previous")
+
+		for _, s := range []*String{&zero, &used} {
+			if err := s.Scan(c.src); err != nil || !s.EqualToString(c.expect) {
+				t.Errorf("String: Scan failed: src=%v String=%s expect=%s err=%v", c.src, s.String(), c.expect, err)
+			}
+			// String is initialized by Scan
+			s.PushString("!")
+		}
+	}
+
+	var s String
+	if err := s.Scan(struct{}{}); err == nil {
+		t.Errorf("String: Scan of unsupported type doesn't fail")
+	}
+	if err := s.TryFrom(nil); err == nil {
+		t.Errorf("String: TryFrom nil doesn't fail")
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+func init() {
+	generators["case"] = genCase
+}
+
+func genCase(w *bytes.Buffer) {
+	var (
+		runes   []rune
+		offsets = map[string]int{}
+	)
+
+	intern := func(d string) int {
+		if off, ok := offsets[d]; ok {
+			return off
+		}
+		off := len(runes)
+		runes = append(runes, []rune(d)...)
+		offsets[d] = off
+		return off
+	}
+
+	table := func(name, comment string, mapping func(r rune) string, simple func(r rune) rune) {
+		w.WriteString(comment)
+		fmt.Fprintf(w, "var %s = [...]caseMapping{\n", name)
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if !utf8.ValidRune(r) {
+				continue
+			}
+			m := mapping(r)
+			if m == string(simple(r)) {
+				continue
+			}
+			n := utf8.RuneCountInString(m)
+			fmt.Fprintf(w, "\t{0x%04X, %d, %d},\n", r, intern(m), n)
+		}
+		w.WriteString("}\n\n")
+
+		if len(runes) > 0xFFFF {
+			log.Fatalf("%s overflows table entry", name)
+		}
+	}
+
+	fold, upper, lower := cases.Fold(), cases.Upper(language.Und), cases.Lower(language.Und)
+
+	table("foldTable",
+		"// foldTable holds full case folding (status C and F of CaseFolding.txt)\n"+
+			"// of runes, which differs from unicode.ToLower\n",
+		func(r rune) string {
+			f := fold.String(string(r))
+			// cases.Fold maps uppercase Cherokee letters to lowercase, while
+			// CaseFolding.txt folds lowercase ones to uppercase for stability,
+			// so folding is not idempotent there. Keep the uppercase letter of
+			// such a cycle as it is.
+			if fold.String(f) == string(r) && f != string(r) && unicode.IsUpper(r) {
+				return string(r)
+			}
+			return f
+		},
+		unicode.ToLower,
+	)
+
+	table("upperTable",
+		"// upperTable holds full uppercase mapping of runes, which differs from\n"+
+			"// unicode.ToUpper, i.e. unconditional mappings of SpecialCasing.txt\n",
+		func(r rune) string { return upper.String(string(r)) },
+		unicode.ToUpper,
+	)
+
+	table("lowerTable",
+		"// lowerTable holds full lowercase mapping of runes, which differs from\n"+
+			"// unicode.ToLower, i.e. unconditional mappings of SpecialCasing.txt\n",
+		func(r rune) string { return lower.String(string(r)) },
+		unicode.ToLower,
+	)
+
+	w.WriteString("var caseRunes = [...]rune{")
+	writeRunes(w, runes)
+	w.WriteString("}\n")
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	generators["grapheme"] = genGrapheme
+}
+
+// graphemeProperties maps values of Grapheme_Cluster_Break to constants of
+// stringx, LV and LVT are derived arithmetically in graphemePropertyOf
+var graphemeProperties = map[string]string{
+	"CR":                 "gbCR",
+	"LF":                 "gbLF",
+	"Control":            "gbControl",
+	"Extend":             "gbExtend",
+	"ZWJ":                "gbZWJ",
+	"Regional_Indicator": "gbRegionalIndicator",
+	"Prepend":            "gbPrepend",
+	"SpacingMark":        "gbSpacingMark",
+	"L":                  "gbL",
+	"V":                  "gbV",
+	"T":                  "gbT",
+	"LV":                 "",
+	"LVT":                "",
+}
+
+func genGrapheme(w *bytes.Buffer) {
+	props := make([]string, unicode.MaxRune+1)
+	set := func(lo, hi rune, prop string) {
+		for r := lo; r <= hi; r++ {
+			if props[r] != "" {
+				log.Fatalf("grapheme: %U is both %s and %s", r, props[r], prop)
+			}
+			props[r] = prop
+		}
+	}
+
+	incbExtend := make([]bool, unicode.MaxRune+1)
+	incbLinker := make([]bool, unicode.MaxRune+1)
+	parseUCD("auxiliary/GraphemeBreakProperty.txt", func(lo, hi rune, fields []string) {
+		prop, ok := graphemeProperties[fields[0]]
+		if !ok {
+			log.Fatalf("grapheme: unknown Grapheme_Cluster_Break %s", fields[0])
+		}
+		if prop != "" {
+			set(lo, hi, prop)
+		}
+	})
+	parseUCD("emoji/emoji-data.txt", func(lo, hi rune, fields []string) {
+		if fields[0] == "Extended_Pictographic" {
+			set(lo, hi, "gbExtendedPictographic")
+		}
+	})
+	parseUCD("DerivedCoreProperties.txt", func(lo, hi rune, fields []string) {
+		if fields[0] != "InCB" {
+			return
+		}
+		switch fields[1] {
+		case "Consonant":
+			set(lo, hi, "gbInCBConsonant")
+		case "Extend":
+			for r := lo; r <= hi; r++ {
+				incbExtend[r] = true
+			}
+		case "Linker":
+			for r := lo; r <= hi; r++ {
+				incbLinker[r] = true
+			}
+		}
+	})
+
+	w.WriteString("// graphemeTable holds Grapheme_Cluster_Break property of runes, sorted by\n")
+	w.WriteString("// range. Extended_Pictographic (emoji-data.txt) and InCB=Consonant\n")
+	w.WriteString("// (DerivedCoreProperties.txt) are merged into it, since they never overlap\n")
+	w.WriteString("// with other properties. Hangul LV and LVT syllables are not listed here,\n")
+	w.WriteString("// they are derived arithmetically in graphemePropertyOf.\n")
+	w.WriteString("var graphemeTable = [...]graphemeRange{\n")
+	for lo := rune(0); lo <= unicode.MaxRune; lo++ {
+		if props[lo] == "" {
+			continue
+		}
+		hi := lo
+		for hi < unicode.MaxRune && props[hi+1] == props[lo] {
+			hi++
+		}
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %s},\n", lo, hi, props[lo])
+		lo = hi
+	}
+	w.WriteString("}\n\n")
+
+	writeRanges(w, "incbExtendTable", "// incbExtendTable holds runes with Indic_Conjunct_Break=Extend, used by rule GB9c\n", incbExtend)
+	w.WriteString("\n")
+	writeRanges(w, "incbLinkerTable", "// incbLinkerTable holds runes with Indic_Conjunct_Break=Linker, used by rule GB9c\n", incbLinker)
+}
+
+// writeRanges writes runes in set as a Go array of ranges
+func writeRanges(w *bytes.Buffer, name, comment string, set []bool) {
+	w.WriteString(comment)
+	fmt.Fprintf(w, "var %s = [...][2]rune{\n", name)
+	for lo := rune(0); lo <= unicode.MaxRune; lo++ {
+		if !set[lo] {
+			continue
+		}
+		hi := lo
+		for hi < unicode.MaxRune && set[hi+1] {
+			hi++
+		}
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X},\n", lo, hi)
+		lo = hi
+	}
+	w.WriteString("}\n")
+}
+
+// openUCD opens a file of the Unicode Character Database of norm.Version,
+// which is downloaded from unicode.org, or read from the directory in $UCD
+func openUCD(name string) io.ReadCloser {
+	if dir := os.Getenv("UCD"); dir != "" {
+		f, err := os.Open(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+
+	url := "https://www.unicode.org/Public/" + norm.Version + "/ucd/" + name
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("fetching %s: %s", url, resp.Status)
+	}
+	return resp.Body
+}
+
+// parseUCD calls fn with the range and the remaining fields of each line of
+// a UCD file, comments are stripped and fields are trimmed
+func parseUCD(name string, fn func(lo, hi rune, fields []string)) {
+	f := openUCD(name)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		first, last, _ := strings.Cut(fields[0], "..")
+		if last == "" {
+			last = first
+		}
+		lo, err := strconv.ParseUint(first, 16, 32)
+		if err != nil {
+			log.Fatalf("parsing %s: %v", name, err)
+		}
+		hi, err := strconv.ParseUint(last, 16, 32)
+		if err != nil {
+			log.Fatalf("parsing %s: %v", name, err)
+		}
+
+		fn(rune(lo), rune(hi), fields[1:])
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("reading %s: %v", name, err)
+	}
+}
@@ -0,0 +1,67 @@
+// Command gen generates Unicode tables of package stringx.
+//
+// It lives in a module of its own, so that stringx itself keeps free of
+// dependencies. Run it through 'go generate' in the root of stringx, or
+//
+//	go run -C internal/gen . <table> <output>
+//
+// where <table> is one of the generators listed by running without arguments.
+//
+// Most tables come from golang.org/x/text. The grapheme table is parsed from
+// the Unicode Character Database of the same version, which is downloaded
+// from unicode.org, or read from a local copy if $UCD names its directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var generators = map[string]func(w *bytes.Buffer){}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+
+	if len(os.Args) != 3 || generators[os.Args[1]] == nil {
+		names := make([]string, 0, len(generators))
+		for name := range generators {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		log.Fatalf("usage: gen <table> <output>, tables: %v", names)
+	}
+
+	var w bytes.Buffer
+	fmt.Fprintf(&w, "// Code generated by internal/gen from Unicode %s data. DO NOT EDIT.\n\n", norm.Version)
+	fmt.Fprintf(&w, "package stringx\n\n")
+	generators[os.Args[1]](&w)
+
+	src, err := format.Source(w.Bytes())
+	if err != nil {
+		log.Fatalf("formatting %s: %v", os.Args[1], err)
+	}
+
+	if err = os.WriteFile(os.Args[2], src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeRunes writes runes as a Go slice literal body, several runes per line
+func writeRunes(w *bytes.Buffer, runes []rune) {
+	for i, r := range runes {
+		if i%8 == 0 {
+			w.WriteString("\n\t")
+		} else {
+			w.WriteString(" ")
+		}
+		fmt.Fprintf(w, "0x%04X,", r)
+	}
+	w.WriteString("\n")
+}
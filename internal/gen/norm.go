@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	generators["norm"] = genNorm
+}
+
+func isHangulSyllable(r rune) bool {
+	return 0xAC00 <= r && r <= 0xD7A3
+}
+
+func genNorm(w *bytes.Buffer) {
+	genCCC(w)
+	genDecomposition(w)
+	genComposition(w)
+}
+
+func genCCC(w *bytes.Buffer) {
+	type cccRange struct {
+		lo, hi rune
+		ccc    uint8
+	}
+
+	var ranges []cccRange
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		ccc := norm.NFD.PropertiesString(string(r)).CCC()
+		if ccc == 0 {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].hi == r-1 && ranges[n-1].ccc == ccc {
+			ranges[n-1].hi = r
+			continue
+		}
+		ranges = append(ranges, cccRange{r, r, ccc})
+	}
+
+	w.WriteString("// cccTable holds ranges of runes with non-zero Canonical_Combining_Class\n")
+	w.WriteString("var cccTable = [...]cccRange{\n")
+	for _, g := range ranges {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %d},\n", g.lo, g.hi, g.ccc)
+	}
+	w.WriteString("}\n\n")
+}
+
+func genDecomposition(w *bytes.Buffer) {
+	var (
+		runes   []rune
+		entries []string
+		offsets = map[string]int{}
+	)
+
+	// intern stores decomposition d into runes, and returns its offset
+	intern := func(d string) int {
+		if off, ok := offsets[d]; ok {
+			return off
+		}
+		off := len(runes)
+		runes = append(runes, []rune(d)...)
+		offsets[d] = off
+		return off
+	}
+
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if isHangulSyllable(r) || !utf8.ValidRune(r) {
+			continue
+		}
+
+		src := string(r)
+		canon, compat := norm.NFD.String(src), norm.NFKD.String(src)
+		if canon == src && compat == src {
+			continue
+		}
+
+		var off, n int
+		if canon != src {
+			off, n = intern(canon), utf8.RuneCountInString(canon)
+		}
+		koff, kn := intern(compat), utf8.RuneCountInString(compat)
+
+		if len(runes) > 0xFFFF || kn > 0xFF {
+			log.Fatalf("decomposition of %U overflows table entry", r)
+		}
+
+		entries = append(entries, fmt.Sprintf("\t{0x%04X, %d, %d, %d, %d},\n", r, off, n, koff, kn))
+	}
+
+	w.WriteString("// decompTable holds full canonical and compatibility decompositions of\n")
+	w.WriteString("// runes, as slices of decompRunes. Hangul syllables are decomposed\n")
+	w.WriteString("// arithmetically and not listed.\n")
+	w.WriteString("var decompTable = [...]decompEntry{\n")
+	for _, e := range entries {
+		w.WriteString(e)
+	}
+	w.WriteString("}\n\n")
+
+	w.WriteString("var decompRunes = [...]rune{")
+	writeRunes(w, runes)
+	w.WriteString("}\n\n")
+}
+
+func genComposition(w *bytes.Buffer) {
+	type pair struct {
+		a, b, c rune
+	}
+
+	var (
+		pairs   []pair
+		seconds = map[rune]bool{}
+	)
+
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if isHangulSyllable(r) || !utf8.ValidRune(r) {
+			continue
+		}
+
+		src := string(r)
+		canon := []rune(norm.NFD.String(src))
+		// primary composites are runes with canonical decomposition, which
+		// are kept by NFC
+		if string(canon) == src || norm.NFC.String(src) != src {
+			continue
+		}
+
+		b := canon[len(canon)-1]
+		a := []rune(norm.NFC.String(string(canon[:len(canon)-1])))
+		if len(a) != 1 || norm.NFC.String(string([]rune{a[0], b})) != src {
+			log.Fatalf("unexpected canonical decomposition of %U: %U", r, canon)
+		}
+
+		pairs = append(pairs, pair{a[0], b, r})
+		seconds[b] = true
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].a != pairs[j].a {
+			return pairs[i].a < pairs[j].a
+		}
+		return pairs[i].b < pairs[j].b
+	})
+
+	w.WriteString("// compositionTable holds canonical composition pairs sorted by (a, b),\n")
+	w.WriteString("// composition exclusions are left out. Hangul syllables are composed\n")
+	w.WriteString("// arithmetically and not listed.\n")
+	w.WriteString("var compositionTable = [...]compositionPair{\n")
+	for _, p := range pairs {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, 0x%04X},\n", p.a, p.b, p.c)
+	}
+	w.WriteString("}\n\n")
+
+	var second []rune
+	for r := range seconds {
+		second = append(second, r)
+	}
+	sort.Slice(second, func(i, j int) bool { return second[i] < second[j] })
+
+	w.WriteString("// compositionSecond holds runes which may compose with a preceding rune,\n")
+	w.WriteString("// sorted. Hangul vowels and trailing consonants are not listed.\n")
+	w.WriteString("var compositionSecond = [...]rune{")
+	writeRunes(w, second)
+	w.WriteString("}\n")
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+func init() {
+	generators["width"] = genWidth
+}
+
+func genWidth(w *bytes.Buffer) {
+	var ranges [][2]rune
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !utf8.ValidRune(r) {
+			continue
+		}
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+		default:
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1][1] == r-1 {
+			ranges[n-1][1] = r
+			continue
+		}
+		ranges = append(ranges, [2]rune{r, r})
+	}
+
+	w.WriteString("// wideTable holds ranges of runes with East_Asian_Width W or F, which\n")
+	w.WriteString("// take two columns when displayed\n")
+	w.WriteString("var wideTable = [...][2]rune{\n")
+	for _, g := range ranges {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X},\n", g[0], g[1])
+	}
+	w.WriteString("}\n")
+}
@@ -0,0 +1,165 @@
+package stringx
+
+import (
+	"hash/maphash"
+	"runtime"
+	"sync"
+)
+
+// Interned is the canonical handle of a payload in an Interner, two handles
+// from the same Interner are equal pointers if and only if their payloads are
+// equal, so they compare by == instead of comparing bytes. The payload of an
+// Interned never changes.
+type Interned struct {
+	str string
+}
+
+// String returns the payload of Interned, which takes no copy
+func (i *Interned) String() string {
+	return i.str
+}
+
+func (i *Interned) GoString() string {
+	return "\"" + i.str + "\""
+}
+
+func (i *Interned) Len() int {
+	return len(i.str)
+}
+
+// View returns a View of the payload of Interned, which takes no copy with
+// 'unsafe_convert' tag
+func (i *Interned) View() View {
+	return View{mem: stringToBytes(i.str)}
+}
+
+// ToString returns a frozen String of the payload of Interned, which shares
+// memory with Interned with 'unsafe_convert' tag. Clone it to get a mutable
+// String.
+func (i *Interned) ToString() *String {
+	var s String
+	s.build(stringToBytes(i.str), len(i.str), len(i.str))
+	// the buffer may be memory of Interned, which is never written
+	s.shared.Store(unshareable)
+	s.frozen = true
+	return &s
+}
+
+// Interner deduplicates payloads into canonical Interned handles. Handles are
+// kept in shards selected by hash of the payload, so goroutines interning
+// different payloads rarely wait for each other. An Interner is safe for
+// concurrent use.
+type Interner struct {
+	seed   maphash.Seed
+	weak   bool
+	shards []internShard
+}
+
+type internShard struct {
+	mu     sync.RWMutex
+	strong map[string]*Interned
+	// weak holds handles of a weak Interner, see NewWeakInterner
+	weak weakMap
+
+	// keeps shards on different cache lines
+	_ [64]byte
+}
+
+// NewInterner returns an Interner with the given number of shards, which is
+// rounded up to a power of two, and defaults to 4 times GOMAXPROCS if shards
+// is not positive. Interned handles are kept forever.
+func NewInterner(shards int) *Interner {
+	return newInterner(shards, false)
+}
+
+func newInterner(shards int, isWeak bool) *Interner {
+	if shards <= 0 {
+		shards = 4 * runtime.GOMAXPROCS(0)
+	}
+
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+
+	in := &Interner{
+		seed:   maphash.MakeSeed(),
+		weak:   isWeak,
+		shards: make([]internShard, n),
+	}
+	for i := range in.shards {
+		if isWeak {
+			in.shards[i].weak = newWeakMap()
+		} else {
+			in.shards[i].strong = make(map[string]*Interned)
+		}
+	}
+
+	return in
+}
+
+func (in *Interner) shard(b []byte) *internShard {
+	return &in.shards[maphash.Bytes(in.seed, b)&uint64(len(in.shards)-1)]
+}
+
+// Intern returns the canonical handle of payload of String
+func (in *Interner) Intern(s *String) *Interned {
+	return in.InternBytes(s.payload())
+}
+
+// InternString returns the canonical handle of str
+func (in *Interner) InternString(str string) *Interned {
+	return in.InternBytes(stringToBytes(str))
+}
+
+// InternBytes returns the canonical handle of b, b is copied only if it is
+// interned for the first time
+func (in *Interner) InternBytes(b []byte) *Interned {
+	shard := in.shard(b)
+
+	shard.mu.RLock()
+	i := shard.lookup(b)
+	shard.mu.RUnlock()
+
+	if i != nil {
+		return i
+	}
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	// interned by others since RUnlock
+	if i = shard.lookup(b); i != nil {
+		return i
+	}
+
+	i = &Interned{str: string(b)}
+	if !in.weak {
+		shard.strong[i.str] = i
+		return i
+	}
+
+	shard.internWeak(i)
+	return i
+}
+
+// lookup returns the handle of b or nil, shard must be locked
+func (s *internShard) lookup(b []byte) *Interned {
+	if s.strong != nil {
+		// string(b) in map index doesn't allocate
+		return s.strong[string(b)]
+	}
+	return s.weak.lookup(b)
+}
+
+// Len returns the number of payloads in Interner, which includes handles
+// already collected but not evicted yet for a weak Interner
+func (in *Interner) Len() (n int) {
+	for i := range in.shards {
+		shard := &in.shards[i]
+		shard.mu.RLock()
+		n += len(shard.strong) + shard.weak.len()
+		shard.mu.RUnlock()
+	}
+	return n
+}
@@ -0,0 +1,23 @@
+//go:build !go1.24
+
+package stringx
+
+// weakMap is never used before Go 1.24, which has no weak pointers, so
+// NewWeakInterner is not available
+type weakMap struct{}
+
+func newWeakMap() weakMap {
+	return weakMap{}
+}
+
+func (weakMap) lookup(b []byte) *Interned {
+	return nil
+}
+
+func (weakMap) len() int {
+	return 0
+}
+
+func (s *internShard) internWeak(i *Interned) {
+	panic("Interner: weak Interner requires Go 1.24")
+}
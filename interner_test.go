@@ -0,0 +1,108 @@
+package stringx
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestInterner_Intern(t *testing.T) {
+	in := NewInterner(0)
+
+	var s String
+	s.FromString("tag:value")
+
+	a := in.Intern(&s)
+	b := in.InternString("tag:value")
+	c := in.InternBytes([]byte("tag:value"))
+	if a != b || b != c || a.String() != "tag:value" {
+		t.Errorf("Interner: intern failed: a=%p b=%p c=%p", a, b, c)
+	}
+
+	// interned payload doesn't change with String
+	s.PushString("!")
+	if a.String() != "tag:value" || in.Intern(&s) == a {
+		t.Errorf("Interner: interned payload changes: interned=%s", a.String())
+	}
+
+	if empty := in.InternString(""); empty != in.InternBytes(nil) || empty.Len() != 0 {
+		t.Errorf("Interner: intern empty payload failed")
+	}
+
+	if in.Len() != 3 {
+		t.Errorf("Interner: length failed: length=%d expect=3", in.Len())
+	}
+
+	// handles of different Interners are different
+	if NewInterner(1).InternString("tag:value") == a {
+		t.Errorf("Interner: handles of different Interners are equal")
+	}
+}
+
+func TestInterned_Convert(t *testing.T) {
+	in := NewInterner(4)
+	i := in.InternString("héllo")
+
+	s := i.ToString()
+	if !s.EqualToString("héllo") || !s.IsFrozen() {
+		t.Fatalf("Interned: ToString failed: String=%s frozen=%v", s.String(), s.IsFrozen())
+	}
+	cloned := s.Clone()
+	cloned.PushString("!")
+	if !cloned.EqualToString("héllo!") || i.String() != "héllo" {
+		t.Errorf("Interned: clone of ToString failed: clone=%s interned=%s", cloned.String(), i.String())
+	}
+
+	if !i.View().EqualToString("héllo") || i.GoString() != "\"héllo\"" {
+		t.Errorf("Interned: View failed: view=%s", i.View().String())
+	}
+}
+
+func TestInterner_Concurrent(t *testing.T) {
+	in := NewInterner(0)
+
+	const workers, tags = 8, 100
+	handles := make([][]*Interned, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < tags; i++ {
+				// each worker interns the tags in its own order
+				tag := (i*(w+1) + w) % tags
+				handles[w] = append(handles[w], in.InternString("tag-"+strconv.Itoa(tag)))
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for w := 0; w < workers; w++ {
+		for i, h := range handles[w] {
+			tag := (i*(w+1) + w) % tags
+			if h != in.InternString("tag-"+strconv.Itoa(tag)) {
+				t.Fatalf("Interner: concurrent intern failed: worker=%d tag=%d", w, tag)
+			}
+		}
+	}
+
+	if in.Len() != tags {
+		t.Errorf("Interner: concurrent length failed: length=%d expect=%d", in.Len(), tags)
+	}
+}
+
+func BenchmarkInterner_Intern(b *testing.B) {
+	in := NewInterner(0)
+	tags := make([]*String, 1000)
+	for i := range tags {
+		tags[i] = New().FromString("tag-" + strconv.Itoa(i))
+	}
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			in.Intern(tags[i%len(tags)])
+		}
+	})
+}
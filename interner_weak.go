@@ -0,0 +1,48 @@
+//go:build go1.24
+
+package stringx
+
+import (
+	"runtime"
+	"weak"
+)
+
+// NewWeakInterner is like NewInterner, but the Interner only holds handles
+// weakly, a handle is evicted once it is no longer referenced, and interning
+// the same payload again creates a new handle. It requires Go 1.24 for weak
+// pointers.
+func NewWeakInterner(shards int) *Interner {
+	return newInterner(shards, true)
+}
+
+type weakMap map[string]weak.Pointer[Interned]
+
+func newWeakMap() weakMap {
+	return make(weakMap)
+}
+
+func (m weakMap) lookup(b []byte) *Interned {
+	// string(b) in map index doesn't allocate
+	return m[string(b)].Value()
+}
+
+func (m weakMap) len() int {
+	return len(m)
+}
+
+// internWeak holds i weakly, shard must be locked
+func (s *internShard) internWeak(i *Interned) {
+	s.weak[i.str] = weak.Make(i)
+	runtime.AddCleanup(i, s.evict, i.str)
+}
+
+// evict deletes str from shard if its handle is collected, it may have been
+// interned again with a new handle before evict runs
+func (s *internShard) evict(str string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if wp, ok := s.weak[str]; ok && wp.Value() == nil {
+		delete(s.weak, str)
+	}
+}
@@ -0,0 +1,34 @@
+//go:build go1.24
+
+package stringx
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestInterner_Weak(t *testing.T) {
+	in := NewWeakInterner(2)
+
+	kept := in.InternString("kept")
+	for i := 0; i < 100; i++ {
+		in.InternString("dropped-" + strconv.Itoa(i))
+	}
+	if kept != in.InternString("kept") {
+		t.Fatalf("Interner: weak handle in use is not canonical")
+	}
+
+	// cleanups run on a separate goroutine after GC
+	deadline := time.Now().Add(5 * time.Second)
+	for in.Len() > 1 && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+
+	if in.Len() != 1 || kept != in.InternString("kept") {
+		t.Errorf("Interner: weak eviction failed: length=%d", in.Len())
+	}
+	runtime.KeepAlive(kept)
+}
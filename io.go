@@ -0,0 +1,267 @@
+package stringx
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"unicode/utf8"
+)
+
+var (
+	_ io.Writer       = (*String)(nil)
+	_ io.StringWriter = (*String)(nil)
+	_ io.ByteWriter   = (*String)(nil)
+	_ io.WriterTo     = (*String)(nil)
+	_ io.ReaderFrom   = (*String)(nil)
+
+	_ io.Reader      = (*Reader)(nil)
+	_ io.ByteScanner = (*Reader)(nil)
+	_ io.RuneScanner = (*Reader)(nil)
+	_ io.Seeker      = (*Reader)(nil)
+	_ io.ReaderAt    = (*Reader)(nil)
+	_ io.WriterTo    = (*Reader)(nil)
+)
+
+// minRead is the least free space ReadFrom makes before each Read, the same
+// as bytes.MinRead
+const minRead = 512
+
+func (s *String) WriteString(str string) (n int, err error) {
+	s.PushString(str)
+	return len(str), nil
+}
+
+func (s *String) WriteByte(c byte) error {
+	s.Push(c)
+	return nil
+}
+
+func (s *String) WriteRune(r rune) (n int, err error) {
+	// invalid runes are pushed as utf8.RuneError
+	l := s.len
+	s.PushRune(r)
+	return s.len - l, nil
+}
+
+// WriteTo writes payload of String to w, String is not consumed
+func (s *String) WriteTo(w io.Writer) (n int64, err error) {
+	payload := s.payload()
+
+	m, err := w.Write(payload)
+	if m != len(payload) && err == nil {
+		err = io.ErrShortWrite
+	}
+
+	return int64(m), err
+}
+
+// ReadFrom appends data read from r until io.EOF to String. If r reports
+// its remaining length by Len, or its size by Stat like os.File does, String
+// grows to fit it ahead, so reading a whole file takes one allocation.
+func (s *String) ReadFrom(r io.Reader) (n int64, err error) {
+	s.copycheck()
+	s.mutable()
+
+	size := sizeHint(r)
+	if size > 0 && s.len+size >= s.cap {
+		// one more byte, so the final Read returning io.EOF doesn't grow
+		s.grow(s.len + size + 1 - s.cap)
+	}
+	s.unshare(s.cap)
+
+	for {
+		// a presized String is filled up before growing
+		if free := s.cap - s.len; free == 0 || free < minRead && size <= 0 {
+			s.grow(minRead - free)
+		}
+
+		m, err := r.Read(s.mem[s.len:s.cap])
+		if m < 0 {
+			panic("String.ReadFrom: reader returned negative count from Read")
+		}
+
+		s.len += m
+		n += int64(m)
+
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+// sizeHint returns bytes expected from r, or 0 if unknown
+func sizeHint(r io.Reader) int {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return r.Len()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		if info, err := r.Stat(); err == nil && info.Mode().IsRegular() && int64(int(info.Size())) == info.Size() {
+			return int(info.Size())
+		}
+	}
+	return 0
+}
+
+// Reader returns a Reader over payload of String, which implements
+// io.Reader, io.ByteScanner, io.RuneScanner, io.Seeker, io.ReaderAt and
+// io.WriterTo. Reader shares memory with String, so it is only valid until
+// String is mutated, like other iterators.
+func (s *String) Reader() *Reader {
+	return &Reader{
+		b:        s.borrow("Reader"),
+		mem:      s.payload(),
+		idx:      0,
+		prevRune: -1,
+	}
+}
+
+// Reader reads payload of a String, see String.Reader
+type Reader struct {
+	b   borrow
+	mem []byte
+	idx int64
+	// prevRune is index of the rune read by the last ReadRune, or -1
+	prevRune int
+}
+
+// Len returns the number of unread bytes
+func (r *Reader) Len() int {
+	if r.idx >= int64(len(r.mem)) {
+		return 0
+	}
+	return int(int64(len(r.mem)) - r.idx)
+}
+
+// Size returns length of the whole payload, which is not affected by reads
+func (r *Reader) Size() int64 {
+	return int64(len(r.mem))
+}
+
+func (r *Reader) Read(b []byte) (n int, err error) {
+	r.b.check()
+	if r.idx >= int64(len(r.mem)) {
+		return 0, io.EOF
+	}
+
+	r.prevRune = -1
+	n = copy(b, r.mem[r.idx:])
+	r.idx += int64(n)
+	return n, nil
+}
+
+func (r *Reader) ReadAt(b []byte, off int64) (n int, err error) {
+	r.b.check()
+	if off < 0 {
+		return 0, errors.New("stringx: Reader.ReadAt: negative offset")
+	}
+	if off >= int64(len(r.mem)) {
+		return 0, io.EOF
+	}
+
+	n = copy(b, r.mem[off:])
+	if n < len(b) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *Reader) ReadByte() (byte, error) {
+	r.b.check()
+	r.prevRune = -1
+	if r.idx >= int64(len(r.mem)) {
+		return 0, io.EOF
+	}
+
+	c := r.mem[r.idx]
+	r.idx++
+	return c, nil
+}
+
+func (r *Reader) UnreadByte() error {
+	if r.idx <= 0 {
+		return errors.New("stringx: Reader.UnreadByte: at beginning of payload")
+	}
+
+	r.prevRune = -1
+	r.idx--
+	return nil
+}
+
+func (r *Reader) ReadRune() (ch rune, size int, err error) {
+	r.b.check()
+	if r.idx >= int64(len(r.mem)) {
+		r.prevRune = -1
+		return 0, 0, io.EOF
+	}
+
+	r.prevRune = int(r.idx)
+	if c := r.mem[r.idx]; c < utf8.RuneSelf {
+		r.idx++
+		return rune(c), 1, nil
+	}
+
+	ch, size = utf8.DecodeRune(r.mem[r.idx:])
+	r.idx += int64(size)
+	return ch, size, nil
+}
+
+func (r *Reader) UnreadRune() error {
+	if r.idx <= 0 {
+		return errors.New("stringx: Reader.UnreadRune: at beginning of payload")
+	}
+	if r.prevRune < 0 {
+		return errors.New("stringx: Reader.UnreadRune: previous operation was not ReadRune")
+	}
+
+	r.idx = int64(r.prevRune)
+	r.prevRune = -1
+	return nil
+}
+
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	r.prevRune = -1
+
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.idx + offset
+	case io.SeekEnd:
+		abs = int64(len(r.mem)) + offset
+	default:
+		return 0, errors.New("stringx: Reader.Seek: invalid whence")
+	}
+
+	if abs < 0 {
+		return 0, errors.New("stringx: Reader.Seek: negative position")
+	}
+
+	r.idx = abs
+	return abs, nil
+}
+
+// WriteTo writes unread bytes to w
+func (r *Reader) WriteTo(w io.Writer) (n int64, err error) {
+	r.b.check()
+	r.prevRune = -1
+	if r.idx >= int64(len(r.mem)) {
+		return 0, nil
+	}
+
+	unread := r.mem[r.idx:]
+	m, err := w.Write(unread)
+	if m > len(unread) {
+		panic("Reader.WriteTo: invalid Write count")
+	}
+
+	r.idx += int64(m)
+	n = int64(m)
+	if m != len(unread) && err == nil {
+		err = io.ErrShortWrite
+	}
+	return n, err
+}
@@ -0,0 +1,154 @@
+package stringx
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestString_Writers(t *testing.T) {
+	s := New()
+	_, _ = s.WriteString("ab")
+	_ = s.WriteByte('c')
+	n, _ := s.WriteRune('你')
+	m, _ := s.WriteRune(-1)
+	if !s.EqualToString("abc你�") || n != 3 || m != 3 {
+		t.Errorf("String: writers failed: String=%s n=%d m=%d", s.String(), n, m)
+	}
+
+	var buf bytes.Buffer
+	written, err := s.WriteTo(&buf)
+	if err != nil || written != int64(s.Length()) || buf.String() != s.String() {
+		t.Errorf("String: WriteTo failed: written=%d buf=%s err=%v", written, buf.String(), err)
+	}
+	// String is not consumed
+	if !s.EqualToString("abc你�") {
+		t.Errorf("String: WriteTo consumes String: String=%s", s.String())
+	}
+
+	if _, err := s.WriteTo(iotest.TruncateWriter(io.Discard, 2)); err != nil {
+		t.Errorf("String: WriteTo to truncate writer failed: err=%v", err)
+	}
+}
+
+func TestString_ReadFrom(t *testing.T) {
+	content := strings.Repeat("stringx ReadFrom\n", 1000)
+
+	for name, r := range map[string]io.Reader{
+		"strings.Reader": strings.NewReader(content),
+		"OneByteReader":  iotest.OneByteReader(strings.NewReader(content)),
+		"HalfReader":     iotest.HalfReader(strings.NewReader(content)),
+		"DataErrReader":  iotest.DataErrReader(strings.NewReader(content)),
+	} {
+		s := New()
+		s.PushString("head:")
+		n, err := s.ReadFrom(r)
+		if err != nil || n != int64(len(content)) || !s.EqualToString("head:"+content) {
+			t.Errorf("String: ReadFrom %s failed: n=%d err=%v", name, n, err)
+		}
+	}
+
+	timeout := New()
+	if _, err := timeout.ReadFrom(iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("ab")))); !errors.Is(err, iotest.ErrTimeout) {
+		t.Errorf("String: ReadFrom doesn't return error: err=%v", err)
+	}
+	if !timeout.EqualToString("a") {
+		t.Errorf("String: ReadFrom drops data before error: String=%s", timeout.String())
+	}
+}
+
+func TestString_ReadFromPresize(t *testing.T) {
+	content := strings.Repeat("x", 100000)
+
+	var s String
+	s.Init()
+	_, _ = s.ReadFrom(strings.NewReader(content))
+	if s.Capacity() != len(content)+1 && s.Capacity() != 1<<17 {
+		t.Errorf("String: ReadFrom doesn't presize: capacity=%d", s.Capacity())
+	}
+
+	path := filepath.Join(t.TempDir(), "content")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var file String
+	file.Init()
+	file.SetGrowthPolicy(GrowExact)
+	if _, err := file.ReadFrom(f); err != nil || !file.EqualToString(content) {
+		t.Fatalf("String: ReadFrom file failed: err=%v", err)
+	}
+	if file.Capacity() != len(content)+1 {
+		t.Errorf("String: ReadFrom doesn't presize by Stat: capacity=%d", file.Capacity())
+	}
+}
+
+func TestReader(t *testing.T) {
+	var s String
+	s.FromString("héllo, 世界")
+
+	if err := iotest.TestReader(s.Reader(), s.Bytes()); err != nil {
+		t.Errorf("Reader: %v", err)
+	}
+
+	r := s.Reader()
+	if r.Size() != int64(s.Length()) || r.Len() != s.Length() {
+		t.Errorf("Reader: size failed: size=%d len=%d", r.Size(), r.Len())
+	}
+
+	if c, _ := r.ReadByte(); c != 'h' {
+		t.Errorf("Reader: ReadByte failed: byte=%c", c)
+	}
+	if ch, size, _ := r.ReadRune(); ch != 'é' || size != 2 {
+		t.Errorf("Reader: ReadRune failed: rune=%c size=%d", ch, size)
+	}
+	if err := r.UnreadRune(); err != nil {
+		t.Errorf("Reader: UnreadRune failed: err=%v", err)
+	}
+	if err := r.UnreadRune(); err == nil {
+		t.Errorf("Reader: UnreadRune twice doesn't fail")
+	}
+	if ch, _, _ := r.ReadRune(); ch != 'é' {
+		t.Errorf("Reader: ReadRune after UnreadRune failed: rune=%c", ch)
+	}
+
+	if pos, err := r.Seek(-6, io.SeekEnd); err != nil || pos != int64(s.Length()-6) {
+		t.Errorf("Reader: Seek failed: pos=%d err=%v", pos, err)
+	}
+	rest, _ := io.ReadAll(r)
+	if string(rest) != "世界" {
+		t.Errorf("Reader: read after Seek failed: rest=%s", rest)
+	}
+	if _, err := r.Seek(-1, io.SeekStart); err == nil {
+		t.Errorf("Reader: Seek to negative position doesn't fail")
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if n, err := r.WriteTo(&buf); err != nil || n != int64(s.Length()) || buf.String() != s.String() {
+		t.Errorf("Reader: WriteTo failed: n=%d buf=%s err=%v", n, buf.String(), err)
+	}
+	if r.Len() != 0 {
+		t.Errorf("Reader: WriteTo doesn't consume Reader: len=%d", r.Len())
+	}
+
+	p := make([]byte, 4)
+	if n, err := r.ReadAt(p, 7); n != 4 || err != nil || string(p) != " 世" {
+		t.Errorf("Reader: ReadAt failed: n=%d p=%s err=%v", n, p, err)
+	}
+	if n, err := r.ReadAt(p, int64(s.Length()-3)); n != 3 || err != io.EOF {
+		t.Errorf("Reader: ReadAt at end failed: n=%d err=%v", n, err)
+	}
+}
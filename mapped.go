@@ -0,0 +1,100 @@
+package stringx
+
+import (
+	"fmt"
+	"os"
+	"unsafe"
+)
+
+// unmappedptr is self of the String of a closed Mapped, see deadcheck
+var unmappedptr = unsafe.Pointer(new(byte))
+
+// Mapped is a file mapped into memory read-only, whose content is used as a
+// frozen String without copying it onto the heap. Mapping is only supported
+// on Linux, on other platforms the file is read into memory instead.
+//
+// The String, and everything borrowed from it, like Views, iterators or the
+// string returned by Freeze, is only valid until Close. Build with
+// 'stringx_debug' tag to catch Views and iterators used after Close.
+type Mapped struct {
+	s      String
+	path   string
+	closed bool
+}
+
+// OpenMapped maps the file of path into memory read-only, Close it to unmap
+// the file when it is no longer used
+func OpenMapped(path string) (*Mapped, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	size := info.Size()
+	if int64(int(size)) != size {
+		return nil, fmt.Errorf("stringx: file %s is too large to map: size=%d", path, size)
+	}
+
+	var mem []byte
+	// an empty file can't be mapped, nor does it need to be
+	if size > 0 {
+		if mem, err = mmap(f, int(size)); err != nil {
+			return nil, fmt.Errorf("stringx: cannot map file %s: %w", path, err)
+		}
+	}
+
+	m := &Mapped{path: path}
+	m.s.build(mem, len(mem), len(mem))
+	m.s.frozen = true
+	// clones must copy, they outlive the mapping
+	m.s.shared.Store(unshareable)
+
+	return m, nil
+}
+
+// ToString returns the frozen String of mapped content, all read-only
+// methods work on it, and mutating methods panic, Clone it to get a mutable
+// copy on the heap
+func (m *Mapped) ToString() *String {
+	return &m.s
+}
+
+// View returns a View of the whole mapped content
+func (m *Mapped) View() View {
+	return m.s.View()
+}
+
+func (m *Mapped) Len() int {
+	return m.s.len
+}
+
+// Close unmaps the file, any later use of the String of Mapped panics
+func (m *Mapped) Close() error {
+	if m.closed {
+		return fmt.Errorf("stringx: mapped file %s is already closed", m.path)
+	}
+	m.closed = true
+
+	// invalidates borrows, the String itself is frozen so it can't mutate
+	m.s.gen.bump()
+
+	mem := m.s.mem
+	m.s.self = unmappedptr
+	m.s.mem = nil
+	m.s.len = 0
+	m.s.cap = 0
+
+	if mem == nil {
+		return nil
+	}
+	if err := munmap(mem); err != nil {
+		return fmt.Errorf("stringx: cannot unmap file %s: %w", m.path, err)
+	}
+	return nil
+}
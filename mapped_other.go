@@ -0,0 +1,21 @@
+//go:build !linux
+
+package stringx
+
+import (
+	"io"
+	"os"
+)
+
+// mmap reads the file into memory, since mapping is only supported on Linux
+func mmap(f *os.File, size int) ([]byte, error) {
+	mem := make([]byte, size)
+	if _, err := io.ReadFull(f, mem); err != nil {
+		return nil, err
+	}
+	return mem, nil
+}
+
+func munmap(mem []byte) error {
+	return nil
+}
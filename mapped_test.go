@@ -0,0 +1,108 @@
+package stringx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTemp(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "mapped")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOpenMapped(t *testing.T) {
+	content := "apple,banana\r\ncherry\n中文,词典\n"
+	m, err := OpenMapped(writeTemp(t, content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	s := m.ToString()
+	if !s.EqualToString(content) || m.Len() != len(content) || !s.IsFrozen() {
+		t.Fatalf("Mapped: content failed: String=%q", s.String())
+	}
+
+	if lines := s.Lines(); lines.Next() && !lines.Value().EqualToString("apple,banana") {
+		t.Errorf("Mapped: Lines failed: line=%q", lines.Value().String())
+	}
+	if pieces := s.SplitSlice(","); len(pieces) != 3 || !pieces[1].EqualToString("banana\r\ncherry\n中文") {
+		t.Errorf("Mapped: Split failed: pieces=%d", len(pieces))
+	}
+	if s.Find("cherry") != 14 || !s.Contains("词典") || !m.View().TrimSpace().HasSuffix("词典") {
+		t.Errorf("Mapped: Find failed: index=%d", s.Find("cherry"))
+	}
+
+	cloned := s.Clone()
+	cloned.ToUpper()
+	if !strings.HasPrefix(cloned.String(), "APPLE") || !s.HasPrefix("apple") {
+		t.Errorf("Mapped: clone failed: clone=%q", cloned.String())
+	}
+}
+
+func TestMapped_Mutate(t *testing.T) {
+	m, err := OpenMapped(writeTemp(t, "read only content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	// writing mapped memory would crash instead of panic
+	for name, mutate := range freezeMutators {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Mapped: %s doesn't panic", name)
+				}
+			}()
+			mutate(m.ToString())
+		}()
+	}
+
+	if !m.ToString().EqualToString("read only content") {
+		t.Errorf("Mapped: content changes: String=%q", m.ToString().String())
+	}
+}
+
+func TestMapped_Close(t *testing.T) {
+	m, err := OpenMapped(writeTemp(t, "closed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := m.ToString()
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Close(); err == nil {
+		t.Errorf("Mapped: close twice doesn't fail")
+	}
+
+	defer func() {
+		if msg := recover(); msg != "String: illegal use of unmapped value" {
+			t.Errorf("Mapped: use after Close doesn't panic: msg=%v", msg)
+		}
+	}()
+	_ = s.Contains("c")
+}
+
+func TestMapped_Empty(t *testing.T) {
+	m, err := OpenMapped(writeTemp(t, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.ToString().IsEmpty() || m.ToString().Lines().Next() || m.Close() != nil {
+		t.Errorf("Mapped: empty file failed")
+	}
+
+	if _, err := OpenMapped(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("Mapped: open missing file doesn't fail")
+	}
+}
@@ -0,0 +1,294 @@
+package stringx
+
+import "sort"
+
+// Matcher is a compiled set of patterns, which finds all of them in String
+// with one pass over the payload, using the Aho-Corasick automaton. A Matcher
+// is immutable after NewMatcher, so it can be shared between goroutines.
+type Matcher struct {
+	patterns []string
+	nodes    []acNode
+	// root holds transitions of the root node for every byte
+	root [256]int32
+}
+
+type acNode struct {
+	edges []acEdge
+	fail  int32
+	// depth is the length of the prefix represented by this node
+	depth int32
+	// pattern is the index of the pattern ending at this node, or -1
+	pattern int32
+	// output is the nearest node on fail chain with a pattern, or -1
+	output int32
+}
+
+type acEdge struct {
+	b  byte
+	to int32
+}
+
+// Match is an instance of a pattern in String, payload[Start:End] equals
+// to the Pattern-th pattern of the Matcher
+type Match struct {
+	Start   int
+	End     int
+	Pattern int
+}
+
+// NewMatcher compiles patterns into a Matcher, empty patterns are ignored,
+// and only the first of duplicated patterns is reported
+func NewMatcher(patterns ...string) *Matcher {
+	m := &Matcher{
+		patterns: patterns,
+		nodes:    []acNode{{pattern: -1, output: -1}},
+	}
+
+	for i, pat := range patterns {
+		if len(pat) == 0 {
+			continue
+		}
+
+		var n int32
+		for j := 0; j < len(pat); j++ {
+			next := m.child(n, pat[j])
+			if next < 0 {
+				next = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{
+					depth:   int32(j + 1),
+					pattern: -1,
+					output:  -1,
+				})
+				m.addEdge(n, pat[j], next)
+			}
+			n = next
+		}
+
+		if m.nodes[n].pattern < 0 {
+			m.nodes[n].pattern = int32(i)
+		}
+	}
+
+	for b := 0; b < 256; b++ {
+		if next := m.child(0, byte(b)); next >= 0 {
+			m.root[b] = next
+		}
+	}
+
+	// compute fail and output links in BFS order
+	queue := make([]int32, 0, len(m.nodes))
+	for _, e := range m.nodes[0].edges {
+		queue = append(queue, e.to)
+	}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		for _, e := range m.nodes[n].edges {
+			child := &m.nodes[e.to]
+			child.fail = m.step(m.nodes[n].fail, e.b)
+			if fail := &m.nodes[child.fail]; fail.pattern >= 0 {
+				child.output = child.fail
+			} else {
+				child.output = fail.output
+			}
+			queue = append(queue, e.to)
+		}
+	}
+
+	return m
+}
+
+// addEdge adds an edge from n to next by b, edges are kept sorted by b
+func (m *Matcher) addEdge(n int32, b byte, next int32) {
+	edges := m.nodes[n].edges
+	i := sort.Search(len(edges), func(i int) bool { return edges[i].b >= b })
+	edges = append(edges, acEdge{})
+	copy(edges[i+1:], edges[i:])
+	edges[i] = acEdge{b, next}
+	m.nodes[n].edges = edges
+}
+
+func (m *Matcher) child(n int32, b byte) int32 {
+	edges := m.nodes[n].edges
+	i := sort.Search(len(edges), func(i int) bool { return edges[i].b >= b })
+	if i < len(edges) && edges[i].b == b {
+		return edges[i].to
+	}
+	return -1
+}
+
+// step returns the state after reading b at state n
+func (m *Matcher) step(n int32, b byte) int32 {
+	for n != 0 {
+		if next := m.child(n, b); next >= 0 {
+			return next
+		}
+		n = m.nodes[n].fail
+	}
+	return m.root[b]
+}
+
+func (m *Matcher) Patterns() []string {
+	return m.patterns
+}
+
+// findAll returns all non-overlapping leftmost-longest matches in b with one
+// pass, the automaton state is carried across matches. Matches seen but not
+// yet reported are pending, the longest one for each start, and the leftmost
+// of them is reported once no later match can start at or before it.
+func (m *Matcher) findAll(b []byte) []Match {
+	var (
+		matches []Match
+		pending []Match
+		n       int32
+	)
+
+	for i := 0; i < len(b); i++ {
+		n = m.step(n, b[i])
+
+		// any match ending from i starts at i+1-depth or later
+		matches, pending = commitMatches(matches, pending, i+1-int(m.nodes[n].depth))
+
+		for o := n; o >= 0; o = m.nodes[o].output {
+			node := &m.nodes[o]
+			if node.pattern < 0 {
+				continue
+			}
+			start := i + 1 - int(node.depth)
+			// overlaps the last reported match
+			if len(matches) > 0 && start < matches[len(matches)-1].End {
+				continue
+			}
+			pending = addMatch(pending, Match{Start: start, End: i + 1, Pattern: int(node.pattern)})
+		}
+	}
+
+	matches, _ = commitMatches(matches, pending, len(b)+1)
+	return matches
+}
+
+// addMatch adds match to pending, which replaces the shorter one of the same
+// start
+func addMatch(pending []Match, match Match) []Match {
+	for i := range pending {
+		if pending[i].Start == match.Start {
+			if match.End > pending[i].End {
+				pending[i] = match
+			}
+			return pending
+		}
+	}
+	return append(pending, match)
+}
+
+// commitMatches moves the leftmost pending match to matches while it starts
+// before lower, pending matches overlapping it are dropped
+func commitMatches(matches, pending []Match, lower int) ([]Match, []Match) {
+	for len(pending) > 0 {
+		first := 0
+		for i := range pending {
+			if pending[i].Start < pending[first].Start {
+				first = i
+			}
+		}
+
+		match := pending[first]
+		if match.Start >= lower {
+			break
+		}
+		matches = append(matches, match)
+
+		kept := pending[:0]
+		for _, p := range pending {
+			if p.Start >= match.End {
+				kept = append(kept, p)
+			}
+		}
+		pending = kept
+	}
+	return matches, pending
+}
+
+// FindAll returns all non-overlapping instances of patterns in String, with
+// leftmost-longest semantics: the match starting first wins, and the longest
+// pattern wins among those starting at the same index
+func (s *String) FindAll(m *Matcher) []Match {
+	return m.findAll(s.payload())
+}
+
+// ContainsAny reports whether any pattern of Matcher is in String
+func (s *String) ContainsAny(m *Matcher) bool {
+	var n int32
+	for _, b := range s.payload() {
+		n = m.step(n, b)
+		if m.nodes[n].pattern >= 0 || m.nodes[n].output >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Replacer replaces many patterns in one pass, see String.ReplaceAll
+type Replacer struct {
+	matcher *Matcher
+	with    []string
+}
+
+// NewReplacer compiles replacements, which maps patterns to their replacement,
+// into a Replacer. Replacer is immutable and can be reused for many Strings.
+func NewReplacer(replacements map[string]string) *Replacer {
+	patterns := make([]string, 0, len(replacements))
+	for from := range replacements {
+		patterns = append(patterns, from)
+	}
+	// make the automaton layout deterministic
+	sort.Strings(patterns)
+
+	with := make([]string, len(patterns))
+	for i, from := range patterns {
+		with[i] = replacements[from]
+	}
+
+	return &Replacer{
+		matcher: NewMatcher(patterns...),
+		with:    with,
+	}
+}
+
+// Replace replaces all non-overlapping instances of patterns in String in
+// place, matches are found with leftmost-longest semantics
+func (r *Replacer) Replace(s *String) {
+	s.mutable()
+
+	payload := s.payload()
+	matches := r.matcher.findAll(payload)
+	if len(matches) == 0 {
+		return
+	}
+
+	size := s.len
+	for _, match := range matches {
+		size += len(r.with[match.Pattern]) - (match.End - match.Start)
+	}
+
+	mem := make([]byte, 0, size)
+	var last int
+	for _, match := range matches {
+		mem = append(mem, payload[last:match.Start]...)
+		mem = append(mem, r.with[match.Pattern]...)
+		last = match.End
+	}
+	mem = append(mem, payload[last:]...)
+
+	s.Reset()
+	s.PushBytes(mem)
+}
+
+// ReplaceAll replaces all instances of the keys of replacements with their
+// values in one pass. Compile a Replacer by NewReplacer instead when the same
+// replacements are applied to many Strings.
+func (s *String) ReplaceAll(replacements map[string]string) {
+	NewReplacer(replacements).Replace(s)
+}
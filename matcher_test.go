@@ -0,0 +1,110 @@
+package stringx
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// findAllNaive finds leftmost-longest matches by trying every pattern at
+// every index
+func findAllNaive(s string, patterns []string) []Match {
+	var matches []Match
+	for i := 0; i < len(s); {
+		best := -1
+		for j, pat := range patterns {
+			if pat != "" && strings.HasPrefix(s[i:], pat) && (best < 0 || len(pat) > len(patterns[best])) {
+				best = j
+			}
+		}
+		if best < 0 {
+			i++
+			continue
+		}
+		matches = append(matches, Match{Start: i, End: i + len(patterns[best]), Pattern: best})
+		i += len(patterns[best])
+	}
+	return matches
+}
+
+func TestString_FindAll(t *testing.T) {
+	var s String
+	for i := 0; i < 200; i++ {
+		patterns := make([]string, rand.Intn(6)+1)
+		for j := range patterns {
+			patterns[j] = strings.Repeat("ab", rand.Intn(3)) + strings.Repeat("b", rand.Intn(3))
+		}
+		str := strings.Repeat("abcab", rand.Intn(4)) + "abbba你好ab"
+
+		s.FromString(str)
+		matches, expect := s.FindAll(NewMatcher(patterns...)), findAllNaive(str, patterns)
+		if len(matches) != len(expect) {
+			t.Fatalf("Matcher: match count mismatch: string=%s patterns=%q matches=%v expect=%v",
+				str, patterns, matches, expect)
+		}
+		for j := range matches {
+			if matches[j].Start != expect[j].Start || matches[j].End != expect[j].End {
+				t.Fatalf("Matcher: match mismatch: string=%s patterns=%q matches=%v expect=%v",
+					str, patterns, matches, expect)
+			}
+		}
+	}
+}
+
+func TestString_ContainsAny(t *testing.T) {
+	var s String
+	s.FromString("the quick brown fox")
+	if !s.ContainsAny(NewMatcher("cat", "own f")) {
+		t.Errorf("Matcher: contains any failed")
+	}
+	if s.ContainsAny(NewMatcher("cat", "dog", "")) {
+		t.Errorf("Matcher: contains any reports absent patterns")
+	}
+}
+
+func TestString_ReplaceAll(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		s            string
+		replacements map[string]string
+		expect       string
+	}{
+		{"token=abc secret=def", map[string]string{"abc": "***", "def": "***"}, "token=*** secret=***"},
+		{"abcd", map[string]string{"ab": "1", "abc": "2", "bcd": "3"}, "2d"},
+		{"aaaa", map[string]string{"a": "b", "aa": "c"}, "cc"},
+		{"ababa", map[string]string{"ab": "1", "ababb": "2"}, "11a"},
+		{"你好世界", map[string]string{"好": "", "世界": "World"}, "你World"},
+		{"no match", map[string]string{"xyz": "abc"}, "no match"},
+	} {
+		s.FromString(data.s)
+		s.ReplaceAll(data.replacements)
+		if !s.EqualToString(data.expect) {
+			t.Errorf("String: replace all failed: before=%s after=%s expect=%s",
+				data.s, s.String(), data.expect)
+		}
+	}
+}
+
+func BenchmarkReplacer_Replace(b *testing.B) {
+	replacements := map[string]string{}
+	for i := 0; i < 30; i++ {
+		replacements["secret"+Int(i).String()] = "***"
+	}
+	r := NewReplacer(replacements)
+
+	var s String
+	for i := 0; i < b.N; i++ {
+		s.FromString("user=alice secret12 token secret3 and secret29, secret7")
+		r.Replace(&s)
+	}
+}
+
+func BenchmarkString_ReplaceMany(b *testing.B) {
+	var s String
+	for i := 0; i < b.N; i++ {
+		s.FromString("user=alice secret12 token secret3 and secret29, secret7")
+		for j := 29; j >= 0; j-- {
+			s.Replace("secret"+Int(j).String(), "***")
+		}
+	}
+}
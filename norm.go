@@ -0,0 +1,367 @@
+package stringx
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+//go:generate go run -C internal/gen . norm ../../norm_table.go
+
+// NormForm is a Unicode normalization form, see
+// https://www.unicode.org/reports/tr15/
+type NormForm int
+
+const (
+	// NFC is canonical decomposition followed by canonical composition
+	NFC NormForm = iota
+	// NFD is canonical decomposition
+	NFD
+	// NFKC is compatibility decomposition followed by canonical composition
+	NFKC
+	// NFKD is compatibility decomposition
+	NFKD
+)
+
+func (f NormForm) String() string {
+	switch f {
+	case NFC:
+		return "NFC"
+	case NFD:
+		return "NFD"
+	case NFKC:
+		return "NFKC"
+	case NFKD:
+		return "NFKD"
+	}
+	return "NormForm(" + Int(f).String() + ")"
+}
+
+func (f NormForm) compat() bool {
+	return f == NFKC || f == NFKD
+}
+
+func (f NormForm) compose() bool {
+	return f == NFC || f == NFKC
+}
+
+type cccRange struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+type decompEntry struct {
+	r rune
+	// canonical decomposition is decompRunes[off:off+n], n is 0 if the rune
+	// has compatibility decomposition only
+	off uint16
+	n   uint8
+	// compatibility decomposition is decompRunes[koff:koff+kn]
+	koff uint16
+	kn   uint8
+}
+
+type compositionPair struct {
+	a, b, c rune
+}
+
+const (
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulNCount = hangulVCount * hangulTCount
+)
+
+func combiningClass(r rune) uint8 {
+	if r < 0x0300 {
+		return 0
+	}
+
+	lo, hi := 0, len(cccTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch g := &cccTable[m]; {
+		case r < g.lo:
+			hi = m
+		case r > g.hi:
+			lo = m + 1
+		default:
+			return g.ccc
+		}
+	}
+
+	return 0
+}
+
+// decompose appends full decomposition of r to dst
+func decompose(dst []rune, r rune, compat bool) []rune {
+	if r < 0x00A0 {
+		return append(dst, r)
+	}
+
+	if hangulSBase <= r && r <= hangulSLast {
+		s := r - hangulSBase
+		dst = append(dst, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	}
+
+	lo, hi := 0, len(decompTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch e := &decompTable[m]; {
+		case r < e.r:
+			hi = m
+		case r > e.r:
+			lo = m + 1
+		case compat:
+			return append(dst, decompRunes[e.koff:int(e.koff)+int(e.kn)]...)
+		case e.n > 0:
+			return append(dst, decompRunes[e.off:int(e.off)+int(e.n)]...)
+		default:
+			return append(dst, r)
+		}
+	}
+
+	return append(dst, r)
+}
+
+// compose returns the primary composite of a and b, or -1 if there is none
+func compose(a, b rune) rune {
+	// Hangul LV
+	if l, v := a-hangulLBase, b-hangulVBase; 0 <= l && l < hangulLCount && 0 <= v && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount
+	}
+
+	// Hangul LVT
+	if s, t := a-hangulSBase, b-hangulTBase; 0 <= s && s < hangulLCount*hangulNCount && s%hangulTCount == 0 && 0 < t && t < hangulTCount {
+		return a + t
+	}
+
+	lo, hi := 0, len(compositionTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch p := &compositionTable[m]; {
+		case a < p.a || a == p.a && b < p.b:
+			hi = m
+		case a > p.a || b > p.b:
+			lo = m + 1
+		default:
+			return p.c
+		}
+	}
+
+	return -1
+}
+
+// composesBackward reports whether r may compose with a preceding rune
+func composesBackward(r rune) bool {
+	if hangulVBase <= r && r < hangulVBase+hangulVCount || hangulTBase < r && r < hangulTBase+hangulTCount {
+		return true
+	}
+
+	lo, hi := 0, len(compositionSecond)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch c := compositionSecond[m]; {
+		case r < c:
+			hi = m
+		case r > c:
+			lo = m + 1
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeRunes normalizes runes in place and returns the result, which
+// may be longer than runes
+func normalizeRunes(runes []rune, form NormForm) []rune {
+	var decomposed []rune
+	for _, r := range runes {
+		decomposed = decompose(decomposed, r, form.compat())
+	}
+
+	// canonical ordering: stable sort each run of non-starters by their
+	// combining classes, runs are short, so insertion sort is enough
+	for i := 1; i < len(decomposed); i++ {
+		ccc := combiningClass(decomposed[i])
+		if ccc == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			prev := combiningClass(decomposed[j-1])
+			if prev <= ccc {
+				break
+			}
+			decomposed[j-1], decomposed[j] = decomposed[j], decomposed[j-1]
+		}
+	}
+
+	if !form.compose() || len(decomposed) < 2 {
+		return decomposed
+	}
+
+	// canonical composition, see UAX #15 'Detailed Composition Algorithm'
+	var (
+		starter  = 0
+		composed = decomposed[:1]
+		// last is the combining class of last rune after starter, a value
+		// of 256 blocks any composition with starter
+		last = int(combiningClass(decomposed[0]))
+	)
+
+	if last != 0 {
+		last = 256
+	}
+
+	for _, r := range decomposed[1:] {
+		ccc := int(combiningClass(r))
+		if last < ccc || last == 0 && starter == len(composed)-1 {
+			if c := compose(composed[starter], r); c >= 0 {
+				composed[starter] = c
+				continue
+			}
+		}
+
+		if ccc == 0 {
+			starter = len(composed)
+		}
+		last = ccc
+		composed = append(composed, r)
+	}
+
+	return composed
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize transforms String into the given normalization form in place.
+// Invalid UTF-8 bytes are replaced by U+FFFD.
+func (s *String) Normalize(form NormForm) {
+	s.copycheck()
+	s.mutable()
+
+	if isASCII(s.payload()) {
+		return
+	}
+
+	s.setRunes(normalizeRunes(s.RuneSlice(), form))
+}
+
+// IsNormalized reports whether String is already in the given normalization form
+func (s *String) IsNormalized(form NormForm) bool {
+	if isASCII(s.payload()) {
+		return true
+	}
+
+	var n int
+	for _, r := range normalizeRunes(s.RuneSlice(), form) {
+		var buf [utf8.UTFMax]byte
+		size := utf8.EncodeRune(buf[:], r)
+		if n+size > s.len || !bytes.Equal(buf[:size], s.mem[n:n+size]) {
+			return false
+		}
+		n += size
+	}
+
+	return n == s.len
+}
+
+// Normalize returns an iterator which yields remaining runes in the given
+// normalization form. Runes are normalized segment by segment, a segment
+// ends before a starter which can't interact with preceding runes, so only
+// one segment is buffered at a time.
+func (r *Runes) Normalize(form NormForm) *NormRunes {
+	return &NormRunes{
+		runes: r,
+		form:  form,
+	}
+}
+
+var _ Iterator[rune] = (*NormRunes)(nil)
+
+type NormRunes struct {
+	runes *Runes
+	form  NormForm
+
+	// segment holds normalized runes of current segment, which are not
+	// consumed yet
+	segment []rune
+	idx     int
+	val     rune
+}
+
+// boundary reports whether a normalization segment may start before c
+func (n *NormRunes) boundary(c rune) bool {
+	var buf [1]rune
+	d := decompose(buf[:0], c, n.form.compat())
+	if combiningClass(d[0]) != 0 {
+		return false
+	}
+	return !n.form.compose() || !composesBackward(d[0])
+}
+
+func (n *NormRunes) fill() {
+	n.segment, n.idx = n.segment[:0], 0
+
+	mem := n.runes.mem
+	for n.runes.idx < len(mem) {
+		c, size := utf8.DecodeRune(mem[n.runes.idx:])
+		if len(n.segment) > 0 && n.boundary(c) {
+			break
+		}
+		n.segment = append(n.segment, c)
+		n.runes.idx += size
+	}
+
+	n.segment = normalizeRunes(n.segment, n.form)
+}
+
+func (n *NormRunes) Next() (hasNext bool) {
+	n.runes.b.check()
+	if n.idx >= len(n.segment) {
+		n.fill()
+	}
+
+	hasNext = n.idx < len(n.segment)
+	if hasNext {
+		n.val = n.segment[n.idx]
+		n.idx++
+	} else {
+		n.val = utf8.RuneError
+	}
+	return hasNext
+}
+
+func (n *NormRunes) Value() rune {
+	return n.val
+}
+
+func (n *NormRunes) Size() (i int) {
+	for i = 0; n.Next(); i++ {
+	}
+	return i
+}
+
+func (n *NormRunes) Consume() []rune {
+	slice := make([]rune, 0)
+
+	for n.Next() {
+		slice = append(slice, n.Value())
+	}
+
+	return slice
+}
@@ -0,0 +1,8160 @@
+// Code generated by internal/gen from Unicode 17.0.0 data. DO NOT EDIT.
+
+package stringx
+
+// cccTable holds ranges of runes with non-zero Canonical_Combining_Class
+var cccTable = [...]cccRange{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0897, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ADC, 230},
+	{0x1ADD, 0x1ADD, 220},
+	{0x1AE0, 0x1AE5, 230},
+	{0x1AE6, 0x1AE6, 220},
+	{0x1AE7, 0x1AEA, 230},
+	{0x1AEB, 0x1AEB, 234},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10D69, 0x10D6D, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFA, 0x10EFB, 220},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x113CE, 0x113D0, 9},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x1612F, 0x1612F, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E5EE, 0x1E5EE, 230},
+	{0x1E5EF, 0x1E5EF, 220},
+	{0x1E6E3, 0x1E6E3, 230},
+	{0x1E6E6, 0x1E6E6, 230},
+	{0x1E6EE, 0x1E6EF, 230},
+	{0x1E6F5, 0x1E6F5, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+// decompTable holds full canonical and compatibility decompositions of
+// runes, as slices of decompRunes. Hangul syllables are decomposed
+// arithmetically and not listed.
+var decompTable = [...]decompEntry{
+	{0x00A0, 0, 0, 0, 1},
+	{0x00A8, 0, 0, 1, 2},
+	{0x00AA, 0, 0, 3, 1},
+	{0x00AF, 0, 0, 4, 2},
+	{0x00B2, 0, 0, 6, 1},
+	{0x00B3, 0, 0, 7, 1},
+	{0x00B4, 0, 0, 8, 2},
+	{0x00B5, 0, 0, 10, 1},
+	{0x00B8, 0, 0, 11, 2},
+	{0x00B9, 0, 0, 13, 1},
+	{0x00BA, 0, 0, 14, 1},
+	{0x00BC, 0, 0, 15, 3},
+	{0x00BD, 0, 0, 18, 3},
+	{0x00BE, 0, 0, 21, 3},
+	{0x00C0, 24, 2, 24, 2},
+	{0x00C1, 26, 2, 26, 2},
+	{0x00C2, 28, 2, 28, 2},
+	{0x00C3, 30, 2, 30, 2},
+	{0x00C4, 32, 2, 32, 2},
+	{0x00C5, 34, 2, 34, 2},
+	{0x00C7, 36, 2, 36, 2},
+	{0x00C8, 38, 2, 38, 2},
+	{0x00C9, 40, 2, 40, 2},
+	{0x00CA, 42, 2, 42, 2},
+	{0x00CB, 44, 2, 44, 2},
+	{0x00CC, 46, 2, 46, 2},
+	{0x00CD, 48, 2, 48, 2},
+	{0x00CE, 50, 2, 50, 2},
+	{0x00CF, 52, 2, 52, 2},
+	{0x00D1, 54, 2, 54, 2},
+	{0x00D2, 56, 2, 56, 2},
+	{0x00D3, 58, 2, 58, 2},
+	{0x00D4, 60, 2, 60, 2},
+	{0x00D5, 62, 2, 62, 2},
+	{0x00D6, 64, 2, 64, 2},
+	{0x00D9, 66, 2, 66, 2},
+	{0x00DA, 68, 2, 68, 2},
+	{0x00DB, 70, 2, 70, 2},
+	{0x00DC, 72, 2, 72, 2},
+	{0x00DD, 74, 2, 74, 2},
+	{0x00E0, 76, 2, 76, 2},
+	{0x00E1, 78, 2, 78, 2},
+	{0x00E2, 80, 2, 80, 2},
+	{0x00E3, 82, 2, 82, 2},
+	{0x00E4, 84, 2, 84, 2},
+	{0x00E5, 86, 2, 86, 2},
+	{0x00E7, 88, 2, 88, 2},
+	{0x00E8, 90, 2, 90, 2},
+	{0x00E9, 92, 2, 92, 2},
+	{0x00EA, 94, 2, 94, 2},
+	{0x00EB, 96, 2, 96, 2},
+	{0x00EC, 98, 2, 98, 2},
+	{0x00ED, 100, 2, 100, 2},
+	{0x00EE, 102, 2, 102, 2},
+	{0x00EF, 104, 2, 104, 2},
+	{0x00F1, 106, 2, 106, 2},
+	{0x00F2, 108, 2, 108, 2},
+	{0x00F3, 110, 2, 110, 2},
+	{0x00F4, 112, 2, 112, 2},
+	{0x00F5, 114, 2, 114, 2},
+	{0x00F6, 116, 2, 116, 2},
+	{0x00F9, 118, 2, 118, 2},
+	{0x00FA, 120, 2, 120, 2},
+	{0x00FB, 122, 2, 122, 2},
+	{0x00FC, 124, 2, 124, 2},
+	{0x00FD, 126, 2, 126, 2},
+	{0x00FF, 128, 2, 128, 2},
+	{0x0100, 130, 2, 130, 2},
+	{0x0101, 132, 2, 132, 2},
+	{0x0102, 134, 2, 134, 2},
+	{0x0103, 136, 2, 136, 2},
+	{0x0104, 138, 2, 138, 2},
+	{0x0105, 140, 2, 140, 2},
+	{0x0106, 142, 2, 142, 2},
+	{0x0107, 144, 2, 144, 2},
+	{0x0108, 146, 2, 146, 2},
+	{0x0109, 148, 2, 148, 2},
+	{0x010A, 150, 2, 150, 2},
+	{0x010B, 152, 2, 152, 2},
+	{0x010C, 154, 2, 154, 2},
+	{0x010D, 156, 2, 156, 2},
+	{0x010E, 158, 2, 158, 2},
+	{0x010F, 160, 2, 160, 2},
+	{0x0112, 162, 2, 162, 2},
+	{0x0113, 164, 2, 164, 2},
+	{0x0114, 166, 2, 166, 2},
+	{0x0115, 168, 2, 168, 2},
+	{0x0116, 170, 2, 170, 2},
+	{0x0117, 172, 2, 172, 2},
+	{0x0118, 174, 2, 174, 2},
+	{0x0119, 176, 2, 176, 2},
+	{0x011A, 178, 2, 178, 2},
+	{0x011B, 180, 2, 180, 2},
+	{0x011C, 182, 2, 182, 2},
+	{0x011D, 184, 2, 184, 2},
+	{0x011E, 186, 2, 186, 2},
+	{0x011F, 188, 2, 188, 2},
+	{0x0120, 190, 2, 190, 2},
+	{0x0121, 192, 2, 192, 2},
+	{0x0122, 194, 2, 194, 2},
+	{0x0123, 196, 2, 196, 2},
+	{0x0124, 198, 2, 198, 2},
+	{0x0125, 200, 2, 200, 2},
+	{0x0128, 202, 2, 202, 2},
+	{0x0129, 204, 2, 204, 2},
+	{0x012A, 206, 2, 206, 2},
+	{0x012B, 208, 2, 208, 2},
+	{0x012C, 210, 2, 210, 2},
+	{0x012D, 212, 2, 212, 2},
+	{0x012E, 214, 2, 214, 2},
+	{0x012F, 216, 2, 216, 2},
+	{0x0130, 218, 2, 218, 2},
+	{0x0132, 0, 0, 220, 2},
+	{0x0133, 0, 0, 222, 2},
+	{0x0134, 224, 2, 224, 2},
+	{0x0135, 226, 2, 226, 2},
+	{0x0136, 228, 2, 228, 2},
+	{0x0137, 230, 2, 230, 2},
+	{0x0139, 232, 2, 232, 2},
+	{0x013A, 234, 2, 234, 2},
+	{0x013B, 236, 2, 236, 2},
+	{0x013C, 238, 2, 238, 2},
+	{0x013D, 240, 2, 240, 2},
+	{0x013E, 242, 2, 242, 2},
+	{0x013F, 0, 0, 244, 2},
+	{0x0140, 0, 0, 246, 2},
+	{0x0143, 248, 2, 248, 2},
+	{0x0144, 250, 2, 250, 2},
+	{0x0145, 252, 2, 252, 2},
+	{0x0146, 254, 2, 254, 2},
+	{0x0147, 256, 2, 256, 2},
+	{0x0148, 258, 2, 258, 2},
+	{0x0149, 0, 0, 260, 2},
+	{0x014C, 262, 2, 262, 2},
+	{0x014D, 264, 2, 264, 2},
+	{0x014E, 266, 2, 266, 2},
+	{0x014F, 268, 2, 268, 2},
+	{0x0150, 270, 2, 270, 2},
+	{0x0151, 272, 2, 272, 2},
+	{0x0154, 274, 2, 274, 2},
+	{0x0155, 276, 2, 276, 2},
+	{0x0156, 278, 2, 278, 2},
+	{0x0157, 280, 2, 280, 2},
+	{0x0158, 282, 2, 282, 2},
+	{0x0159, 284, 2, 284, 2},
+	{0x015A, 286, 2, 286, 2},
+	{0x015B, 288, 2, 288, 2},
+	{0x015C, 290, 2, 290, 2},
+	{0x015D, 292, 2, 292, 2},
+	{0x015E, 294, 2, 294, 2},
+	{0x015F, 296, 2, 296, 2},
+	{0x0160, 298, 2, 298, 2},
+	{0x0161, 300, 2, 300, 2},
+	{0x0162, 302, 2, 302, 2},
+	{0x0163, 304, 2, 304, 2},
+	{0x0164, 306, 2, 306, 2},
+	{0x0165, 308, 2, 308, 2},
+	{0x0168, 310, 2, 310, 2},
+	{0x0169, 312, 2, 312, 2},
+	{0x016A, 314, 2, 314, 2},
+	{0x016B, 316, 2, 316, 2},
+	{0x016C, 318, 2, 318, 2},
+	{0x016D, 320, 2, 320, 2},
+	{0x016E, 322, 2, 322, 2},
+	{0x016F, 324, 2, 324, 2},
+	{0x0170, 326, 2, 326, 2},
+	{0x0171, 328, 2, 328, 2},
+	{0x0172, 330, 2, 330, 2},
+	{0x0173, 332, 2, 332, 2},
+	{0x0174, 334, 2, 334, 2},
+	{0x0175, 336, 2, 336, 2},
+	{0x0176, 338, 2, 338, 2},
+	{0x0177, 340, 2, 340, 2},
+	{0x0178, 342, 2, 342, 2},
+	{0x0179, 344, 2, 344, 2},
+	{0x017A, 346, 2, 346, 2},
+	{0x017B, 348, 2, 348, 2},
+	{0x017C, 350, 2, 350, 2},
+	{0x017D, 352, 2, 352, 2},
+	{0x017E, 354, 2, 354, 2},
+	{0x017F, 0, 0, 356, 1},
+	{0x01A0, 357, 2, 357, 2},
+	{0x01A1, 359, 2, 359, 2},
+	{0x01AF, 361, 2, 361, 2},
+	{0x01B0, 363, 2, 363, 2},
+	{0x01C4, 0, 0, 365, 3},
+	{0x01C5, 0, 0, 368, 3},
+	{0x01C6, 0, 0, 371, 3},
+	{0x01C7, 0, 0, 374, 2},
+	{0x01C8, 0, 0, 376, 2},
+	{0x01C9, 0, 0, 378, 2},
+	{0x01CA, 0, 0, 380, 2},
+	{0x01CB, 0, 0, 382, 2},
+	{0x01CC, 0, 0, 384, 2},
+	{0x01CD, 386, 2, 386, 2},
+	{0x01CE, 388, 2, 388, 2},
+	{0x01CF, 390, 2, 390, 2},
+	{0x01D0, 392, 2, 392, 2},
+	{0x01D1, 394, 2, 394, 2},
+	{0x01D2, 396, 2, 396, 2},
+	{0x01D3, 398, 2, 398, 2},
+	{0x01D4, 400, 2, 400, 2},
+	{0x01D5, 402, 3, 402, 3},
+	{0x01D6, 405, 3, 405, 3},
+	{0x01D7, 408, 3, 408, 3},
+	{0x01D8, 411, 3, 411, 3},
+	{0x01D9, 414, 3, 414, 3},
+	{0x01DA, 417, 3, 417, 3},
+	{0x01DB, 420, 3, 420, 3},
+	{0x01DC, 423, 3, 423, 3},
+	{0x01DE, 426, 3, 426, 3},
+	{0x01DF, 429, 3, 429, 3},
+	{0x01E0, 432, 3, 432, 3},
+	{0x01E1, 435, 3, 435, 3},
+	{0x01E2, 438, 2, 438, 2},
+	{0x01E3, 440, 2, 440, 2},
+	{0x01E6, 442, 2, 442, 2},
+	{0x01E7, 444, 2, 444, 2},
+	{0x01E8, 446, 2, 446, 2},
+	{0x01E9, 448, 2, 448, 2},
+	{0x01EA, 450, 2, 450, 2},
+	{0x01EB, 452, 2, 452, 2},
+	{0x01EC, 454, 3, 454, 3},
+	{0x01ED, 457, 3, 457, 3},
+	{0x01EE, 460, 2, 460, 2},
+	{0x01EF, 462, 2, 462, 2},
+	{0x01F0, 464, 2, 464, 2},
+	{0x01F1, 0, 0, 466, 2},
+	{0x01F2, 0, 0, 468, 2},
+	{0x01F3, 0, 0, 470, 2},
+	{0x01F4, 472, 2, 472, 2},
+	{0x01F5, 474, 2, 474, 2},
+	{0x01F8, 476, 2, 476, 2},
+	{0x01F9, 478, 2, 478, 2},
+	{0x01FA, 480, 3, 480, 3},
+	{0x01FB, 483, 3, 483, 3},
+	{0x01FC, 486, 2, 486, 2},
+	{0x01FD, 488, 2, 488, 2},
+	{0x01FE, 490, 2, 490, 2},
+	{0x01FF, 492, 2, 492, 2},
+	{0x0200, 494, 2, 494, 2},
+	{0x0201, 496, 2, 496, 2},
+	{0x0202, 498, 2, 498, 2},
+	{0x0203, 500, 2, 500, 2},
+	{0x0204, 502, 2, 502, 2},
+	{0x0205, 504, 2, 504, 2},
+	{0x0206, 506, 2, 506, 2},
+	{0x0207, 508, 2, 508, 2},
+	{0x0208, 510, 2, 510, 2},
+	{0x0209, 512, 2, 512, 2},
+	{0x020A, 514, 2, 514, 2},
+	{0x020B, 516, 2, 516, 2},
+	{0x020C, 518, 2, 518, 2},
+	{0x020D, 520, 2, 520, 2},
+	{0x020E, 522, 2, 522, 2},
+	{0x020F, 524, 2, 524, 2},
+	{0x0210, 526, 2, 526, 2},
+	{0x0211, 528, 2, 528, 2},
+	{0x0212, 530, 2, 530, 2},
+	{0x0213, 532, 2, 532, 2},
+	{0x0214, 534, 2, 534, 2},
+	{0x0215, 536, 2, 536, 2},
+	{0x0216, 538, 2, 538, 2},
+	{0x0217, 540, 2, 540, 2},
+	{0x0218, 542, 2, 542, 2},
+	{0x0219, 544, 2, 544, 2},
+	{0x021A, 546, 2, 546, 2},
+	{0x021B, 548, 2, 548, 2},
+	{0x021E, 550, 2, 550, 2},
+	{0x021F, 552, 2, 552, 2},
+	{0x0226, 554, 2, 554, 2},
+	{0x0227, 556, 2, 556, 2},
+	{0x0228, 558, 2, 558, 2},
+	{0x0229, 560, 2, 560, 2},
+	{0x022A, 562, 3, 562, 3},
+	{0x022B, 565, 3, 565, 3},
+	{0x022C, 568, 3, 568, 3},
+	{0x022D, 571, 3, 571, 3},
+	{0x022E, 574, 2, 574, 2},
+	{0x022F, 576, 2, 576, 2},
+	{0x0230, 578, 3, 578, 3},
+	{0x0231, 581, 3, 581, 3},
+	{0x0232, 584, 2, 584, 2},
+	{0x0233, 586, 2, 586, 2},
+	{0x02B0, 0, 0, 588, 1},
+	{0x02B1, 0, 0, 589, 1},
+	{0x02B2, 0, 0, 590, 1},
+	{0x02B3, 0, 0, 591, 1},
+	{0x02B4, 0, 0, 592, 1},
+	{0x02B5, 0, 0, 593, 1},
+	{0x02B6, 0, 0, 594, 1},
+	{0x02B7, 0, 0, 595, 1},
+	{0x02B8, 0, 0, 596, 1},
+	{0x02D8, 0, 0, 597, 2},
+	{0x02D9, 0, 0, 599, 2},
+	{0x02DA, 0, 0, 601, 2},
+	{0x02DB, 0, 0, 603, 2},
+	{0x02DC, 0, 0, 605, 2},
+	{0x02DD, 0, 0, 607, 2},
+	{0x02E0, 0, 0, 609, 1},
+	{0x02E1, 0, 0, 610, 1},
+	{0x02E2, 0, 0, 356, 1},
+	{0x02E3, 0, 0, 611, 1},
+	{0x02E4, 0, 0, 612, 1},
+	{0x0340, 613, 1, 613, 1},
+	{0x0341, 614, 1, 614, 1},
+	{0x0343, 615, 1, 615, 1},
+	{0x0344, 616, 2, 616, 2},
+	{0x0374, 618, 1, 618, 1},
+	{0x037A, 0, 0, 619, 2},
+	{0x037E, 621, 1, 621, 1},
+	{0x0384, 0, 0, 8, 2},
+	{0x0385, 622, 2, 624, 3},
+	{0x0386, 627, 2, 627, 2},
+	{0x0387, 629, 1, 629, 1},
+	{0x0388, 630, 2, 630, 2},
+	{0x0389, 632, 2, 632, 2},
+	{0x038A, 634, 2, 634, 2},
+	{0x038C, 636, 2, 636, 2},
+	{0x038E, 638, 2, 638, 2},
+	{0x038F, 640, 2, 640, 2},
+	{0x0390, 642, 3, 642, 3},
+	{0x03AA, 645, 2, 645, 2},
+	{0x03AB, 647, 2, 647, 2},
+	{0x03AC, 649, 2, 649, 2},
+	{0x03AD, 651, 2, 651, 2},
+	{0x03AE, 653, 2, 653, 2},
+	{0x03AF, 655, 2, 655, 2},
+	{0x03B0, 657, 3, 657, 3},
+	{0x03CA, 660, 2, 660, 2},
+	{0x03CB, 662, 2, 662, 2},
+	{0x03CC, 664, 2, 664, 2},
+	{0x03CD, 666, 2, 666, 2},
+	{0x03CE, 668, 2, 668, 2},
+	{0x03D0, 0, 0, 670, 1},
+	{0x03D1, 0, 0, 671, 1},
+	{0x03D2, 0, 0, 672, 1},
+	{0x03D3, 673, 2, 638, 2},
+	{0x03D4, 675, 2, 647, 2},
+	{0x03D5, 0, 0, 677, 1},
+	{0x03D6, 0, 0, 678, 1},
+	{0x03F0, 0, 0, 679, 1},
+	{0x03F1, 0, 0, 680, 1},
+	{0x03F2, 0, 0, 681, 1},
+	{0x03F4, 0, 0, 682, 1},
+	{0x03F5, 0, 0, 683, 1},
+	{0x03F9, 0, 0, 684, 1},
+	{0x0400, 685, 2, 685, 2},
+	{0x0401, 687, 2, 687, 2},
+	{0x0403, 689, 2, 689, 2},
+	{0x0407, 691, 2, 691, 2},
+	{0x040C, 693, 2, 693, 2},
+	{0x040D, 695, 2, 695, 2},
+	{0x040E, 697, 2, 697, 2},
+	{0x0419, 699, 2, 699, 2},
+	{0x0439, 701, 2, 701, 2},
+	{0x0450, 703, 2, 703, 2},
+	{0x0451, 705, 2, 705, 2},
+	{0x0453, 707, 2, 707, 2},
+	{0x0457, 709, 2, 709, 2},
+	{0x045C, 711, 2, 711, 2},
+	{0x045D, 713, 2, 713, 2},
+	{0x045E, 715, 2, 715, 2},
+	{0x0476, 717, 2, 717, 2},
+	{0x0477, 719, 2, 719, 2},
+	{0x04C1, 721, 2, 721, 2},
+	{0x04C2, 723, 2, 723, 2},
+	{0x04D0, 725, 2, 725, 2},
+	{0x04D1, 727, 2, 727, 2},
+	{0x04D2, 729, 2, 729, 2},
+	{0x04D3, 731, 2, 731, 2},
+	{0x04D6, 733, 2, 733, 2},
+	{0x04D7, 735, 2, 735, 2},
+	{0x04DA, 737, 2, 737, 2},
+	{0x04DB, 739, 2, 739, 2},
+	{0x04DC, 741, 2, 741, 2},
+	{0x04DD, 743, 2, 743, 2},
+	{0x04DE, 745, 2, 745, 2},
+	{0x04DF, 747, 2, 747, 2},
+	{0x04E2, 749, 2, 749, 2},
+	{0x04E3, 751, 2, 751, 2},
+	{0x04E4, 753, 2, 753, 2},
+	{0x04E5, 755, 2, 755, 2},
+	{0x04E6, 757, 2, 757, 2},
+	{0x04E7, 759, 2, 759, 2},
+	{0x04EA, 761, 2, 761, 2},
+	{0x04EB, 763, 2, 763, 2},
+	{0x04EC, 765, 2, 765, 2},
+	{0x04ED, 767, 2, 767, 2},
+	{0x04EE, 769, 2, 769, 2},
+	{0x04EF, 771, 2, 771, 2},
+	{0x04F0, 773, 2, 773, 2},
+	{0x04F1, 775, 2, 775, 2},
+	{0x04F2, 777, 2, 777, 2},
+	{0x04F3, 779, 2, 779, 2},
+	{0x04F4, 781, 2, 781, 2},
+	{0x04F5, 783, 2, 783, 2},
+	{0x04F8, 785, 2, 785, 2},
+	{0x04F9, 787, 2, 787, 2},
+	{0x0587, 0, 0, 789, 2},
+	{0x0622, 791, 2, 791, 2},
+	{0x0623, 793, 2, 793, 2},
+	{0x0624, 795, 2, 795, 2},
+	{0x0625, 797, 2, 797, 2},
+	{0x0626, 799, 2, 799, 2},
+	{0x0675, 0, 0, 801, 2},
+	{0x0676, 0, 0, 803, 2},
+	{0x0677, 0, 0, 805, 2},
+	{0x0678, 0, 0, 807, 2},
+	{0x06C0, 809, 2, 809, 2},
+	{0x06C2, 811, 2, 811, 2},
+	{0x06D3, 813, 2, 813, 2},
+	{0x0929, 815, 2, 815, 2},
+	{0x0931, 817, 2, 817, 2},
+	{0x0934, 819, 2, 819, 2},
+	{0x0958, 821, 2, 821, 2},
+	{0x0959, 823, 2, 823, 2},
+	{0x095A, 825, 2, 825, 2},
+	{0x095B, 827, 2, 827, 2},
+	{0x095C, 829, 2, 829, 2},
+	{0x095D, 831, 2, 831, 2},
+	{0x095E, 833, 2, 833, 2},
+	{0x095F, 835, 2, 835, 2},
+	{0x09CB, 837, 2, 837, 2},
+	{0x09CC, 839, 2, 839, 2},
+	{0x09DC, 841, 2, 841, 2},
+	{0x09DD, 843, 2, 843, 2},
+	{0x09DF, 845, 2, 845, 2},
+	{0x0A33, 847, 2, 847, 2},
+	{0x0A36, 849, 2, 849, 2},
+	{0x0A59, 851, 2, 851, 2},
+	{0x0A5A, 853, 2, 853, 2},
+	{0x0A5B, 855, 2, 855, 2},
+	{0x0A5E, 857, 2, 857, 2},
+	{0x0B48, 859, 2, 859, 2},
+	{0x0B4B, 861, 2, 861, 2},
+	{0x0B4C, 863, 2, 863, 2},
+	{0x0B5C, 865, 2, 865, 2},
+	{0x0B5D, 867, 2, 867, 2},
+	{0x0B94, 869, 2, 869, 2},
+	{0x0BCA, 871, 2, 871, 2},
+	{0x0BCB, 873, 2, 873, 2},
+	{0x0BCC, 875, 2, 875, 2},
+	{0x0C48, 877, 2, 877, 2},
+	{0x0CC0, 879, 2, 879, 2},
+	{0x0CC7, 881, 2, 881, 2},
+	{0x0CC8, 883, 2, 883, 2},
+	{0x0CCA, 885, 2, 885, 2},
+	{0x0CCB, 887, 3, 887, 3},
+	{0x0D4A, 890, 2, 890, 2},
+	{0x0D4B, 892, 2, 892, 2},
+	{0x0D4C, 894, 2, 894, 2},
+	{0x0DDA, 896, 2, 896, 2},
+	{0x0DDC, 898, 2, 898, 2},
+	{0x0DDD, 900, 3, 900, 3},
+	{0x0DDE, 903, 2, 903, 2},
+	{0x0E33, 0, 0, 905, 2},
+	{0x0EB3, 0, 0, 907, 2},
+	{0x0EDC, 0, 0, 909, 2},
+	{0x0EDD, 0, 0, 911, 2},
+	{0x0F0C, 0, 0, 913, 1},
+	{0x0F43, 914, 2, 914, 2},
+	{0x0F4D, 916, 2, 916, 2},
+	{0x0F52, 918, 2, 918, 2},
+	{0x0F57, 920, 2, 920, 2},
+	{0x0F5C, 922, 2, 922, 2},
+	{0x0F69, 924, 2, 924, 2},
+	{0x0F73, 926, 2, 926, 2},
+	{0x0F75, 928, 2, 928, 2},
+	{0x0F76, 930, 2, 930, 2},
+	{0x0F77, 0, 0, 932, 3},
+	{0x0F78, 935, 2, 935, 2},
+	{0x0F79, 0, 0, 937, 3},
+	{0x0F81, 940, 2, 940, 2},
+	{0x0F93, 942, 2, 942, 2},
+	{0x0F9D, 944, 2, 944, 2},
+	{0x0FA2, 946, 2, 946, 2},
+	{0x0FA7, 948, 2, 948, 2},
+	{0x0FAC, 950, 2, 950, 2},
+	{0x0FB9, 952, 2, 952, 2},
+	{0x1026, 954, 2, 954, 2},
+	{0x10FC, 0, 0, 956, 1},
+	{0x1B06, 957, 2, 957, 2},
+	{0x1B08, 959, 2, 959, 2},
+	{0x1B0A, 961, 2, 961, 2},
+	{0x1B0C, 963, 2, 963, 2},
+	{0x1B0E, 965, 2, 965, 2},
+	{0x1B12, 967, 2, 967, 2},
+	{0x1B3B, 969, 2, 969, 2},
+	{0x1B3D, 971, 2, 971, 2},
+	{0x1B40, 973, 2, 973, 2},
+	{0x1B41, 975, 2, 975, 2},
+	{0x1B43, 977, 2, 977, 2},
+	{0x1D2C, 0, 0, 979, 1},
+	{0x1D2D, 0, 0, 980, 1},
+	{0x1D2E, 0, 0, 981, 1},
+	{0x1D30, 0, 0, 982, 1},
+	{0x1D31, 0, 0, 983, 1},
+	{0x1D32, 0, 0, 984, 1},
+	{0x1D33, 0, 0, 985, 1},
+	{0x1D34, 0, 0, 986, 1},
+	{0x1D35, 0, 0, 987, 1},
+	{0x1D36, 0, 0, 988, 1},
+	{0x1D37, 0, 0, 989, 1},
+	{0x1D38, 0, 0, 990, 1},
+	{0x1D39, 0, 0, 991, 1},
+	{0x1D3A, 0, 0, 992, 1},
+	{0x1D3C, 0, 0, 993, 1},
+	{0x1D3D, 0, 0, 994, 1},
+	{0x1D3E, 0, 0, 995, 1},
+	{0x1D3F, 0, 0, 996, 1},
+	{0x1D40, 0, 0, 997, 1},
+	{0x1D41, 0, 0, 998, 1},
+	{0x1D42, 0, 0, 999, 1},
+	{0x1D43, 0, 0, 3, 1},
+	{0x1D44, 0, 0, 1000, 1},
+	{0x1D45, 0, 0, 1001, 1},
+	{0x1D46, 0, 0, 1002, 1},
+	{0x1D47, 0, 0, 1003, 1},
+	{0x1D48, 0, 0, 1004, 1},
+	{0x1D49, 0, 0, 1005, 1},
+	{0x1D4A, 0, 0, 1006, 1},
+	{0x1D4B, 0, 0, 1007, 1},
+	{0x1D4C, 0, 0, 1008, 1},
+	{0x1D4D, 0, 0, 1009, 1},
+	{0x1D4F, 0, 0, 1010, 1},
+	{0x1D50, 0, 0, 1011, 1},
+	{0x1D51, 0, 0, 1012, 1},
+	{0x1D52, 0, 0, 14, 1},
+	{0x1D53, 0, 0, 1013, 1},
+	{0x1D54, 0, 0, 1014, 1},
+	{0x1D55, 0, 0, 1015, 1},
+	{0x1D56, 0, 0, 1016, 1},
+	{0x1D57, 0, 0, 1017, 1},
+	{0x1D58, 0, 0, 1018, 1},
+	{0x1D59, 0, 0, 1019, 1},
+	{0x1D5A, 0, 0, 1020, 1},
+	{0x1D5B, 0, 0, 1021, 1},
+	{0x1D5C, 0, 0, 1022, 1},
+	{0x1D5D, 0, 0, 670, 1},
+	{0x1D5E, 0, 0, 1023, 1},
+	{0x1D5F, 0, 0, 1024, 1},
+	{0x1D60, 0, 0, 677, 1},
+	{0x1D61, 0, 0, 1025, 1},
+	{0x1D62, 0, 0, 1026, 1},
+	{0x1D63, 0, 0, 591, 1},
+	{0x1D64, 0, 0, 1018, 1},
+	{0x1D65, 0, 0, 1021, 1},
+	{0x1D66, 0, 0, 670, 1},
+	{0x1D67, 0, 0, 1023, 1},
+	{0x1D68, 0, 0, 680, 1},
+	{0x1D69, 0, 0, 677, 1},
+	{0x1D6A, 0, 0, 1025, 1},
+	{0x1D78, 0, 0, 1027, 1},
+	{0x1D9B, 0, 0, 1028, 1},
+	{0x1D9C, 0, 0, 1029, 1},
+	{0x1D9D, 0, 0, 1030, 1},
+	{0x1D9E, 0, 0, 1031, 1},
+	{0x1D9F, 0, 0, 1008, 1},
+	{0x1DA0, 0, 0, 1032, 1},
+	{0x1DA1, 0, 0, 1033, 1},
+	{0x1DA2, 0, 0, 1034, 1},
+	{0x1DA3, 0, 0, 1035, 1},
+	{0x1DA4, 0, 0, 1036, 1},
+	{0x1DA5, 0, 0, 1037, 1},
+	{0x1DA6, 0, 0, 1038, 1},
+	{0x1DA7, 0, 0, 1039, 1},
+	{0x1DA8, 0, 0, 1040, 1},
+	{0x1DA9, 0, 0, 1041, 1},
+	{0x1DAA, 0, 0, 1042, 1},
+	{0x1DAB, 0, 0, 1043, 1},
+	{0x1DAC, 0, 0, 1044, 1},
+	{0x1DAD, 0, 0, 1045, 1},
+	{0x1DAE, 0, 0, 1046, 1},
+	{0x1DAF, 0, 0, 1047, 1},
+	{0x1DB0, 0, 0, 1048, 1},
+	{0x1DB1, 0, 0, 1049, 1},
+	{0x1DB2, 0, 0, 1050, 1},
+	{0x1DB3, 0, 0, 1051, 1},
+	{0x1DB4, 0, 0, 1052, 1},
+	{0x1DB5, 0, 0, 1053, 1},
+	{0x1DB6, 0, 0, 1054, 1},
+	{0x1DB7, 0, 0, 1055, 1},
+	{0x1DB8, 0, 0, 1056, 1},
+	{0x1DB9, 0, 0, 1057, 1},
+	{0x1DBA, 0, 0, 1058, 1},
+	{0x1DBB, 0, 0, 1059, 1},
+	{0x1DBC, 0, 0, 1060, 1},
+	{0x1DBD, 0, 0, 1061, 1},
+	{0x1DBE, 0, 0, 1062, 1},
+	{0x1DBF, 0, 0, 671, 1},
+	{0x1E00, 1063, 2, 1063, 2},
+	{0x1E01, 1065, 2, 1065, 2},
+	{0x1E02, 1067, 2, 1067, 2},
+	{0x1E03, 1069, 2, 1069, 2},
+	{0x1E04, 1071, 2, 1071, 2},
+	{0x1E05, 1073, 2, 1073, 2},
+	{0x1E06, 1075, 2, 1075, 2},
+	{0x1E07, 1077, 2, 1077, 2},
+	{0x1E08, 1079, 3, 1079, 3},
+	{0x1E09, 1082, 3, 1082, 3},
+	{0x1E0A, 1085, 2, 1085, 2},
+	{0x1E0B, 1087, 2, 1087, 2},
+	{0x1E0C, 1089, 2, 1089, 2},
+	{0x1E0D, 1091, 2, 1091, 2},
+	{0x1E0E, 1093, 2, 1093, 2},
+	{0x1E0F, 1095, 2, 1095, 2},
+	{0x1E10, 1097, 2, 1097, 2},
+	{0x1E11, 1099, 2, 1099, 2},
+	{0x1E12, 1101, 2, 1101, 2},
+	{0x1E13, 1103, 2, 1103, 2},
+	{0x1E14, 1105, 3, 1105, 3},
+	{0x1E15, 1108, 3, 1108, 3},
+	{0x1E16, 1111, 3, 1111, 3},
+	{0x1E17, 1114, 3, 1114, 3},
+	{0x1E18, 1117, 2, 1117, 2},
+	{0x1E19, 1119, 2, 1119, 2},
+	{0x1E1A, 1121, 2, 1121, 2},
+	{0x1E1B, 1123, 2, 1123, 2},
+	{0x1E1C, 1125, 3, 1125, 3},
+	{0x1E1D, 1128, 3, 1128, 3},
+	{0x1E1E, 1131, 2, 1131, 2},
+	{0x1E1F, 1133, 2, 1133, 2},
+	{0x1E20, 1135, 2, 1135, 2},
+	{0x1E21, 1137, 2, 1137, 2},
+	{0x1E22, 1139, 2, 1139, 2},
+	{0x1E23, 1141, 2, 1141, 2},
+	{0x1E24, 1143, 2, 1143, 2},
+	{0x1E25, 1145, 2, 1145, 2},
+	{0x1E26, 1147, 2, 1147, 2},
+	{0x1E27, 1149, 2, 1149, 2},
+	{0x1E28, 1151, 2, 1151, 2},
+	{0x1E29, 1153, 2, 1153, 2},
+	{0x1E2A, 1155, 2, 1155, 2},
+	{0x1E2B, 1157, 2, 1157, 2},
+	{0x1E2C, 1159, 2, 1159, 2},
+	{0x1E2D, 1161, 2, 1161, 2},
+	{0x1E2E, 1163, 3, 1163, 3},
+	{0x1E2F, 1166, 3, 1166, 3},
+	{0x1E30, 1169, 2, 1169, 2},
+	{0x1E31, 1171, 2, 1171, 2},
+	{0x1E32, 1173, 2, 1173, 2},
+	{0x1E33, 1175, 2, 1175, 2},
+	{0x1E34, 1177, 2, 1177, 2},
+	{0x1E35, 1179, 2, 1179, 2},
+	{0x1E36, 1181, 2, 1181, 2},
+	{0x1E37, 1183, 2, 1183, 2},
+	{0x1E38, 1185, 3, 1185, 3},
+	{0x1E39, 1188, 3, 1188, 3},
+	{0x1E3A, 1191, 2, 1191, 2},
+	{0x1E3B, 1193, 2, 1193, 2},
+	{0x1E3C, 1195, 2, 1195, 2},
+	{0x1E3D, 1197, 2, 1197, 2},
+	{0x1E3E, 1199, 2, 1199, 2},
+	{0x1E3F, 1201, 2, 1201, 2},
+	{0x1E40, 1203, 2, 1203, 2},
+	{0x1E41, 1205, 2, 1205, 2},
+	{0x1E42, 1207, 2, 1207, 2},
+	{0x1E43, 1209, 2, 1209, 2},
+	{0x1E44, 1211, 2, 1211, 2},
+	{0x1E45, 1213, 2, 1213, 2},
+	{0x1E46, 1215, 2, 1215, 2},
+	{0x1E47, 1217, 2, 1217, 2},
+	{0x1E48, 1219, 2, 1219, 2},
+	{0x1E49, 1221, 2, 1221, 2},
+	{0x1E4A, 1223, 2, 1223, 2},
+	{0x1E4B, 1225, 2, 1225, 2},
+	{0x1E4C, 1227, 3, 1227, 3},
+	{0x1E4D, 1230, 3, 1230, 3},
+	{0x1E4E, 1233, 3, 1233, 3},
+	{0x1E4F, 1236, 3, 1236, 3},
+	{0x1E50, 1239, 3, 1239, 3},
+	{0x1E51, 1242, 3, 1242, 3},
+	{0x1E52, 1245, 3, 1245, 3},
+	{0x1E53, 1248, 3, 1248, 3},
+	{0x1E54, 1251, 2, 1251, 2},
+	{0x1E55, 1253, 2, 1253, 2},
+	{0x1E56, 1255, 2, 1255, 2},
+	{0x1E57, 1257, 2, 1257, 2},
+	{0x1E58, 1259, 2, 1259, 2},
+	{0x1E59, 1261, 2, 1261, 2},
+	{0x1E5A, 1263, 2, 1263, 2},
+	{0x1E5B, 1265, 2, 1265, 2},
+	{0x1E5C, 1267, 3, 1267, 3},
+	{0x1E5D, 1270, 3, 1270, 3},
+	{0x1E5E, 1273, 2, 1273, 2},
+	{0x1E5F, 1275, 2, 1275, 2},
+	{0x1E60, 1277, 2, 1277, 2},
+	{0x1E61, 1279, 2, 1279, 2},
+	{0x1E62, 1281, 2, 1281, 2},
+	{0x1E63, 1283, 2, 1283, 2},
+	{0x1E64, 1285, 3, 1285, 3},
+	{0x1E65, 1288, 3, 1288, 3},
+	{0x1E66, 1291, 3, 1291, 3},
+	{0x1E67, 1294, 3, 1294, 3},
+	{0x1E68, 1297, 3, 1297, 3},
+	{0x1E69, 1300, 3, 1300, 3},
+	{0x1E6A, 1303, 2, 1303, 2},
+	{0x1E6B, 1305, 2, 1305, 2},
+	{0x1E6C, 1307, 2, 1307, 2},
+	{0x1E6D, 1309, 2, 1309, 2},
+	{0x1E6E, 1311, 2, 1311, 2},
+	{0x1E6F, 1313, 2, 1313, 2},
+	{0x1E70, 1315, 2, 1315, 2},
+	{0x1E71, 1317, 2, 1317, 2},
+	{0x1E72, 1319, 2, 1319, 2},
+	{0x1E73, 1321, 2, 1321, 2},
+	{0x1E74, 1323, 2, 1323, 2},
+	{0x1E75, 1325, 2, 1325, 2},
+	{0x1E76, 1327, 2, 1327, 2},
+	{0x1E77, 1329, 2, 1329, 2},
+	{0x1E78, 1331, 3, 1331, 3},
+	{0x1E79, 1334, 3, 1334, 3},
+	{0x1E7A, 1337, 3, 1337, 3},
+	{0x1E7B, 1340, 3, 1340, 3},
+	{0x1E7C, 1343, 2, 1343, 2},
+	{0x1E7D, 1345, 2, 1345, 2},
+	{0x1E7E, 1347, 2, 1347, 2},
+	{0x1E7F, 1349, 2, 1349, 2},
+	{0x1E80, 1351, 2, 1351, 2},
+	{0x1E81, 1353, 2, 1353, 2},
+	{0x1E82, 1355, 2, 1355, 2},
+	{0x1E83, 1357, 2, 1357, 2},
+	{0x1E84, 1359, 2, 1359, 2},
+	{0x1E85, 1361, 2, 1361, 2},
+	{0x1E86, 1363, 2, 1363, 2},
+	{0x1E87, 1365, 2, 1365, 2},
+	{0x1E88, 1367, 2, 1367, 2},
+	{0x1E89, 1369, 2, 1369, 2},
+	{0x1E8A, 1371, 2, 1371, 2},
+	{0x1E8B, 1373, 2, 1373, 2},
+	{0x1E8C, 1375, 2, 1375, 2},
+	{0x1E8D, 1377, 2, 1377, 2},
+	{0x1E8E, 1379, 2, 1379, 2},
+	{0x1E8F, 1381, 2, 1381, 2},
+	{0x1E90, 1383, 2, 1383, 2},
+	{0x1E91, 1385, 2, 1385, 2},
+	{0x1E92, 1387, 2, 1387, 2},
+	{0x1E93, 1389, 2, 1389, 2},
+	{0x1E94, 1391, 2, 1391, 2},
+	{0x1E95, 1393, 2, 1393, 2},
+	{0x1E96, 1395, 2, 1395, 2},
+	{0x1E97, 1397, 2, 1397, 2},
+	{0x1E98, 1399, 2, 1399, 2},
+	{0x1E99, 1401, 2, 1401, 2},
+	{0x1E9A, 0, 0, 1403, 2},
+	{0x1E9B, 1405, 2, 1279, 2},
+	{0x1EA0, 1407, 2, 1407, 2},
+	{0x1EA1, 1409, 2, 1409, 2},
+	{0x1EA2, 1411, 2, 1411, 2},
+	{0x1EA3, 1413, 2, 1413, 2},
+	{0x1EA4, 1415, 3, 1415, 3},
+	{0x1EA5, 1418, 3, 1418, 3},
+	{0x1EA6, 1421, 3, 1421, 3},
+	{0x1EA7, 1424, 3, 1424, 3},
+	{0x1EA8, 1427, 3, 1427, 3},
+	{0x1EA9, 1430, 3, 1430, 3},
+	{0x1EAA, 1433, 3, 1433, 3},
+	{0x1EAB, 1436, 3, 1436, 3},
+	{0x1EAC, 1439, 3, 1439, 3},
+	{0x1EAD, 1442, 3, 1442, 3},
+	{0x1EAE, 1445, 3, 1445, 3},
+	{0x1EAF, 1448, 3, 1448, 3},
+	{0x1EB0, 1451, 3, 1451, 3},
+	{0x1EB1, 1454, 3, 1454, 3},
+	{0x1EB2, 1457, 3, 1457, 3},
+	{0x1EB3, 1460, 3, 1460, 3},
+	{0x1EB4, 1463, 3, 1463, 3},
+	{0x1EB5, 1466, 3, 1466, 3},
+	{0x1EB6, 1469, 3, 1469, 3},
+	{0x1EB7, 1472, 3, 1472, 3},
+	{0x1EB8, 1475, 2, 1475, 2},
+	{0x1EB9, 1477, 2, 1477, 2},
+	{0x1EBA, 1479, 2, 1479, 2},
+	{0x1EBB, 1481, 2, 1481, 2},
+	{0x1EBC, 1483, 2, 1483, 2},
+	{0x1EBD, 1485, 2, 1485, 2},
+	{0x1EBE, 1487, 3, 1487, 3},
+	{0x1EBF, 1490, 3, 1490, 3},
+	{0x1EC0, 1493, 3, 1493, 3},
+	{0x1EC1, 1496, 3, 1496, 3},
+	{0x1EC2, 1499, 3, 1499, 3},
+	{0x1EC3, 1502, 3, 1502, 3},
+	{0x1EC4, 1505, 3, 1505, 3},
+	{0x1EC5, 1508, 3, 1508, 3},
+	{0x1EC6, 1511, 3, 1511, 3},
+	{0x1EC7, 1514, 3, 1514, 3},
+	{0x1EC8, 1517, 2, 1517, 2},
+	{0x1EC9, 1519, 2, 1519, 2},
+	{0x1ECA, 1521, 2, 1521, 2},
+	{0x1ECB, 1523, 2, 1523, 2},
+	{0x1ECC, 1525, 2, 1525, 2},
+	{0x1ECD, 1527, 2, 1527, 2},
+	{0x1ECE, 1529, 2, 1529, 2},
+	{0x1ECF, 1531, 2, 1531, 2},
+	{0x1ED0, 1533, 3, 1533, 3},
+	{0x1ED1, 1536, 3, 1536, 3},
+	{0x1ED2, 1539, 3, 1539, 3},
+	{0x1ED3, 1542, 3, 1542, 3},
+	{0x1ED4, 1545, 3, 1545, 3},
+	{0x1ED5, 1548, 3, 1548, 3},
+	{0x1ED6, 1551, 3, 1551, 3},
+	{0x1ED7, 1554, 3, 1554, 3},
+	{0x1ED8, 1557, 3, 1557, 3},
+	{0x1ED9, 1560, 3, 1560, 3},
+	{0x1EDA, 1563, 3, 1563, 3},
+	{0x1EDB, 1566, 3, 1566, 3},
+	{0x1EDC, 1569, 3, 1569, 3},
+	{0x1EDD, 1572, 3, 1572, 3},
+	{0x1EDE, 1575, 3, 1575, 3},
+	{0x1EDF, 1578, 3, 1578, 3},
+	{0x1EE0, 1581, 3, 1581, 3},
+	{0x1EE1, 1584, 3, 1584, 3},
+	{0x1EE2, 1587, 3, 1587, 3},
+	{0x1EE3, 1590, 3, 1590, 3},
+	{0x1EE4, 1593, 2, 1593, 2},
+	{0x1EE5, 1595, 2, 1595, 2},
+	{0x1EE6, 1597, 2, 1597, 2},
+	{0x1EE7, 1599, 2, 1599, 2},
+	{0x1EE8, 1601, 3, 1601, 3},
+	{0x1EE9, 1604, 3, 1604, 3},
+	{0x1EEA, 1607, 3, 1607, 3},
+	{0x1EEB, 1610, 3, 1610, 3},
+	{0x1EEC, 1613, 3, 1613, 3},
+	{0x1EED, 1616, 3, 1616, 3},
+	{0x1EEE, 1619, 3, 1619, 3},
+	{0x1EEF, 1622, 3, 1622, 3},
+	{0x1EF0, 1625, 3, 1625, 3},
+	{0x1EF1, 1628, 3, 1628, 3},
+	{0x1EF2, 1631, 2, 1631, 2},
+	{0x1EF3, 1633, 2, 1633, 2},
+	{0x1EF4, 1635, 2, 1635, 2},
+	{0x1EF5, 1637, 2, 1637, 2},
+	{0x1EF6, 1639, 2, 1639, 2},
+	{0x1EF7, 1641, 2, 1641, 2},
+	{0x1EF8, 1643, 2, 1643, 2},
+	{0x1EF9, 1645, 2, 1645, 2},
+	{0x1F00, 1647, 2, 1647, 2},
+	{0x1F01, 1649, 2, 1649, 2},
+	{0x1F02, 1651, 3, 1651, 3},
+	{0x1F03, 1654, 3, 1654, 3},
+	{0x1F04, 1657, 3, 1657, 3},
+	{0x1F05, 1660, 3, 1660, 3},
+	{0x1F06, 1663, 3, 1663, 3},
+	{0x1F07, 1666, 3, 1666, 3},
+	{0x1F08, 1669, 2, 1669, 2},
+	{0x1F09, 1671, 2, 1671, 2},
+	{0x1F0A, 1673, 3, 1673, 3},
+	{0x1F0B, 1676, 3, 1676, 3},
+	{0x1F0C, 1679, 3, 1679, 3},
+	{0x1F0D, 1682, 3, 1682, 3},
+	{0x1F0E, 1685, 3, 1685, 3},
+	{0x1F0F, 1688, 3, 1688, 3},
+	{0x1F10, 1691, 2, 1691, 2},
+	{0x1F11, 1693, 2, 1693, 2},
+	{0x1F12, 1695, 3, 1695, 3},
+	{0x1F13, 1698, 3, 1698, 3},
+	{0x1F14, 1701, 3, 1701, 3},
+	{0x1F15, 1704, 3, 1704, 3},
+	{0x1F18, 1707, 2, 1707, 2},
+	{0x1F19, 1709, 2, 1709, 2},
+	{0x1F1A, 1711, 3, 1711, 3},
+	{0x1F1B, 1714, 3, 1714, 3},
+	{0x1F1C, 1717, 3, 1717, 3},
+	{0x1F1D, 1720, 3, 1720, 3},
+	{0x1F20, 1723, 2, 1723, 2},
+	{0x1F21, 1725, 2, 1725, 2},
+	{0x1F22, 1727, 3, 1727, 3},
+	{0x1F23, 1730, 3, 1730, 3},
+	{0x1F24, 1733, 3, 1733, 3},
+	{0x1F25, 1736, 3, 1736, 3},
+	{0x1F26, 1739, 3, 1739, 3},
+	{0x1F27, 1742, 3, 1742, 3},
+	{0x1F28, 1745, 2, 1745, 2},
+	{0x1F29, 1747, 2, 1747, 2},
+	{0x1F2A, 1749, 3, 1749, 3},
+	{0x1F2B, 1752, 3, 1752, 3},
+	{0x1F2C, 1755, 3, 1755, 3},
+	{0x1F2D, 1758, 3, 1758, 3},
+	{0x1F2E, 1761, 3, 1761, 3},
+	{0x1F2F, 1764, 3, 1764, 3},
+	{0x1F30, 1767, 2, 1767, 2},
+	{0x1F31, 1769, 2, 1769, 2},
+	{0x1F32, 1771, 3, 1771, 3},
+	{0x1F33, 1774, 3, 1774, 3},
+	{0x1F34, 1777, 3, 1777, 3},
+	{0x1F35, 1780, 3, 1780, 3},
+	{0x1F36, 1783, 3, 1783, 3},
+	{0x1F37, 1786, 3, 1786, 3},
+	{0x1F38, 1789, 2, 1789, 2},
+	{0x1F39, 1791, 2, 1791, 2},
+	{0x1F3A, 1793, 3, 1793, 3},
+	{0x1F3B, 1796, 3, 1796, 3},
+	{0x1F3C, 1799, 3, 1799, 3},
+	{0x1F3D, 1802, 3, 1802, 3},
+	{0x1F3E, 1805, 3, 1805, 3},
+	{0x1F3F, 1808, 3, 1808, 3},
+	{0x1F40, 1811, 2, 1811, 2},
+	{0x1F41, 1813, 2, 1813, 2},
+	{0x1F42, 1815, 3, 1815, 3},
+	{0x1F43, 1818, 3, 1818, 3},
+	{0x1F44, 1821, 3, 1821, 3},
+	{0x1F45, 1824, 3, 1824, 3},
+	{0x1F48, 1827, 2, 1827, 2},
+	{0x1F49, 1829, 2, 1829, 2},
+	{0x1F4A, 1831, 3, 1831, 3},
+	{0x1F4B, 1834, 3, 1834, 3},
+	{0x1F4C, 1837, 3, 1837, 3},
+	{0x1F4D, 1840, 3, 1840, 3},
+	{0x1F50, 1843, 2, 1843, 2},
+	{0x1F51, 1845, 2, 1845, 2},
+	{0x1F52, 1847, 3, 1847, 3},
+	{0x1F53, 1850, 3, 1850, 3},
+	{0x1F54, 1853, 3, 1853, 3},
+	{0x1F55, 1856, 3, 1856, 3},
+	{0x1F56, 1859, 3, 1859, 3},
+	{0x1F57, 1862, 3, 1862, 3},
+	{0x1F59, 1865, 2, 1865, 2},
+	{0x1F5B, 1867, 3, 1867, 3},
+	{0x1F5D, 1870, 3, 1870, 3},
+	{0x1F5F, 1873, 3, 1873, 3},
+	{0x1F60, 1876, 2, 1876, 2},
+	{0x1F61, 1878, 2, 1878, 2},
+	{0x1F62, 1880, 3, 1880, 3},
+	{0x1F63, 1883, 3, 1883, 3},
+	{0x1F64, 1886, 3, 1886, 3},
+	{0x1F65, 1889, 3, 1889, 3},
+	{0x1F66, 1892, 3, 1892, 3},
+	{0x1F67, 1895, 3, 1895, 3},
+	{0x1F68, 1898, 2, 1898, 2},
+	{0x1F69, 1900, 2, 1900, 2},
+	{0x1F6A, 1902, 3, 1902, 3},
+	{0x1F6B, 1905, 3, 1905, 3},
+	{0x1F6C, 1908, 3, 1908, 3},
+	{0x1F6D, 1911, 3, 1911, 3},
+	{0x1F6E, 1914, 3, 1914, 3},
+	{0x1F6F, 1917, 3, 1917, 3},
+	{0x1F70, 1920, 2, 1920, 2},
+	{0x1F71, 649, 2, 649, 2},
+	{0x1F72, 1922, 2, 1922, 2},
+	{0x1F73, 651, 2, 651, 2},
+	{0x1F74, 1924, 2, 1924, 2},
+	{0x1F75, 653, 2, 653, 2},
+	{0x1F76, 1926, 2, 1926, 2},
+	{0x1F77, 655, 2, 655, 2},
+	{0x1F78, 1928, 2, 1928, 2},
+	{0x1F79, 664, 2, 664, 2},
+	{0x1F7A, 1930, 2, 1930, 2},
+	{0x1F7B, 666, 2, 666, 2},
+	{0x1F7C, 1932, 2, 1932, 2},
+	{0x1F7D, 668, 2, 668, 2},
+	{0x1F80, 1934, 3, 1934, 3},
+	{0x1F81, 1937, 3, 1937, 3},
+	{0x1F82, 1940, 4, 1940, 4},
+	{0x1F83, 1944, 4, 1944, 4},
+	{0x1F84, 1948, 4, 1948, 4},
+	{0x1F85, 1952, 4, 1952, 4},
+	{0x1F86, 1956, 4, 1956, 4},
+	{0x1F87, 1960, 4, 1960, 4},
+	{0x1F88, 1964, 3, 1964, 3},
+	{0x1F89, 1967, 3, 1967, 3},
+	{0x1F8A, 1970, 4, 1970, 4},
+	{0x1F8B, 1974, 4, 1974, 4},
+	{0x1F8C, 1978, 4, 1978, 4},
+	{0x1F8D, 1982, 4, 1982, 4},
+	{0x1F8E, 1986, 4, 1986, 4},
+	{0x1F8F, 1990, 4, 1990, 4},
+	{0x1F90, 1994, 3, 1994, 3},
+	{0x1F91, 1997, 3, 1997, 3},
+	{0x1F92, 2000, 4, 2000, 4},
+	{0x1F93, 2004, 4, 2004, 4},
+	{0x1F94, 2008, 4, 2008, 4},
+	{0x1F95, 2012, 4, 2012, 4},
+	{0x1F96, 2016, 4, 2016, 4},
+	{0x1F97, 2020, 4, 2020, 4},
+	{0x1F98, 2024, 3, 2024, 3},
+	{0x1F99, 2027, 3, 2027, 3},
+	{0x1F9A, 2030, 4, 2030, 4},
+	{0x1F9B, 2034, 4, 2034, 4},
+	{0x1F9C, 2038, 4, 2038, 4},
+	{0x1F9D, 2042, 4, 2042, 4},
+	{0x1F9E, 2046, 4, 2046, 4},
+	{0x1F9F, 2050, 4, 2050, 4},
+	{0x1FA0, 2054, 3, 2054, 3},
+	{0x1FA1, 2057, 3, 2057, 3},
+	{0x1FA2, 2060, 4, 2060, 4},
+	{0x1FA3, 2064, 4, 2064, 4},
+	{0x1FA4, 2068, 4, 2068, 4},
+	{0x1FA5, 2072, 4, 2072, 4},
+	{0x1FA6, 2076, 4, 2076, 4},
+	{0x1FA7, 2080, 4, 2080, 4},
+	{0x1FA8, 2084, 3, 2084, 3},
+	{0x1FA9, 2087, 3, 2087, 3},
+	{0x1FAA, 2090, 4, 2090, 4},
+	{0x1FAB, 2094, 4, 2094, 4},
+	{0x1FAC, 2098, 4, 2098, 4},
+	{0x1FAD, 2102, 4, 2102, 4},
+	{0x1FAE, 2106, 4, 2106, 4},
+	{0x1FAF, 2110, 4, 2110, 4},
+	{0x1FB0, 2114, 2, 2114, 2},
+	{0x1FB1, 2116, 2, 2116, 2},
+	{0x1FB2, 2118, 3, 2118, 3},
+	{0x1FB3, 2121, 2, 2121, 2},
+	{0x1FB4, 2123, 3, 2123, 3},
+	{0x1FB6, 2126, 2, 2126, 2},
+	{0x1FB7, 2128, 3, 2128, 3},
+	{0x1FB8, 2131, 2, 2131, 2},
+	{0x1FB9, 2133, 2, 2133, 2},
+	{0x1FBA, 2135, 2, 2135, 2},
+	{0x1FBB, 627, 2, 627, 2},
+	{0x1FBC, 2137, 2, 2137, 2},
+	{0x1FBD, 0, 0, 2139, 2},
+	{0x1FBE, 2141, 1, 2141, 1},
+	{0x1FBF, 0, 0, 2139, 2},
+	{0x1FC0, 0, 0, 2142, 2},
+	{0x1FC1, 2144, 2, 2146, 3},
+	{0x1FC2, 2149, 3, 2149, 3},
+	{0x1FC3, 2152, 2, 2152, 2},
+	{0x1FC4, 2154, 3, 2154, 3},
+	{0x1FC6, 2157, 2, 2157, 2},
+	{0x1FC7, 2159, 3, 2159, 3},
+	{0x1FC8, 2162, 2, 2162, 2},
+	{0x1FC9, 630, 2, 630, 2},
+	{0x1FCA, 2164, 2, 2164, 2},
+	{0x1FCB, 632, 2, 632, 2},
+	{0x1FCC, 2166, 2, 2166, 2},
+	{0x1FCD, 2168, 2, 2170, 3},
+	{0x1FCE, 2173, 2, 2175, 3},
+	{0x1FCF, 2178, 2, 2180, 3},
+	{0x1FD0, 2183, 2, 2183, 2},
+	{0x1FD1, 2185, 2, 2185, 2},
+	{0x1FD2, 2187, 3, 2187, 3},
+	{0x1FD3, 642, 3, 642, 3},
+	{0x1FD6, 2190, 2, 2190, 2},
+	{0x1FD7, 2192, 3, 2192, 3},
+	{0x1FD8, 2195, 2, 2195, 2},
+	{0x1FD9, 2197, 2, 2197, 2},
+	{0x1FDA, 2199, 2, 2199, 2},
+	{0x1FDB, 634, 2, 634, 2},
+	{0x1FDD, 2201, 2, 2203, 3},
+	{0x1FDE, 2206, 2, 2208, 3},
+	{0x1FDF, 2211, 2, 2213, 3},
+	{0x1FE0, 2216, 2, 2216, 2},
+	{0x1FE1, 2218, 2, 2218, 2},
+	{0x1FE2, 2220, 3, 2220, 3},
+	{0x1FE3, 657, 3, 657, 3},
+	{0x1FE4, 2223, 2, 2223, 2},
+	{0x1FE5, 2225, 2, 2225, 2},
+	{0x1FE6, 2227, 2, 2227, 2},
+	{0x1FE7, 2229, 3, 2229, 3},
+	{0x1FE8, 2232, 2, 2232, 2},
+	{0x1FE9, 2234, 2, 2234, 2},
+	{0x1FEA, 2236, 2, 2236, 2},
+	{0x1FEB, 638, 2, 638, 2},
+	{0x1FEC, 2238, 2, 2238, 2},
+	{0x1FED, 2240, 2, 2242, 3},
+	{0x1FEE, 622, 2, 624, 3},
+	{0x1FEF, 2245, 1, 2245, 1},
+	{0x1FF2, 2246, 3, 2246, 3},
+	{0x1FF3, 2249, 2, 2249, 2},
+	{0x1FF4, 2251, 3, 2251, 3},
+	{0x1FF6, 2254, 2, 2254, 2},
+	{0x1FF7, 2256, 3, 2256, 3},
+	{0x1FF8, 2259, 2, 2259, 2},
+	{0x1FF9, 636, 2, 636, 2},
+	{0x1FFA, 2261, 2, 2261, 2},
+	{0x1FFB, 640, 2, 640, 2},
+	{0x1FFC, 2263, 2, 2263, 2},
+	{0x1FFD, 2265, 1, 8, 2},
+	{0x1FFE, 0, 0, 2266, 2},
+	{0x2000, 2268, 1, 0, 1},
+	{0x2001, 2269, 1, 0, 1},
+	{0x2002, 0, 0, 0, 1},
+	{0x2003, 0, 0, 0, 1},
+	{0x2004, 0, 0, 0, 1},
+	{0x2005, 0, 0, 0, 1},
+	{0x2006, 0, 0, 0, 1},
+	{0x2007, 0, 0, 0, 1},
+	{0x2008, 0, 0, 0, 1},
+	{0x2009, 0, 0, 0, 1},
+	{0x200A, 0, 0, 0, 1},
+	{0x2011, 0, 0, 2270, 1},
+	{0x2017, 0, 0, 2271, 2},
+	{0x2024, 0, 0, 2273, 1},
+	{0x2025, 0, 0, 2274, 2},
+	{0x2026, 0, 0, 2276, 3},
+	{0x202F, 0, 0, 0, 1},
+	{0x2033, 0, 0, 2279, 2},
+	{0x2034, 0, 0, 2281, 3},
+	{0x2036, 0, 0, 2284, 2},
+	{0x2037, 0, 0, 2286, 3},
+	{0x203C, 0, 0, 2289, 2},
+	{0x203E, 0, 0, 2291, 2},
+	{0x2047, 0, 0, 2293, 2},
+	{0x2048, 0, 0, 2295, 2},
+	{0x2049, 0, 0, 2297, 2},
+	{0x2057, 0, 0, 2299, 4},
+	{0x205F, 0, 0, 0, 1},
+	{0x2070, 0, 0, 2303, 1},
+	{0x2071, 0, 0, 1026, 1},
+	{0x2074, 0, 0, 2304, 1},
+	{0x2075, 0, 0, 2305, 1},
+	{0x2076, 0, 0, 2306, 1},
+	{0x2077, 0, 0, 2307, 1},
+	{0x2078, 0, 0, 2308, 1},
+	{0x2079, 0, 0, 2309, 1},
+	{0x207A, 0, 0, 2310, 1},
+	{0x207B, 0, 0, 2311, 1},
+	{0x207C, 0, 0, 2312, 1},
+	{0x207D, 0, 0, 2313, 1},
+	{0x207E, 0, 0, 2314, 1},
+	{0x207F, 0, 0, 2315, 1},
+	{0x2080, 0, 0, 2303, 1},
+	{0x2081, 0, 0, 13, 1},
+	{0x2082, 0, 0, 6, 1},
+	{0x2083, 0, 0, 7, 1},
+	{0x2084, 0, 0, 2304, 1},
+	{0x2085, 0, 0, 2305, 1},
+	{0x2086, 0, 0, 2306, 1},
+	{0x2087, 0, 0, 2307, 1},
+	{0x2088, 0, 0, 2308, 1},
+	{0x2089, 0, 0, 2309, 1},
+	{0x208A, 0, 0, 2310, 1},
+	{0x208B, 0, 0, 2311, 1},
+	{0x208C, 0, 0, 2312, 1},
+	{0x208D, 0, 0, 2313, 1},
+	{0x208E, 0, 0, 2314, 1},
+	{0x2090, 0, 0, 3, 1},
+	{0x2091, 0, 0, 1005, 1},
+	{0x2092, 0, 0, 14, 1},
+	{0x2093, 0, 0, 611, 1},
+	{0x2094, 0, 0, 1006, 1},
+	{0x2095, 0, 0, 588, 1},
+	{0x2096, 0, 0, 1010, 1},
+	{0x2097, 0, 0, 610, 1},
+	{0x2098, 0, 0, 1011, 1},
+	{0x2099, 0, 0, 2315, 1},
+	{0x209A, 0, 0, 1016, 1},
+	{0x209B, 0, 0, 356, 1},
+	{0x209C, 0, 0, 1017, 1},
+	{0x20A8, 0, 0, 2316, 2},
+	{0x2100, 0, 0, 2318, 3},
+	{0x2101, 0, 0, 2321, 3},
+	{0x2102, 0, 0, 2324, 1},
+	{0x2103, 0, 0, 2325, 2},
+	{0x2105, 0, 0, 2327, 3},
+	{0x2106, 0, 0, 2330, 3},
+	{0x2107, 0, 0, 2333, 1},
+	{0x2109, 0, 0, 2334, 2},
+	{0x210A, 0, 0, 1009, 1},
+	{0x210B, 0, 0, 986, 1},
+	{0x210C, 0, 0, 986, 1},
+	{0x210D, 0, 0, 986, 1},
+	{0x210E, 0, 0, 588, 1},
+	{0x210F, 0, 0, 2336, 1},
+	{0x2110, 0, 0, 987, 1},
+	{0x2111, 0, 0, 987, 1},
+	{0x2112, 0, 0, 990, 1},
+	{0x2113, 0, 0, 610, 1},
+	{0x2115, 0, 0, 992, 1},
+	{0x2116, 0, 0, 2337, 2},
+	{0x2119, 0, 0, 995, 1},
+	{0x211A, 0, 0, 2339, 1},
+	{0x211B, 0, 0, 996, 1},
+	{0x211C, 0, 0, 996, 1},
+	{0x211D, 0, 0, 996, 1},
+	{0x2120, 0, 0, 2340, 2},
+	{0x2121, 0, 0, 2342, 3},
+	{0x2122, 0, 0, 2345, 2},
+	{0x2124, 0, 0, 2347, 1},
+	{0x2126, 2348, 1, 2348, 1},
+	{0x2128, 0, 0, 2347, 1},
+	{0x212A, 989, 1, 989, 1},
+	{0x212B, 34, 2, 34, 2},
+	{0x212C, 0, 0, 981, 1},
+	{0x212D, 0, 0, 2324, 1},
+	{0x212F, 0, 0, 1005, 1},
+	{0x2130, 0, 0, 983, 1},
+	{0x2131, 0, 0, 2349, 1},
+	{0x2133, 0, 0, 991, 1},
+	{0x2134, 0, 0, 14, 1},
+	{0x2135, 0, 0, 2350, 1},
+	{0x2136, 0, 0, 2351, 1},
+	{0x2137, 0, 0, 2352, 1},
+	{0x2138, 0, 0, 2353, 1},
+	{0x2139, 0, 0, 1026, 1},
+	{0x213B, 0, 0, 2354, 3},
+	{0x213C, 0, 0, 678, 1},
+	{0x213D, 0, 0, 1023, 1},
+	{0x213E, 0, 0, 2357, 1},
+	{0x213F, 0, 0, 2358, 1},
+	{0x2140, 0, 0, 2359, 1},
+	{0x2145, 0, 0, 982, 1},
+	{0x2146, 0, 0, 1004, 1},
+	{0x2147, 0, 0, 1005, 1},
+	{0x2148, 0, 0, 1026, 1},
+	{0x2149, 0, 0, 590, 1},
+	{0x2150, 0, 0, 2360, 3},
+	{0x2151, 0, 0, 2363, 3},
+	{0x2152, 0, 0, 2366, 4},
+	{0x2153, 0, 0, 2370, 3},
+	{0x2154, 0, 0, 2373, 3},
+	{0x2155, 0, 0, 2376, 3},
+	{0x2156, 0, 0, 2379, 3},
+	{0x2157, 0, 0, 2382, 3},
+	{0x2158, 0, 0, 2385, 3},
+	{0x2159, 0, 0, 2388, 3},
+	{0x215A, 0, 0, 2391, 3},
+	{0x215B, 0, 0, 2394, 3},
+	{0x215C, 0, 0, 2397, 3},
+	{0x215D, 0, 0, 2400, 3},
+	{0x215E, 0, 0, 2403, 3},
+	{0x215F, 0, 0, 2406, 2},
+	{0x2160, 0, 0, 987, 1},
+	{0x2161, 0, 0, 2408, 2},
+	{0x2162, 0, 0, 2410, 3},
+	{0x2163, 0, 0, 2413, 2},
+	{0x2164, 0, 0, 2415, 1},
+	{0x2165, 0, 0, 2416, 2},
+	{0x2166, 0, 0, 2418, 3},
+	{0x2167, 0, 0, 2421, 4},
+	{0x2168, 0, 0, 2425, 2},
+	{0x2169, 0, 0, 2427, 1},
+	{0x216A, 0, 0, 2428, 2},
+	{0x216B, 0, 0, 2430, 3},
+	{0x216C, 0, 0, 990, 1},
+	{0x216D, 0, 0, 2324, 1},
+	{0x216E, 0, 0, 982, 1},
+	{0x216F, 0, 0, 991, 1},
+	{0x2170, 0, 0, 1026, 1},
+	{0x2171, 0, 0, 2433, 2},
+	{0x2172, 0, 0, 2435, 3},
+	{0x2173, 0, 0, 2438, 2},
+	{0x2174, 0, 0, 1021, 1},
+	{0x2175, 0, 0, 2440, 2},
+	{0x2176, 0, 0, 2442, 3},
+	{0x2177, 0, 0, 2445, 4},
+	{0x2178, 0, 0, 2449, 2},
+	{0x2179, 0, 0, 611, 1},
+	{0x217A, 0, 0, 2451, 2},
+	{0x217B, 0, 0, 2453, 3},
+	{0x217C, 0, 0, 610, 1},
+	{0x217D, 0, 0, 1029, 1},
+	{0x217E, 0, 0, 1004, 1},
+	{0x217F, 0, 0, 1011, 1},
+	{0x2189, 0, 0, 2456, 3},
+	{0x219A, 2459, 2, 2459, 2},
+	{0x219B, 2461, 2, 2461, 2},
+	{0x21AE, 2463, 2, 2463, 2},
+	{0x21CD, 2465, 2, 2465, 2},
+	{0x21CE, 2467, 2, 2467, 2},
+	{0x21CF, 2469, 2, 2469, 2},
+	{0x2204, 2471, 2, 2471, 2},
+	{0x2209, 2473, 2, 2473, 2},
+	{0x220C, 2475, 2, 2475, 2},
+	{0x2224, 2477, 2, 2477, 2},
+	{0x2226, 2479, 2, 2479, 2},
+	{0x222C, 0, 0, 2481, 2},
+	{0x222D, 0, 0, 2483, 3},
+	{0x222F, 0, 0, 2486, 2},
+	{0x2230, 0, 0, 2488, 3},
+	{0x2241, 2491, 2, 2491, 2},
+	{0x2244, 2493, 2, 2493, 2},
+	{0x2247, 2495, 2, 2495, 2},
+	{0x2249, 2497, 2, 2497, 2},
+	{0x2260, 2499, 2, 2499, 2},
+	{0x2262, 2501, 2, 2501, 2},
+	{0x226D, 2503, 2, 2503, 2},
+	{0x226E, 2505, 2, 2505, 2},
+	{0x226F, 2507, 2, 2507, 2},
+	{0x2270, 2509, 2, 2509, 2},
+	{0x2271, 2511, 2, 2511, 2},
+	{0x2274, 2513, 2, 2513, 2},
+	{0x2275, 2515, 2, 2515, 2},
+	{0x2278, 2517, 2, 2517, 2},
+	{0x2279, 2519, 2, 2519, 2},
+	{0x2280, 2521, 2, 2521, 2},
+	{0x2281, 2523, 2, 2523, 2},
+	{0x2284, 2525, 2, 2525, 2},
+	{0x2285, 2527, 2, 2527, 2},
+	{0x2288, 2529, 2, 2529, 2},
+	{0x2289, 2531, 2, 2531, 2},
+	{0x22AC, 2533, 2, 2533, 2},
+	{0x22AD, 2535, 2, 2535, 2},
+	{0x22AE, 2537, 2, 2537, 2},
+	{0x22AF, 2539, 2, 2539, 2},
+	{0x22E0, 2541, 2, 2541, 2},
+	{0x22E1, 2543, 2, 2543, 2},
+	{0x22E2, 2545, 2, 2545, 2},
+	{0x22E3, 2547, 2, 2547, 2},
+	{0x22EA, 2549, 2, 2549, 2},
+	{0x22EB, 2551, 2, 2551, 2},
+	{0x22EC, 2553, 2, 2553, 2},
+	{0x22ED, 2555, 2, 2555, 2},
+	{0x2329, 2557, 1, 2557, 1},
+	{0x232A, 2558, 1, 2558, 1},
+	{0x2460, 0, 0, 13, 1},
+	{0x2461, 0, 0, 6, 1},
+	{0x2462, 0, 0, 7, 1},
+	{0x2463, 0, 0, 2304, 1},
+	{0x2464, 0, 0, 2305, 1},
+	{0x2465, 0, 0, 2306, 1},
+	{0x2466, 0, 0, 2307, 1},
+	{0x2467, 0, 0, 2308, 1},
+	{0x2468, 0, 0, 2309, 1},
+	{0x2469, 0, 0, 2559, 2},
+	{0x246A, 0, 0, 2561, 2},
+	{0x246B, 0, 0, 2563, 2},
+	{0x246C, 0, 0, 2565, 2},
+	{0x246D, 0, 0, 2567, 2},
+	{0x246E, 0, 0, 2569, 2},
+	{0x246F, 0, 0, 2571, 2},
+	{0x2470, 0, 0, 2573, 2},
+	{0x2471, 0, 0, 2575, 2},
+	{0x2472, 0, 0, 2577, 2},
+	{0x2473, 0, 0, 2579, 2},
+	{0x2474, 0, 0, 2581, 3},
+	{0x2475, 0, 0, 2584, 3},
+	{0x2476, 0, 0, 2587, 3},
+	{0x2477, 0, 0, 2590, 3},
+	{0x2478, 0, 0, 2593, 3},
+	{0x2479, 0, 0, 2596, 3},
+	{0x247A, 0, 0, 2599, 3},
+	{0x247B, 0, 0, 2602, 3},
+	{0x247C, 0, 0, 2605, 3},
+	{0x247D, 0, 0, 2608, 4},
+	{0x247E, 0, 0, 2612, 4},
+	{0x247F, 0, 0, 2616, 4},
+	{0x2480, 0, 0, 2620, 4},
+	{0x2481, 0, 0, 2624, 4},
+	{0x2482, 0, 0, 2628, 4},
+	{0x2483, 0, 0, 2632, 4},
+	{0x2484, 0, 0, 2636, 4},
+	{0x2485, 0, 0, 2640, 4},
+	{0x2486, 0, 0, 2644, 4},
+	{0x2487, 0, 0, 2648, 4},
+	{0x2488, 0, 0, 2652, 2},
+	{0x2489, 0, 0, 2654, 2},
+	{0x248A, 0, 0, 2656, 2},
+	{0x248B, 0, 0, 2658, 2},
+	{0x248C, 0, 0, 2660, 2},
+	{0x248D, 0, 0, 2662, 2},
+	{0x248E, 0, 0, 2664, 2},
+	{0x248F, 0, 0, 2666, 2},
+	{0x2490, 0, 0, 2668, 2},
+	{0x2491, 0, 0, 2670, 3},
+	{0x2492, 0, 0, 2673, 3},
+	{0x2493, 0, 0, 2676, 3},
+	{0x2494, 0, 0, 2679, 3},
+	{0x2495, 0, 0, 2682, 3},
+	{0x2496, 0, 0, 2685, 3},
+	{0x2497, 0, 0, 2688, 3},
+	{0x2498, 0, 0, 2691, 3},
+	{0x2499, 0, 0, 2694, 3},
+	{0x249A, 0, 0, 2697, 3},
+	{0x249B, 0, 0, 2700, 3},
+	{0x249C, 0, 0, 2703, 3},
+	{0x249D, 0, 0, 2706, 3},
+	{0x249E, 0, 0, 2709, 3},
+	{0x249F, 0, 0, 2712, 3},
+	{0x24A0, 0, 0, 2715, 3},
+	{0x24A1, 0, 0, 2718, 3},
+	{0x24A2, 0, 0, 2721, 3},
+	{0x24A3, 0, 0, 2724, 3},
+	{0x24A4, 0, 0, 2727, 3},
+	{0x24A5, 0, 0, 2730, 3},
+	{0x24A6, 0, 0, 2733, 3},
+	{0x24A7, 0, 0, 2736, 3},
+	{0x24A8, 0, 0, 2739, 3},
+	{0x24A9, 0, 0, 2742, 3},
+	{0x24AA, 0, 0, 2745, 3},
+	{0x24AB, 0, 0, 2748, 3},
+	{0x24AC, 0, 0, 2751, 3},
+	{0x24AD, 0, 0, 2754, 3},
+	{0x24AE, 0, 0, 2757, 3},
+	{0x24AF, 0, 0, 2760, 3},
+	{0x24B0, 0, 0, 2763, 3},
+	{0x24B1, 0, 0, 2766, 3},
+	{0x24B2, 0, 0, 2769, 3},
+	{0x24B3, 0, 0, 2772, 3},
+	{0x24B4, 0, 0, 2775, 3},
+	{0x24B5, 0, 0, 2778, 3},
+	{0x24B6, 0, 0, 979, 1},
+	{0x24B7, 0, 0, 981, 1},
+	{0x24B8, 0, 0, 2324, 1},
+	{0x24B9, 0, 0, 982, 1},
+	{0x24BA, 0, 0, 983, 1},
+	{0x24BB, 0, 0, 2349, 1},
+	{0x24BC, 0, 0, 985, 1},
+	{0x24BD, 0, 0, 986, 1},
+	{0x24BE, 0, 0, 987, 1},
+	{0x24BF, 0, 0, 988, 1},
+	{0x24C0, 0, 0, 989, 1},
+	{0x24C1, 0, 0, 990, 1},
+	{0x24C2, 0, 0, 991, 1},
+	{0x24C3, 0, 0, 992, 1},
+	{0x24C4, 0, 0, 993, 1},
+	{0x24C5, 0, 0, 995, 1},
+	{0x24C6, 0, 0, 2339, 1},
+	{0x24C7, 0, 0, 996, 1},
+	{0x24C8, 0, 0, 2781, 1},
+	{0x24C9, 0, 0, 997, 1},
+	{0x24CA, 0, 0, 998, 1},
+	{0x24CB, 0, 0, 2415, 1},
+	{0x24CC, 0, 0, 999, 1},
+	{0x24CD, 0, 0, 2427, 1},
+	{0x24CE, 0, 0, 2782, 1},
+	{0x24CF, 0, 0, 2347, 1},
+	{0x24D0, 0, 0, 3, 1},
+	{0x24D1, 0, 0, 1003, 1},
+	{0x24D2, 0, 0, 1029, 1},
+	{0x24D3, 0, 0, 1004, 1},
+	{0x24D4, 0, 0, 1005, 1},
+	{0x24D5, 0, 0, 1032, 1},
+	{0x24D6, 0, 0, 1009, 1},
+	{0x24D7, 0, 0, 588, 1},
+	{0x24D8, 0, 0, 1026, 1},
+	{0x24D9, 0, 0, 590, 1},
+	{0x24DA, 0, 0, 1010, 1},
+	{0x24DB, 0, 0, 610, 1},
+	{0x24DC, 0, 0, 1011, 1},
+	{0x24DD, 0, 0, 2315, 1},
+	{0x24DE, 0, 0, 14, 1},
+	{0x24DF, 0, 0, 1016, 1},
+	{0x24E0, 0, 0, 2783, 1},
+	{0x24E1, 0, 0, 591, 1},
+	{0x24E2, 0, 0, 356, 1},
+	{0x24E3, 0, 0, 1017, 1},
+	{0x24E4, 0, 0, 1018, 1},
+	{0x24E5, 0, 0, 1021, 1},
+	{0x24E6, 0, 0, 595, 1},
+	{0x24E7, 0, 0, 611, 1},
+	{0x24E8, 0, 0, 596, 1},
+	{0x24E9, 0, 0, 1059, 1},
+	{0x24EA, 0, 0, 2303, 1},
+	{0x2A0C, 0, 0, 2784, 4},
+	{0x2A74, 0, 0, 2788, 3},
+	{0x2A75, 0, 0, 2791, 2},
+	{0x2A76, 0, 0, 2793, 3},
+	{0x2ADC, 2796, 2, 2796, 2},
+	{0x2C7C, 0, 0, 590, 1},
+	{0x2C7D, 0, 0, 2415, 1},
+	{0x2D6F, 0, 0, 2798, 1},
+	{0x2E9F, 0, 0, 2799, 1},
+	{0x2EF3, 0, 0, 2800, 1},
+	{0x2F00, 0, 0, 2801, 1},
+	{0x2F01, 0, 0, 2802, 1},
+	{0x2F02, 0, 0, 2803, 1},
+	{0x2F03, 0, 0, 2804, 1},
+	{0x2F04, 0, 0, 2805, 1},
+	{0x2F05, 0, 0, 2806, 1},
+	{0x2F06, 0, 0, 2807, 1},
+	{0x2F07, 0, 0, 2808, 1},
+	{0x2F08, 0, 0, 2809, 1},
+	{0x2F09, 0, 0, 2810, 1},
+	{0x2F0A, 0, 0, 2811, 1},
+	{0x2F0B, 0, 0, 2812, 1},
+	{0x2F0C, 0, 0, 2813, 1},
+	{0x2F0D, 0, 0, 2814, 1},
+	{0x2F0E, 0, 0, 2815, 1},
+	{0x2F0F, 0, 0, 2816, 1},
+	{0x2F10, 0, 0, 2817, 1},
+	{0x2F11, 0, 0, 2818, 1},
+	{0x2F12, 0, 0, 2819, 1},
+	{0x2F13, 0, 0, 2820, 1},
+	{0x2F14, 0, 0, 2821, 1},
+	{0x2F15, 0, 0, 2822, 1},
+	{0x2F16, 0, 0, 2823, 1},
+	{0x2F17, 0, 0, 2824, 1},
+	{0x2F18, 0, 0, 2825, 1},
+	{0x2F19, 0, 0, 2826, 1},
+	{0x2F1A, 0, 0, 2827, 1},
+	{0x2F1B, 0, 0, 2828, 1},
+	{0x2F1C, 0, 0, 2829, 1},
+	{0x2F1D, 0, 0, 2830, 1},
+	{0x2F1E, 0, 0, 2831, 1},
+	{0x2F1F, 0, 0, 2832, 1},
+	{0x2F20, 0, 0, 2833, 1},
+	{0x2F21, 0, 0, 2834, 1},
+	{0x2F22, 0, 0, 2835, 1},
+	{0x2F23, 0, 0, 2836, 1},
+	{0x2F24, 0, 0, 2837, 1},
+	{0x2F25, 0, 0, 2838, 1},
+	{0x2F26, 0, 0, 2839, 1},
+	{0x2F27, 0, 0, 2840, 1},
+	{0x2F28, 0, 0, 2841, 1},
+	{0x2F29, 0, 0, 2842, 1},
+	{0x2F2A, 0, 0, 2843, 1},
+	{0x2F2B, 0, 0, 2844, 1},
+	{0x2F2C, 0, 0, 2845, 1},
+	{0x2F2D, 0, 0, 2846, 1},
+	{0x2F2E, 0, 0, 2847, 1},
+	{0x2F2F, 0, 0, 2848, 1},
+	{0x2F30, 0, 0, 2849, 1},
+	{0x2F31, 0, 0, 2850, 1},
+	{0x2F32, 0, 0, 2851, 1},
+	{0x2F33, 0, 0, 2852, 1},
+	{0x2F34, 0, 0, 2853, 1},
+	{0x2F35, 0, 0, 2854, 1},
+	{0x2F36, 0, 0, 2855, 1},
+	{0x2F37, 0, 0, 2856, 1},
+	{0x2F38, 0, 0, 2857, 1},
+	{0x2F39, 0, 0, 2858, 1},
+	{0x2F3A, 0, 0, 2859, 1},
+	{0x2F3B, 0, 0, 2860, 1},
+	{0x2F3C, 0, 0, 2861, 1},
+	{0x2F3D, 0, 0, 2862, 1},
+	{0x2F3E, 0, 0, 2863, 1},
+	{0x2F3F, 0, 0, 2864, 1},
+	{0x2F40, 0, 0, 2865, 1},
+	{0x2F41, 0, 0, 2866, 1},
+	{0x2F42, 0, 0, 2867, 1},
+	{0x2F43, 0, 0, 2868, 1},
+	{0x2F44, 0, 0, 2869, 1},
+	{0x2F45, 0, 0, 2870, 1},
+	{0x2F46, 0, 0, 2871, 1},
+	{0x2F47, 0, 0, 2872, 1},
+	{0x2F48, 0, 0, 2873, 1},
+	{0x2F49, 0, 0, 2874, 1},
+	{0x2F4A, 0, 0, 2875, 1},
+	{0x2F4B, 0, 0, 2876, 1},
+	{0x2F4C, 0, 0, 2877, 1},
+	{0x2F4D, 0, 0, 2878, 1},
+	{0x2F4E, 0, 0, 2879, 1},
+	{0x2F4F, 0, 0, 2880, 1},
+	{0x2F50, 0, 0, 2881, 1},
+	{0x2F51, 0, 0, 2882, 1},
+	{0x2F52, 0, 0, 2883, 1},
+	{0x2F53, 0, 0, 2884, 1},
+	{0x2F54, 0, 0, 2885, 1},
+	{0x2F55, 0, 0, 2886, 1},
+	{0x2F56, 0, 0, 2887, 1},
+	{0x2F57, 0, 0, 2888, 1},
+	{0x2F58, 0, 0, 2889, 1},
+	{0x2F59, 0, 0, 2890, 1},
+	{0x2F5A, 0, 0, 2891, 1},
+	{0x2F5B, 0, 0, 2892, 1},
+	{0x2F5C, 0, 0, 2893, 1},
+	{0x2F5D, 0, 0, 2894, 1},
+	{0x2F5E, 0, 0, 2895, 1},
+	{0x2F5F, 0, 0, 2896, 1},
+	{0x2F60, 0, 0, 2897, 1},
+	{0x2F61, 0, 0, 2898, 1},
+	{0x2F62, 0, 0, 2899, 1},
+	{0x2F63, 0, 0, 2900, 1},
+	{0x2F64, 0, 0, 2901, 1},
+	{0x2F65, 0, 0, 2902, 1},
+	{0x2F66, 0, 0, 2903, 1},
+	{0x2F67, 0, 0, 2904, 1},
+	{0x2F68, 0, 0, 2905, 1},
+	{0x2F69, 0, 0, 2906, 1},
+	{0x2F6A, 0, 0, 2907, 1},
+	{0x2F6B, 0, 0, 2908, 1},
+	{0x2F6C, 0, 0, 2909, 1},
+	{0x2F6D, 0, 0, 2910, 1},
+	{0x2F6E, 0, 0, 2911, 1},
+	{0x2F6F, 0, 0, 2912, 1},
+	{0x2F70, 0, 0, 2913, 1},
+	{0x2F71, 0, 0, 2914, 1},
+	{0x2F72, 0, 0, 2915, 1},
+	{0x2F73, 0, 0, 2916, 1},
+	{0x2F74, 0, 0, 2917, 1},
+	{0x2F75, 0, 0, 2918, 1},
+	{0x2F76, 0, 0, 2919, 1},
+	{0x2F77, 0, 0, 2920, 1},
+	{0x2F78, 0, 0, 2921, 1},
+	{0x2F79, 0, 0, 2922, 1},
+	{0x2F7A, 0, 0, 2923, 1},
+	{0x2F7B, 0, 0, 2924, 1},
+	{0x2F7C, 0, 0, 2925, 1},
+	{0x2F7D, 0, 0, 2926, 1},
+	{0x2F7E, 0, 0, 2927, 1},
+	{0x2F7F, 0, 0, 2928, 1},
+	{0x2F80, 0, 0, 2929, 1},
+	{0x2F81, 0, 0, 2930, 1},
+	{0x2F82, 0, 0, 2931, 1},
+	{0x2F83, 0, 0, 2932, 1},
+	{0x2F84, 0, 0, 2933, 1},
+	{0x2F85, 0, 0, 2934, 1},
+	{0x2F86, 0, 0, 2935, 1},
+	{0x2F87, 0, 0, 2936, 1},
+	{0x2F88, 0, 0, 2937, 1},
+	{0x2F89, 0, 0, 2938, 1},
+	{0x2F8A, 0, 0, 2939, 1},
+	{0x2F8B, 0, 0, 2940, 1},
+	{0x2F8C, 0, 0, 2941, 1},
+	{0x2F8D, 0, 0, 2942, 1},
+	{0x2F8E, 0, 0, 2943, 1},
+	{0x2F8F, 0, 0, 2944, 1},
+	{0x2F90, 0, 0, 2945, 1},
+	{0x2F91, 0, 0, 2946, 1},
+	{0x2F92, 0, 0, 2947, 1},
+	{0x2F93, 0, 0, 2948, 1},
+	{0x2F94, 0, 0, 2949, 1},
+	{0x2F95, 0, 0, 2950, 1},
+	{0x2F96, 0, 0, 2951, 1},
+	{0x2F97, 0, 0, 2952, 1},
+	{0x2F98, 0, 0, 2953, 1},
+	{0x2F99, 0, 0, 2954, 1},
+	{0x2F9A, 0, 0, 2955, 1},
+	{0x2F9B, 0, 0, 2956, 1},
+	{0x2F9C, 0, 0, 2957, 1},
+	{0x2F9D, 0, 0, 2958, 1},
+	{0x2F9E, 0, 0, 2959, 1},
+	{0x2F9F, 0, 0, 2960, 1},
+	{0x2FA0, 0, 0, 2961, 1},
+	{0x2FA1, 0, 0, 2962, 1},
+	{0x2FA2, 0, 0, 2963, 1},
+	{0x2FA3, 0, 0, 2964, 1},
+	{0x2FA4, 0, 0, 2965, 1},
+	{0x2FA5, 0, 0, 2966, 1},
+	{0x2FA6, 0, 0, 2967, 1},
+	{0x2FA7, 0, 0, 2968, 1},
+	{0x2FA8, 0, 0, 2969, 1},
+	{0x2FA9, 0, 0, 2970, 1},
+	{0x2FAA, 0, 0, 2971, 1},
+	{0x2FAB, 0, 0, 2972, 1},
+	{0x2FAC, 0, 0, 2973, 1},
+	{0x2FAD, 0, 0, 2974, 1},
+	{0x2FAE, 0, 0, 2975, 1},
+	{0x2FAF, 0, 0, 2976, 1},
+	{0x2FB0, 0, 0, 2977, 1},
+	{0x2FB1, 0, 0, 2978, 1},
+	{0x2FB2, 0, 0, 2979, 1},
+	{0x2FB3, 0, 0, 2980, 1},
+	{0x2FB4, 0, 0, 2981, 1},
+	{0x2FB5, 0, 0, 2982, 1},
+	{0x2FB6, 0, 0, 2983, 1},
+	{0x2FB7, 0, 0, 2984, 1},
+	{0x2FB8, 0, 0, 2985, 1},
+	{0x2FB9, 0, 0, 2986, 1},
+	{0x2FBA, 0, 0, 2987, 1},
+	{0x2FBB, 0, 0, 2988, 1},
+	{0x2FBC, 0, 0, 2989, 1},
+	{0x2FBD, 0, 0, 2990, 1},
+	{0x2FBE, 0, 0, 2991, 1},
+	{0x2FBF, 0, 0, 2992, 1},
+	{0x2FC0, 0, 0, 2993, 1},
+	{0x2FC1, 0, 0, 2994, 1},
+	{0x2FC2, 0, 0, 2995, 1},
+	{0x2FC3, 0, 0, 2996, 1},
+	{0x2FC4, 0, 0, 2997, 1},
+	{0x2FC5, 0, 0, 2998, 1},
+	{0x2FC6, 0, 0, 2999, 1},
+	{0x2FC7, 0, 0, 3000, 1},
+	{0x2FC8, 0, 0, 3001, 1},
+	{0x2FC9, 0, 0, 3002, 1},
+	{0x2FCA, 0, 0, 3003, 1},
+	{0x2FCB, 0, 0, 3004, 1},
+	{0x2FCC, 0, 0, 3005, 1},
+	{0x2FCD, 0, 0, 3006, 1},
+	{0x2FCE, 0, 0, 3007, 1},
+	{0x2FCF, 0, 0, 3008, 1},
+	{0x2FD0, 0, 0, 3009, 1},
+	{0x2FD1, 0, 0, 3010, 1},
+	{0x2FD2, 0, 0, 3011, 1},
+	{0x2FD3, 0, 0, 3012, 1},
+	{0x2FD4, 0, 0, 3013, 1},
+	{0x2FD5, 0, 0, 3014, 1},
+	{0x3000, 0, 0, 0, 1},
+	{0x3036, 0, 0, 3015, 1},
+	{0x3038, 0, 0, 2824, 1},
+	{0x3039, 0, 0, 3016, 1},
+	{0x303A, 0, 0, 3017, 1},
+	{0x304C, 3018, 2, 3018, 2},
+	{0x304E, 3020, 2, 3020, 2},
+	{0x3050, 3022, 2, 3022, 2},
+	{0x3052, 3024, 2, 3024, 2},
+	{0x3054, 3026, 2, 3026, 2},
+	{0x3056, 3028, 2, 3028, 2},
+	{0x3058, 3030, 2, 3030, 2},
+	{0x305A, 3032, 2, 3032, 2},
+	{0x305C, 3034, 2, 3034, 2},
+	{0x305E, 3036, 2, 3036, 2},
+	{0x3060, 3038, 2, 3038, 2},
+	{0x3062, 3040, 2, 3040, 2},
+	{0x3065, 3042, 2, 3042, 2},
+	{0x3067, 3044, 2, 3044, 2},
+	{0x3069, 3046, 2, 3046, 2},
+	{0x3070, 3048, 2, 3048, 2},
+	{0x3071, 3050, 2, 3050, 2},
+	{0x3073, 3052, 2, 3052, 2},
+	{0x3074, 3054, 2, 3054, 2},
+	{0x3076, 3056, 2, 3056, 2},
+	{0x3077, 3058, 2, 3058, 2},
+	{0x3079, 3060, 2, 3060, 2},
+	{0x307A, 3062, 2, 3062, 2},
+	{0x307C, 3064, 2, 3064, 2},
+	{0x307D, 3066, 2, 3066, 2},
+	{0x3094, 3068, 2, 3068, 2},
+	{0x309B, 0, 0, 3070, 2},
+	{0x309C, 0, 0, 3072, 2},
+	{0x309E, 3074, 2, 3074, 2},
+	{0x309F, 0, 0, 3076, 2},
+	{0x30AC, 3078, 2, 3078, 2},
+	{0x30AE, 3080, 2, 3080, 2},
+	{0x30B0, 3082, 2, 3082, 2},
+	{0x30B2, 3084, 2, 3084, 2},
+	{0x30B4, 3086, 2, 3086, 2},
+	{0x30B6, 3088, 2, 3088, 2},
+	{0x30B8, 3090, 2, 3090, 2},
+	{0x30BA, 3092, 2, 3092, 2},
+	{0x30BC, 3094, 2, 3094, 2},
+	{0x30BE, 3096, 2, 3096, 2},
+	{0x30C0, 3098, 2, 3098, 2},
+	{0x30C2, 3100, 2, 3100, 2},
+	{0x30C5, 3102, 2, 3102, 2},
+	{0x30C7, 3104, 2, 3104, 2},
+	{0x30C9, 3106, 2, 3106, 2},
+	{0x30D0, 3108, 2, 3108, 2},
+	{0x30D1, 3110, 2, 3110, 2},
+	{0x30D3, 3112, 2, 3112, 2},
+	{0x30D4, 3114, 2, 3114, 2},
+	{0x30D6, 3116, 2, 3116, 2},
+	{0x30D7, 3118, 2, 3118, 2},
+	{0x30D9, 3120, 2, 3120, 2},
+	{0x30DA, 3122, 2, 3122, 2},
+	{0x30DC, 3124, 2, 3124, 2},
+	{0x30DD, 3126, 2, 3126, 2},
+	{0x30F4, 3128, 2, 3128, 2},
+	{0x30F7, 3130, 2, 3130, 2},
+	{0x30F8, 3132, 2, 3132, 2},
+	{0x30F9, 3134, 2, 3134, 2},
+	{0x30FA, 3136, 2, 3136, 2},
+	{0x30FE, 3138, 2, 3138, 2},
+	{0x30FF, 0, 0, 3140, 2},
+	{0x3131, 0, 0, 3142, 1},
+	{0x3132, 0, 0, 3143, 1},
+	{0x3133, 0, 0, 3144, 1},
+	{0x3134, 0, 0, 3145, 1},
+	{0x3135, 0, 0, 3146, 1},
+	{0x3136, 0, 0, 3147, 1},
+	{0x3137, 0, 0, 3148, 1},
+	{0x3138, 0, 0, 3149, 1},
+	{0x3139, 0, 0, 3150, 1},
+	{0x313A, 0, 0, 3151, 1},
+	{0x313B, 0, 0, 3152, 1},
+	{0x313C, 0, 0, 3153, 1},
+	{0x313D, 0, 0, 3154, 1},
+	{0x313E, 0, 0, 3155, 1},
+	{0x313F, 0, 0, 3156, 1},
+	{0x3140, 0, 0, 3157, 1},
+	{0x3141, 0, 0, 3158, 1},
+	{0x3142, 0, 0, 3159, 1},
+	{0x3143, 0, 0, 3160, 1},
+	{0x3144, 0, 0, 3161, 1},
+	{0x3145, 0, 0, 3162, 1},
+	{0x3146, 0, 0, 3163, 1},
+	{0x3147, 0, 0, 3164, 1},
+	{0x3148, 0, 0, 3165, 1},
+	{0x3149, 0, 0, 3166, 1},
+	{0x314A, 0, 0, 3167, 1},
+	{0x314B, 0, 0, 3168, 1},
+	{0x314C, 0, 0, 3169, 1},
+	{0x314D, 0, 0, 3170, 1},
+	{0x314E, 0, 0, 3171, 1},
+	{0x314F, 0, 0, 3172, 1},
+	{0x3150, 0, 0, 3173, 1},
+	{0x3151, 0, 0, 3174, 1},
+	{0x3152, 0, 0, 3175, 1},
+	{0x3153, 0, 0, 3176, 1},
+	{0x3154, 0, 0, 3177, 1},
+	{0x3155, 0, 0, 3178, 1},
+	{0x3156, 0, 0, 3179, 1},
+	{0x3157, 0, 0, 3180, 1},
+	{0x3158, 0, 0, 3181, 1},
+	{0x3159, 0, 0, 3182, 1},
+	{0x315A, 0, 0, 3183, 1},
+	{0x315B, 0, 0, 3184, 1},
+	{0x315C, 0, 0, 3185, 1},
+	{0x315D, 0, 0, 3186, 1},
+	{0x315E, 0, 0, 3187, 1},
+	{0x315F, 0, 0, 3188, 1},
+	{0x3160, 0, 0, 3189, 1},
+	{0x3161, 0, 0, 3190, 1},
+	{0x3162, 0, 0, 3191, 1},
+	{0x3163, 0, 0, 3192, 1},
+	{0x3164, 0, 0, 3193, 1},
+	{0x3165, 0, 0, 3194, 1},
+	{0x3166, 0, 0, 3195, 1},
+	{0x3167, 0, 0, 3196, 1},
+	{0x3168, 0, 0, 3197, 1},
+	{0x3169, 0, 0, 3198, 1},
+	{0x316A, 0, 0, 3199, 1},
+	{0x316B, 0, 0, 3200, 1},
+	{0x316C, 0, 0, 3201, 1},
+	{0x316D, 0, 0, 3202, 1},
+	{0x316E, 0, 0, 3203, 1},
+	{0x316F, 0, 0, 3204, 1},
+	{0x3170, 0, 0, 3205, 1},
+	{0x3171, 0, 0, 3206, 1},
+	{0x3172, 0, 0, 3207, 1},
+	{0x3173, 0, 0, 3208, 1},
+	{0x3174, 0, 0, 3209, 1},
+	{0x3175, 0, 0, 3210, 1},
+	{0x3176, 0, 0, 3211, 1},
+	{0x3177, 0, 0, 3212, 1},
+	{0x3178, 0, 0, 3213, 1},
+	{0x3179, 0, 0, 3214, 1},
+	{0x317A, 0, 0, 3215, 1},
+	{0x317B, 0, 0, 3216, 1},
+	{0x317C, 0, 0, 3217, 1},
+	{0x317D, 0, 0, 3218, 1},
+	{0x317E, 0, 0, 3219, 1},
+	{0x317F, 0, 0, 3220, 1},
+	{0x3180, 0, 0, 3221, 1},
+	{0x3181, 0, 0, 3222, 1},
+	{0x3182, 0, 0, 3223, 1},
+	{0x3183, 0, 0, 3224, 1},
+	{0x3184, 0, 0, 3225, 1},
+	{0x3185, 0, 0, 3226, 1},
+	{0x3186, 0, 0, 3227, 1},
+	{0x3187, 0, 0, 3228, 1},
+	{0x3188, 0, 0, 3229, 1},
+	{0x3189, 0, 0, 3230, 1},
+	{0x318A, 0, 0, 3231, 1},
+	{0x318B, 0, 0, 3232, 1},
+	{0x318C, 0, 0, 3233, 1},
+	{0x318D, 0, 0, 3234, 1},
+	{0x318E, 0, 0, 3235, 1},
+	{0x3192, 0, 0, 2801, 1},
+	{0x3193, 0, 0, 2807, 1},
+	{0x3194, 0, 0, 3236, 1},
+	{0x3195, 0, 0, 3237, 1},
+	{0x3196, 0, 0, 3238, 1},
+	{0x3197, 0, 0, 3239, 1},
+	{0x3198, 0, 0, 3240, 1},
+	{0x3199, 0, 0, 3241, 1},
+	{0x319A, 0, 0, 2805, 1},
+	{0x319B, 0, 0, 3242, 1},
+	{0x319C, 0, 0, 3243, 1},
+	{0x319D, 0, 0, 3244, 1},
+	{0x319E, 0, 0, 3245, 1},
+	{0x319F, 0, 0, 2809, 1},
+	{0x3200, 0, 0, 3246, 3},
+	{0x3201, 0, 0, 3249, 3},
+	{0x3202, 0, 0, 3252, 3},
+	{0x3203, 0, 0, 3255, 3},
+	{0x3204, 0, 0, 3258, 3},
+	{0x3205, 0, 0, 3261, 3},
+	{0x3206, 0, 0, 3264, 3},
+	{0x3207, 0, 0, 3267, 3},
+	{0x3208, 0, 0, 3270, 3},
+	{0x3209, 0, 0, 3273, 3},
+	{0x320A, 0, 0, 3276, 3},
+	{0x320B, 0, 0, 3279, 3},
+	{0x320C, 0, 0, 3282, 3},
+	{0x320D, 0, 0, 3285, 3},
+	{0x320E, 0, 0, 3288, 4},
+	{0x320F, 0, 0, 3292, 4},
+	{0x3210, 0, 0, 3296, 4},
+	{0x3211, 0, 0, 3300, 4},
+	{0x3212, 0, 0, 3304, 4},
+	{0x3213, 0, 0, 3308, 4},
+	{0x3214, 0, 0, 3312, 4},
+	{0x3215, 0, 0, 3316, 4},
+	{0x3216, 0, 0, 3320, 4},
+	{0x3217, 0, 0, 3324, 4},
+	{0x3218, 0, 0, 3328, 4},
+	{0x3219, 0, 0, 3332, 4},
+	{0x321A, 0, 0, 3336, 4},
+	{0x321B, 0, 0, 3340, 4},
+	{0x321C, 0, 0, 3344, 4},
+	{0x321D, 0, 0, 3348, 7},
+	{0x321E, 0, 0, 3355, 6},
+	{0x3220, 0, 0, 3361, 3},
+	{0x3221, 0, 0, 3364, 3},
+	{0x3222, 0, 0, 3367, 3},
+	{0x3223, 0, 0, 3370, 3},
+	{0x3224, 0, 0, 3373, 3},
+	{0x3225, 0, 0, 3376, 3},
+	{0x3226, 0, 0, 3379, 3},
+	{0x3227, 0, 0, 3382, 3},
+	{0x3228, 0, 0, 3385, 3},
+	{0x3229, 0, 0, 3388, 3},
+	{0x322A, 0, 0, 3391, 3},
+	{0x322B, 0, 0, 3394, 3},
+	{0x322C, 0, 0, 3397, 3},
+	{0x322D, 0, 0, 3400, 3},
+	{0x322E, 0, 0, 3403, 3},
+	{0x322F, 0, 0, 3406, 3},
+	{0x3230, 0, 0, 3409, 3},
+	{0x3231, 0, 0, 3412, 3},
+	{0x3232, 0, 0, 3415, 3},
+	{0x3233, 0, 0, 3418, 3},
+	{0x3234, 0, 0, 3421, 3},
+	{0x3235, 0, 0, 3424, 3},
+	{0x3236, 0, 0, 3427, 3},
+	{0x3237, 0, 0, 3430, 3},
+	{0x3238, 0, 0, 3433, 3},
+	{0x3239, 0, 0, 3436, 3},
+	{0x323A, 0, 0, 3439, 3},
+	{0x323B, 0, 0, 3442, 3},
+	{0x323C, 0, 0, 3445, 3},
+	{0x323D, 0, 0, 3448, 3},
+	{0x323E, 0, 0, 3451, 3},
+	{0x323F, 0, 0, 3454, 3},
+	{0x3240, 0, 0, 3457, 3},
+	{0x3241, 0, 0, 3460, 3},
+	{0x3242, 0, 0, 3463, 3},
+	{0x3243, 0, 0, 3466, 3},
+	{0x3244, 0, 0, 3469, 1},
+	{0x3245, 0, 0, 3470, 1},
+	{0x3246, 0, 0, 2867, 1},
+	{0x3247, 0, 0, 3471, 1},
+	{0x3250, 0, 0, 3472, 3},
+	{0x3251, 0, 0, 3475, 2},
+	{0x3252, 0, 0, 3477, 2},
+	{0x3253, 0, 0, 3479, 2},
+	{0x3254, 0, 0, 3481, 2},
+	{0x3255, 0, 0, 3483, 2},
+	{0x3256, 0, 0, 3485, 2},
+	{0x3257, 0, 0, 3487, 2},
+	{0x3258, 0, 0, 3489, 2},
+	{0x3259, 0, 0, 3491, 2},
+	{0x325A, 0, 0, 3493, 2},
+	{0x325B, 0, 0, 3495, 2},
+	{0x325C, 0, 0, 3497, 2},
+	{0x325D, 0, 0, 3499, 2},
+	{0x325E, 0, 0, 3501, 2},
+	{0x325F, 0, 0, 3503, 2},
+	{0x3260, 0, 0, 3142, 1},
+	{0x3261, 0, 0, 3145, 1},
+	{0x3262, 0, 0, 3148, 1},
+	{0x3263, 0, 0, 3150, 1},
+	{0x3264, 0, 0, 3158, 1},
+	{0x3265, 0, 0, 3159, 1},
+	{0x3266, 0, 0, 3162, 1},
+	{0x3267, 0, 0, 3164, 1},
+	{0x3268, 0, 0, 3165, 1},
+	{0x3269, 0, 0, 3167, 1},
+	{0x326A, 0, 0, 3168, 1},
+	{0x326B, 0, 0, 3169, 1},
+	{0x326C, 0, 0, 3170, 1},
+	{0x326D, 0, 0, 3171, 1},
+	{0x326E, 0, 0, 3505, 2},
+	{0x326F, 0, 0, 3507, 2},
+	{0x3270, 0, 0, 3509, 2},
+	{0x3271, 0, 0, 3511, 2},
+	{0x3272, 0, 0, 3513, 2},
+	{0x3273, 0, 0, 3515, 2},
+	{0x3274, 0, 0, 3517, 2},
+	{0x3275, 0, 0, 3519, 2},
+	{0x3276, 0, 0, 3521, 2},
+	{0x3277, 0, 0, 3523, 2},
+	{0x3278, 0, 0, 3525, 2},
+	{0x3279, 0, 0, 3527, 2},
+	{0x327A, 0, 0, 3529, 2},
+	{0x327B, 0, 0, 3531, 2},
+	{0x327C, 0, 0, 3533, 5},
+	{0x327D, 0, 0, 3538, 4},
+	{0x327E, 0, 0, 3542, 2},
+	{0x3280, 0, 0, 2801, 1},
+	{0x3281, 0, 0, 2807, 1},
+	{0x3282, 0, 0, 3236, 1},
+	{0x3283, 0, 0, 3237, 1},
+	{0x3284, 0, 0, 3544, 1},
+	{0x3285, 0, 0, 3545, 1},
+	{0x3286, 0, 0, 3546, 1},
+	{0x3287, 0, 0, 2812, 1},
+	{0x3288, 0, 0, 3547, 1},
+	{0x3289, 0, 0, 2824, 1},
+	{0x328A, 0, 0, 2874, 1},
+	{0x328B, 0, 0, 2886, 1},
+	{0x328C, 0, 0, 2885, 1},
+	{0x328D, 0, 0, 2875, 1},
+	{0x328E, 0, 0, 2967, 1},
+	{0x328F, 0, 0, 2832, 1},
+	{0x3290, 0, 0, 2872, 1},
+	{0x3291, 0, 0, 3548, 1},
+	{0x3292, 0, 0, 3549, 1},
+	{0x3293, 0, 0, 3550, 1},
+	{0x3294, 0, 0, 3551, 1},
+	{0x3295, 0, 0, 3552, 1},
+	{0x3296, 0, 0, 3553, 1},
+	{0x3297, 0, 0, 3554, 1},
+	{0x3298, 0, 0, 3555, 1},
+	{0x3299, 0, 0, 3556, 1},
+	{0x329A, 0, 0, 3557, 1},
+	{0x329B, 0, 0, 2838, 1},
+	{0x329C, 0, 0, 3558, 1},
+	{0x329D, 0, 0, 3559, 1},
+	{0x329E, 0, 0, 3560, 1},
+	{0x329F, 0, 0, 3561, 1},
+	{0x32A0, 0, 0, 3562, 1},
+	{0x32A1, 0, 0, 3563, 1},
+	{0x32A2, 0, 0, 3564, 1},
+	{0x32A3, 0, 0, 3565, 1},
+	{0x32A4, 0, 0, 3238, 1},
+	{0x32A5, 0, 0, 3239, 1},
+	{0x32A6, 0, 0, 3240, 1},
+	{0x32A7, 0, 0, 3566, 1},
+	{0x32A8, 0, 0, 3567, 1},
+	{0x32A9, 0, 0, 3568, 1},
+	{0x32AA, 0, 0, 3569, 1},
+	{0x32AB, 0, 0, 3570, 1},
+	{0x32AC, 0, 0, 3571, 1},
+	{0x32AD, 0, 0, 3572, 1},
+	{0x32AE, 0, 0, 3573, 1},
+	{0x32AF, 0, 0, 3574, 1},
+	{0x32B0, 0, 0, 3575, 1},
+	{0x32B1, 0, 0, 3576, 2},
+	{0x32B2, 0, 0, 3578, 2},
+	{0x32B3, 0, 0, 3580, 2},
+	{0x32B4, 0, 0, 3582, 2},
+	{0x32B5, 0, 0, 3584, 2},
+	{0x32B6, 0, 0, 3586, 2},
+	{0x32B7, 0, 0, 3588, 2},
+	{0x32B8, 0, 0, 3590, 2},
+	{0x32B9, 0, 0, 3592, 2},
+	{0x32BA, 0, 0, 3594, 2},
+	{0x32BB, 0, 0, 3596, 2},
+	{0x32BC, 0, 0, 3598, 2},
+	{0x32BD, 0, 0, 3600, 2},
+	{0x32BE, 0, 0, 3602, 2},
+	{0x32BF, 0, 0, 3604, 2},
+	{0x32C0, 0, 0, 3606, 2},
+	{0x32C1, 0, 0, 3608, 2},
+	{0x32C2, 0, 0, 3610, 2},
+	{0x32C3, 0, 0, 3612, 2},
+	{0x32C4, 0, 0, 3614, 2},
+	{0x32C5, 0, 0, 3616, 2},
+	{0x32C6, 0, 0, 3618, 2},
+	{0x32C7, 0, 0, 3620, 2},
+	{0x32C8, 0, 0, 3622, 2},
+	{0x32C9, 0, 0, 3624, 3},
+	{0x32CA, 0, 0, 3627, 3},
+	{0x32CB, 0, 0, 3630, 3},
+	{0x32CC, 0, 0, 3633, 2},
+	{0x32CD, 0, 0, 3635, 3},
+	{0x32CE, 0, 0, 3638, 2},
+	{0x32CF, 0, 0, 3640, 3},
+	{0x32D0, 0, 0, 3643, 1},
+	{0x32D1, 0, 0, 3644, 1},
+	{0x32D2, 0, 0, 3645, 1},
+	{0x32D3, 0, 0, 3646, 1},
+	{0x32D4, 0, 0, 3647, 1},
+	{0x32D5, 0, 0, 3648, 1},
+	{0x32D6, 0, 0, 3649, 1},
+	{0x32D7, 0, 0, 3650, 1},
+	{0x32D8, 0, 0, 3651, 1},
+	{0x32D9, 0, 0, 3652, 1},
+	{0x32DA, 0, 0, 3653, 1},
+	{0x32DB, 0, 0, 3654, 1},
+	{0x32DC, 0, 0, 3655, 1},
+	{0x32DD, 0, 0, 3656, 1},
+	{0x32DE, 0, 0, 3657, 1},
+	{0x32DF, 0, 0, 3658, 1},
+	{0x32E0, 0, 0, 3659, 1},
+	{0x32E1, 0, 0, 3660, 1},
+	{0x32E2, 0, 0, 3661, 1},
+	{0x32E3, 0, 0, 3662, 1},
+	{0x32E4, 0, 0, 3663, 1},
+	{0x32E5, 0, 0, 3664, 1},
+	{0x32E6, 0, 0, 3665, 1},
+	{0x32E7, 0, 0, 3666, 1},
+	{0x32E8, 0, 0, 3667, 1},
+	{0x32E9, 0, 0, 3668, 1},
+	{0x32EA, 0, 0, 3669, 1},
+	{0x32EB, 0, 0, 3670, 1},
+	{0x32EC, 0, 0, 3671, 1},
+	{0x32ED, 0, 0, 3672, 1},
+	{0x32EE, 0, 0, 3673, 1},
+	{0x32EF, 0, 0, 3674, 1},
+	{0x32F0, 0, 0, 3675, 1},
+	{0x32F1, 0, 0, 3676, 1},
+	{0x32F2, 0, 0, 3677, 1},
+	{0x32F3, 0, 0, 3678, 1},
+	{0x32F4, 0, 0, 3679, 1},
+	{0x32F5, 0, 0, 3680, 1},
+	{0x32F6, 0, 0, 3681, 1},
+	{0x32F7, 0, 0, 3682, 1},
+	{0x32F8, 0, 0, 3683, 1},
+	{0x32F9, 0, 0, 3684, 1},
+	{0x32FA, 0, 0, 3685, 1},
+	{0x32FB, 0, 0, 3686, 1},
+	{0x32FC, 0, 0, 3687, 1},
+	{0x32FD, 0, 0, 3688, 1},
+	{0x32FE, 0, 0, 3689, 1},
+	{0x32FF, 0, 0, 3690, 2},
+	{0x3300, 0, 0, 3692, 5},
+	{0x3301, 0, 0, 3697, 4},
+	{0x3302, 0, 0, 3701, 5},
+	{0x3303, 0, 0, 3706, 3},
+	{0x3304, 0, 0, 3709, 5},
+	{0x3305, 0, 0, 3714, 3},
+	{0x3306, 0, 0, 3717, 3},
+	{0x3307, 0, 0, 3720, 6},
+	{0x3308, 0, 0, 3726, 4},
+	{0x3309, 0, 0, 3730, 3},
+	{0x330A, 0, 0, 3733, 3},
+	{0x330B, 0, 0, 3736, 3},
+	{0x330C, 0, 0, 3739, 4},
+	{0x330D, 0, 0, 3743, 4},
+	{0x330E, 0, 0, 3747, 4},
+	{0x330F, 0, 0, 3751, 4},
+	{0x3310, 0, 0, 3755, 4},
+	{0x3311, 0, 0, 3759, 4},
+	{0x3312, 0, 0, 3763, 4},
+	{0x3313, 0, 0, 3767, 6},
+	{0x3314, 0, 0, 3773, 2},
+	{0x3315, 0, 0, 3775, 6},
+	{0x3316, 0, 0, 3781, 6},
+	{0x3317, 0, 0, 3787, 5},
+	{0x3318, 0, 0, 3792, 4},
+	{0x3319, 0, 0, 3796, 6},
+	{0x331A, 0, 0, 3802, 6},
+	{0x331B, 0, 0, 3808, 4},
+	{0x331C, 0, 0, 3812, 3},
+	{0x331D, 0, 0, 3815, 3},
+	{0x331E, 0, 0, 3818, 4},
+	{0x331F, 0, 0, 3822, 4},
+	{0x3320, 0, 0, 3826, 5},
+	{0x3321, 0, 0, 3831, 5},
+	{0x3322, 0, 0, 3836, 3},
+	{0x3323, 0, 0, 3839, 3},
+	{0x3324, 0, 0, 3842, 4},
+	{0x3325, 0, 0, 3846, 3},
+	{0x3326, 0, 0, 3849, 3},
+	{0x3327, 0, 0, 3852, 2},
+	{0x3328, 0, 0, 3854, 2},
+	{0x3329, 0, 0, 3856, 3},
+	{0x332A, 0, 0, 3859, 3},
+	{0x332B, 0, 0, 3862, 6},
+	{0x332C, 0, 0, 3868, 4},
+	{0x332D, 0, 0, 3872, 5},
+	{0x332E, 0, 0, 3877, 6},
+	{0x332F, 0, 0, 3883, 4},
+	{0x3330, 0, 0, 3887, 3},
+	{0x3331, 0, 0, 3890, 3},
+	{0x3332, 0, 0, 3893, 6},
+	{0x3333, 0, 0, 3899, 4},
+	{0x3334, 0, 0, 3903, 6},
+	{0x3335, 0, 0, 3909, 3},
+	{0x3336, 0, 0, 3912, 5},
+	{0x3337, 0, 0, 3917, 3},
+	{0x3338, 0, 0, 3920, 4},
+	{0x3339, 0, 0, 3924, 3},
+	{0x333A, 0, 0, 3927, 4},
+	{0x333B, 0, 0, 3931, 5},
+	{0x333C, 0, 0, 3936, 4},
+	{0x333D, 0, 0, 3940, 5},
+	{0x333E, 0, 0, 3945, 4},
+	{0x333F, 0, 0, 3949, 2},
+	{0x3340, 0, 0, 3951, 5},
+	{0x3341, 0, 0, 3956, 3},
+	{0x3342, 0, 0, 3959, 3},
+	{0x3343, 0, 0, 3962, 4},
+	{0x3344, 0, 0, 3966, 3},
+	{0x3345, 0, 0, 3969, 3},
+	{0x3346, 0, 0, 3972, 3},
+	{0x3347, 0, 0, 3975, 5},
+	{0x3348, 0, 0, 3980, 4},
+	{0x3349, 0, 0, 3984, 2},
+	{0x334A, 0, 0, 3986, 6},
+	{0x334B, 0, 0, 3992, 3},
+	{0x334C, 0, 0, 3995, 5},
+	{0x334D, 0, 0, 4000, 4},
+	{0x334E, 0, 0, 4004, 4},
+	{0x334F, 0, 0, 4008, 3},
+	{0x3350, 0, 0, 4011, 3},
+	{0x3351, 0, 0, 4014, 4},
+	{0x3352, 0, 0, 4018, 2},
+	{0x3353, 0, 0, 4020, 4},
+	{0x3354, 0, 0, 4024, 5},
+	{0x3355, 0, 0, 4029, 2},
+	{0x3356, 0, 0, 4031, 6},
+	{0x3357, 0, 0, 4037, 3},
+	{0x3358, 0, 0, 4040, 2},
+	{0x3359, 0, 0, 4042, 2},
+	{0x335A, 0, 0, 4044, 2},
+	{0x335B, 0, 0, 4046, 2},
+	{0x335C, 0, 0, 4048, 2},
+	{0x335D, 0, 0, 4050, 2},
+	{0x335E, 0, 0, 4052, 2},
+	{0x335F, 0, 0, 4054, 2},
+	{0x3360, 0, 0, 4056, 2},
+	{0x3361, 0, 0, 4058, 2},
+	{0x3362, 0, 0, 4060, 3},
+	{0x3363, 0, 0, 4063, 3},
+	{0x3364, 0, 0, 4066, 3},
+	{0x3365, 0, 0, 4069, 3},
+	{0x3366, 0, 0, 4072, 3},
+	{0x3367, 0, 0, 4075, 3},
+	{0x3368, 0, 0, 4078, 3},
+	{0x3369, 0, 0, 4081, 3},
+	{0x336A, 0, 0, 4084, 3},
+	{0x336B, 0, 0, 4087, 3},
+	{0x336C, 0, 0, 4090, 3},
+	{0x336D, 0, 0, 4093, 3},
+	{0x336E, 0, 0, 4096, 3},
+	{0x336F, 0, 0, 4099, 3},
+	{0x3370, 0, 0, 4102, 3},
+	{0x3371, 0, 0, 4105, 3},
+	{0x3372, 0, 0, 4108, 2},
+	{0x3373, 0, 0, 4110, 2},
+	{0x3374, 0, 0, 4112, 3},
+	{0x3375, 0, 0, 4115, 2},
+	{0x3376, 0, 0, 4117, 2},
+	{0x3377, 0, 0, 4119, 2},
+	{0x3378, 0, 0, 4121, 3},
+	{0x3379, 0, 0, 4124, 3},
+	{0x337A, 0, 0, 4127, 2},
+	{0x337B, 0, 0, 4129, 2},
+	{0x337C, 0, 0, 4131, 2},
+	{0x337D, 0, 0, 4133, 2},
+	{0x337E, 0, 0, 4135, 2},
+	{0x337F, 0, 0, 4137, 4},
+	{0x3380, 0, 0, 4141, 2},
+	{0x3381, 0, 0, 4143, 2},
+	{0x3382, 0, 0, 4145, 2},
+	{0x3383, 0, 0, 4147, 2},
+	{0x3384, 0, 0, 4149, 2},
+	{0x3385, 0, 0, 4151, 2},
+	{0x3386, 0, 0, 4153, 2},
+	{0x3387, 0, 0, 4155, 2},
+	{0x3388, 0, 0, 4157, 3},
+	{0x3389, 0, 0, 4160, 4},
+	{0x338A, 0, 0, 4164, 2},
+	{0x338B, 0, 0, 4166, 2},
+	{0x338C, 0, 0, 4168, 2},
+	{0x338D, 0, 0, 4170, 2},
+	{0x338E, 0, 0, 4172, 2},
+	{0x338F, 0, 0, 4174, 2},
+	{0x3390, 0, 0, 4176, 2},
+	{0x3391, 0, 0, 4178, 3},
+	{0x3392, 0, 0, 4181, 3},
+	{0x3393, 0, 0, 4184, 3},
+	{0x3394, 0, 0, 4187, 3},
+	{0x3395, 0, 0, 4190, 2},
+	{0x3396, 0, 0, 4192, 2},
+	{0x3397, 0, 0, 4194, 2},
+	{0x3398, 0, 0, 4196, 2},
+	{0x3399, 0, 0, 4198, 2},
+	{0x339A, 0, 0, 4200, 2},
+	{0x339B, 0, 0, 4202, 2},
+	{0x339C, 0, 0, 4204, 2},
+	{0x339D, 0, 0, 4206, 2},
+	{0x339E, 0, 0, 4208, 2},
+	{0x339F, 0, 0, 4210, 3},
+	{0x33A0, 0, 0, 4213, 3},
+	{0x33A1, 0, 0, 4216, 2},
+	{0x33A2, 0, 0, 4218, 3},
+	{0x33A3, 0, 0, 4221, 3},
+	{0x33A4, 0, 0, 4224, 3},
+	{0x33A5, 0, 0, 4227, 2},
+	{0x33A6, 0, 0, 4229, 3},
+	{0x33A7, 0, 0, 4232, 3},
+	{0x33A8, 0, 0, 4235, 4},
+	{0x33A9, 0, 0, 4239, 2},
+	{0x33AA, 0, 0, 4241, 3},
+	{0x33AB, 0, 0, 4244, 3},
+	{0x33AC, 0, 0, 4247, 3},
+	{0x33AD, 0, 0, 4250, 3},
+	{0x33AE, 0, 0, 4253, 5},
+	{0x33AF, 0, 0, 4258, 6},
+	{0x33B0, 0, 0, 4264, 2},
+	{0x33B1, 0, 0, 4266, 2},
+	{0x33B2, 0, 0, 4268, 2},
+	{0x33B3, 0, 0, 4270, 2},
+	{0x33B4, 0, 0, 4272, 2},
+	{0x33B5, 0, 0, 4274, 2},
+	{0x33B6, 0, 0, 4276, 2},
+	{0x33B7, 0, 0, 4278, 2},
+	{0x33B8, 0, 0, 4280, 2},
+	{0x33B9, 0, 0, 4282, 2},
+	{0x33BA, 0, 0, 4284, 2},
+	{0x33BB, 0, 0, 4286, 2},
+	{0x33BC, 0, 0, 4288, 2},
+	{0x33BD, 0, 0, 4290, 2},
+	{0x33BE, 0, 0, 4292, 2},
+	{0x33BF, 0, 0, 4294, 2},
+	{0x33C0, 0, 0, 4296, 2},
+	{0x33C1, 0, 0, 4298, 2},
+	{0x33C2, 0, 0, 4300, 4},
+	{0x33C3, 0, 0, 4304, 2},
+	{0x33C4, 0, 0, 4306, 2},
+	{0x33C5, 0, 0, 4308, 2},
+	{0x33C6, 0, 0, 4310, 4},
+	{0x33C7, 0, 0, 4314, 3},
+	{0x33C8, 0, 0, 4317, 2},
+	{0x33C9, 0, 0, 4319, 2},
+	{0x33CA, 0, 0, 4321, 2},
+	{0x33CB, 0, 0, 4323, 2},
+	{0x33CC, 0, 0, 4325, 2},
+	{0x33CD, 0, 0, 4327, 2},
+	{0x33CE, 0, 0, 4329, 2},
+	{0x33CF, 0, 0, 4331, 2},
+	{0x33D0, 0, 0, 4333, 2},
+	{0x33D1, 0, 0, 4335, 2},
+	{0x33D2, 0, 0, 4337, 3},
+	{0x33D3, 0, 0, 4340, 2},
+	{0x33D4, 0, 0, 4342, 2},
+	{0x33D5, 0, 0, 4344, 3},
+	{0x33D6, 0, 0, 4347, 3},
+	{0x33D7, 0, 0, 4350, 2},
+	{0x33D8, 0, 0, 4352, 4},
+	{0x33D9, 0, 0, 4356, 3},
+	{0x33DA, 0, 0, 4359, 2},
+	{0x33DB, 0, 0, 4361, 2},
+	{0x33DC, 0, 0, 4363, 2},
+	{0x33DD, 0, 0, 4365, 2},
+	{0x33DE, 0, 0, 4367, 3},
+	{0x33DF, 0, 0, 4370, 3},
+	{0x33E0, 0, 0, 4373, 2},
+	{0x33E1, 0, 0, 4375, 2},
+	{0x33E2, 0, 0, 4377, 2},
+	{0x33E3, 0, 0, 4379, 2},
+	{0x33E4, 0, 0, 4381, 2},
+	{0x33E5, 0, 0, 4383, 2},
+	{0x33E6, 0, 0, 4385, 2},
+	{0x33E7, 0, 0, 4387, 2},
+	{0x33E8, 0, 0, 4389, 2},
+	{0x33E9, 0, 0, 4391, 3},
+	{0x33EA, 0, 0, 4394, 3},
+	{0x33EB, 0, 0, 4397, 3},
+	{0x33EC, 0, 0, 4400, 3},
+	{0x33ED, 0, 0, 4403, 3},
+	{0x33EE, 0, 0, 4406, 3},
+	{0x33EF, 0, 0, 4409, 3},
+	{0x33F0, 0, 0, 4412, 3},
+	{0x33F1, 0, 0, 4415, 3},
+	{0x33F2, 0, 0, 4418, 3},
+	{0x33F3, 0, 0, 4421, 3},
+	{0x33F4, 0, 0, 4424, 3},
+	{0x33F5, 0, 0, 4427, 3},
+	{0x33F6, 0, 0, 4430, 3},
+	{0x33F7, 0, 0, 4433, 3},
+	{0x33F8, 0, 0, 4436, 3},
+	{0x33F9, 0, 0, 4439, 3},
+	{0x33FA, 0, 0, 4442, 3},
+	{0x33FB, 0, 0, 4445, 3},
+	{0x33FC, 0, 0, 4448, 3},
+	{0x33FD, 0, 0, 4451, 3},
+	{0x33FE, 0, 0, 4454, 3},
+	{0x33FF, 0, 0, 4457, 3},
+	{0xA69C, 0, 0, 4460, 1},
+	{0xA69D, 0, 0, 4461, 1},
+	{0xA770, 0, 0, 4462, 1},
+	{0xA7F1, 0, 0, 2781, 1},
+	{0xA7F2, 0, 0, 2324, 1},
+	{0xA7F3, 0, 0, 2349, 1},
+	{0xA7F4, 0, 0, 2339, 1},
+	{0xA7F8, 0, 0, 4463, 1},
+	{0xA7F9, 0, 0, 4464, 1},
+	{0xAB5C, 0, 0, 4465, 1},
+	{0xAB5D, 0, 0, 4466, 1},
+	{0xAB5E, 0, 0, 4467, 1},
+	{0xAB5F, 0, 0, 4468, 1},
+	{0xAB69, 0, 0, 4469, 1},
+	{0xF900, 4470, 1, 4470, 1},
+	{0xF901, 4471, 1, 4471, 1},
+	{0xF902, 2959, 1, 2959, 1},
+	{0xF903, 4472, 1, 4472, 1},
+	{0xF904, 4473, 1, 4473, 1},
+	{0xF905, 4474, 1, 4474, 1},
+	{0xF906, 4475, 1, 4475, 1},
+	{0xF907, 3013, 1, 3013, 1},
+	{0xF908, 3013, 1, 3013, 1},
+	{0xF909, 4476, 1, 4476, 1},
+	{0xF90A, 2967, 1, 2967, 1},
+	{0xF90B, 4477, 1, 4477, 1},
+	{0xF90C, 4478, 1, 4478, 1},
+	{0xF90D, 4479, 1, 4479, 1},
+	{0xF90E, 4480, 1, 4480, 1},
+	{0xF90F, 4481, 1, 4481, 1},
+	{0xF910, 4482, 1, 4482, 1},
+	{0xF911, 4483, 1, 4483, 1},
+	{0xF912, 4484, 1, 4484, 1},
+	{0xF913, 4485, 1, 4485, 1},
+	{0xF914, 4486, 1, 4486, 1},
+	{0xF915, 4487, 1, 4487, 1},
+	{0xF916, 4488, 1, 4488, 1},
+	{0xF917, 4489, 1, 4489, 1},
+	{0xF918, 4490, 1, 4490, 1},
+	{0xF919, 4491, 1, 4491, 1},
+	{0xF91A, 4492, 1, 4492, 1},
+	{0xF91B, 4493, 1, 4493, 1},
+	{0xF91C, 4494, 1, 4494, 1},
+	{0xF91D, 4495, 1, 4495, 1},
+	{0xF91E, 4496, 1, 4496, 1},
+	{0xF91F, 4497, 1, 4497, 1},
+	{0xF920, 4498, 1, 4498, 1},
+	{0xF921, 4499, 1, 4499, 1},
+	{0xF922, 4500, 1, 4500, 1},
+	{0xF923, 4501, 1, 4501, 1},
+	{0xF924, 4502, 1, 4502, 1},
+	{0xF925, 4503, 1, 4503, 1},
+	{0xF926, 4504, 1, 4504, 1},
+	{0xF927, 4505, 1, 4505, 1},
+	{0xF928, 4506, 1, 4506, 1},
+	{0xF929, 4507, 1, 4507, 1},
+	{0xF92A, 4508, 1, 4508, 1},
+	{0xF92B, 4509, 1, 4509, 1},
+	{0xF92C, 4510, 1, 4510, 1},
+	{0xF92D, 4511, 1, 4511, 1},
+	{0xF92E, 4512, 1, 4512, 1},
+	{0xF92F, 4513, 1, 4513, 1},
+	{0xF930, 4514, 1, 4514, 1},
+	{0xF931, 4515, 1, 4515, 1},
+	{0xF932, 4516, 1, 4516, 1},
+	{0xF933, 4517, 1, 4517, 1},
+	{0xF934, 2925, 1, 2925, 1},
+	{0xF935, 4518, 1, 4518, 1},
+	{0xF936, 4519, 1, 4519, 1},
+	{0xF937, 4520, 1, 4520, 1},
+	{0xF938, 4521, 1, 4521, 1},
+	{0xF939, 4522, 1, 4522, 1},
+	{0xF93A, 4523, 1, 4523, 1},
+	{0xF93B, 4524, 1, 4524, 1},
+	{0xF93C, 4525, 1, 4525, 1},
+	{0xF93D, 4526, 1, 4526, 1},
+	{0xF93E, 4527, 1, 4527, 1},
+	{0xF93F, 4528, 1, 4528, 1},
+	{0xF940, 2998, 1, 2998, 1},
+	{0xF941, 4529, 1, 4529, 1},
+	{0xF942, 4530, 1, 4530, 1},
+	{0xF943, 4531, 1, 4531, 1},
+	{0xF944, 4532, 1, 4532, 1},
+	{0xF945, 4533, 1, 4533, 1},
+	{0xF946, 4534, 1, 4534, 1},
+	{0xF947, 4535, 1, 4535, 1},
+	{0xF948, 4536, 1, 4536, 1},
+	{0xF949, 4537, 1, 4537, 1},
+	{0xF94A, 4538, 1, 4538, 1},
+	{0xF94B, 4539, 1, 4539, 1},
+	{0xF94C, 4540, 1, 4540, 1},
+	{0xF94D, 4541, 1, 4541, 1},
+	{0xF94E, 4542, 1, 4542, 1},
+	{0xF94F, 4543, 1, 4543, 1},
+	{0xF950, 4544, 1, 4544, 1},
+	{0xF951, 4545, 1, 4545, 1},
+	{0xF952, 4546, 1, 4546, 1},
+	{0xF953, 4547, 1, 4547, 1},
+	{0xF954, 4548, 1, 4548, 1},
+	{0xF955, 4549, 1, 4549, 1},
+	{0xF956, 4550, 1, 4550, 1},
+	{0xF957, 4551, 1, 4551, 1},
+	{0xF958, 4552, 1, 4552, 1},
+	{0xF959, 4553, 1, 4553, 1},
+	{0xF95A, 4554, 1, 4554, 1},
+	{0xF95B, 4555, 1, 4555, 1},
+	{0xF95C, 4486, 1, 4486, 1},
+	{0xF95D, 4556, 1, 4556, 1},
+	{0xF95E, 4557, 1, 4557, 1},
+	{0xF95F, 4558, 1, 4558, 1},
+	{0xF960, 4559, 1, 4559, 1},
+	{0xF961, 4560, 1, 4560, 1},
+	{0xF962, 4561, 1, 4561, 1},
+	{0xF963, 4562, 1, 4562, 1},
+	{0xF964, 4563, 1, 4563, 1},
+	{0xF965, 4564, 1, 4564, 1},
+	{0xF966, 4565, 1, 4565, 1},
+	{0xF967, 4566, 1, 4566, 1},
+	{0xF968, 4567, 1, 4567, 1},
+	{0xF969, 4568, 1, 4568, 1},
+	{0xF96A, 4569, 1, 4569, 1},
+	{0xF96B, 4570, 1, 4570, 1},
+	{0xF96C, 4571, 1, 4571, 1},
+	{0xF96D, 4572, 1, 4572, 1},
+	{0xF96E, 4573, 1, 4573, 1},
+	{0xF96F, 4574, 1, 4574, 1},
+	{0xF970, 4575, 1, 4575, 1},
+	{0xF971, 2961, 1, 2961, 1},
+	{0xF972, 4576, 1, 4576, 1},
+	{0xF973, 4577, 1, 4577, 1},
+	{0xF974, 4578, 1, 4578, 1},
+	{0xF975, 4579, 1, 4579, 1},
+	{0xF976, 4580, 1, 4580, 1},
+	{0xF977, 4581, 1, 4581, 1},
+	{0xF978, 4582, 1, 4582, 1},
+	{0xF979, 4583, 1, 4583, 1},
+	{0xF97A, 4584, 1, 4584, 1},
+	{0xF97B, 4585, 1, 4585, 1},
+	{0xF97C, 4586, 1, 4586, 1},
+	{0xF97D, 4587, 1, 4587, 1},
+	{0xF97E, 4588, 1, 4588, 1},
+	{0xF97F, 4589, 1, 4589, 1},
+	{0xF980, 4590, 1, 4590, 1},
+	{0xF981, 2838, 1, 2838, 1},
+	{0xF982, 4591, 1, 4591, 1},
+	{0xF983, 4592, 1, 4592, 1},
+	{0xF984, 4593, 1, 4593, 1},
+	{0xF985, 4594, 1, 4594, 1},
+	{0xF986, 4595, 1, 4595, 1},
+	{0xF987, 4596, 1, 4596, 1},
+	{0xF988, 4597, 1, 4597, 1},
+	{0xF989, 4598, 1, 4598, 1},
+	{0xF98A, 2819, 1, 2819, 1},
+	{0xF98B, 4599, 1, 4599, 1},
+	{0xF98C, 4600, 1, 4600, 1},
+	{0xF98D, 4601, 1, 4601, 1},
+	{0xF98E, 4602, 1, 4602, 1},
+	{0xF98F, 4603, 1, 4603, 1},
+	{0xF990, 4604, 1, 4604, 1},
+	{0xF991, 4605, 1, 4605, 1},
+	{0xF992, 4606, 1, 4606, 1},
+	{0xF993, 4607, 1, 4607, 1},
+	{0xF994, 4608, 1, 4608, 1},
+	{0xF995, 4609, 1, 4609, 1},
+	{0xF996, 4610, 1, 4610, 1},
+	{0xF997, 4611, 1, 4611, 1},
+	{0xF998, 4612, 1, 4612, 1},
+	{0xF999, 4613, 1, 4613, 1},
+	{0xF99A, 4614, 1, 4614, 1},
+	{0xF99B, 4615, 1, 4615, 1},
+	{0xF99C, 4616, 1, 4616, 1},
+	{0xF99D, 4617, 1, 4617, 1},
+	{0xF99E, 4618, 1, 4618, 1},
+	{0xF99F, 4619, 1, 4619, 1},
+	{0xF9A0, 4620, 1, 4620, 1},
+	{0xF9A1, 4574, 1, 4574, 1},
+	{0xF9A2, 4621, 1, 4621, 1},
+	{0xF9A3, 4622, 1, 4622, 1},
+	{0xF9A4, 4623, 1, 4623, 1},
+	{0xF9A5, 4624, 1, 4624, 1},
+	{0xF9A6, 4625, 1, 4625, 1},
+	{0xF9A7, 4626, 1, 4626, 1},
+	{0xF9A8, 4627, 1, 4627, 1},
+	{0xF9A9, 4628, 1, 4628, 1},
+	{0xF9AA, 4558, 1, 4558, 1},
+	{0xF9AB, 4629, 1, 4629, 1},
+	{0xF9AC, 4630, 1, 4630, 1},
+	{0xF9AD, 4631, 1, 4631, 1},
+	{0xF9AE, 4632, 1, 4632, 1},
+	{0xF9AF, 4633, 1, 4633, 1},
+	{0xF9B0, 4634, 1, 4634, 1},
+	{0xF9B1, 4635, 1, 4635, 1},
+	{0xF9B2, 4636, 1, 4636, 1},
+	{0xF9B3, 4637, 1, 4637, 1},
+	{0xF9B4, 4638, 1, 4638, 1},
+	{0xF9B5, 4639, 1, 4639, 1},
+	{0xF9B6, 4640, 1, 4640, 1},
+	{0xF9B7, 4641, 1, 4641, 1},
+	{0xF9B8, 4642, 1, 4642, 1},
+	{0xF9B9, 4643, 1, 4643, 1},
+	{0xF9BA, 4644, 1, 4644, 1},
+	{0xF9BB, 4645, 1, 4645, 1},
+	{0xF9BC, 4646, 1, 4646, 1},
+	{0xF9BD, 4647, 1, 4647, 1},
+	{0xF9BE, 4648, 1, 4648, 1},
+	{0xF9BF, 4486, 1, 4486, 1},
+	{0xF9C0, 4649, 1, 4649, 1},
+	{0xF9C1, 4650, 1, 4650, 1},
+	{0xF9C2, 4651, 1, 4651, 1},
+	{0xF9C3, 4652, 1, 4652, 1},
+	{0xF9C4, 3012, 1, 3012, 1},
+	{0xF9C5, 4653, 1, 4653, 1},
+	{0xF9C6, 4654, 1, 4654, 1},
+	{0xF9C7, 4655, 1, 4655, 1},
+	{0xF9C8, 4656, 1, 4656, 1},
+	{0xF9C9, 4657, 1, 4657, 1},
+	{0xF9CA, 4658, 1, 4658, 1},
+	{0xF9CB, 4659, 1, 4659, 1},
+	{0xF9CC, 4660, 1, 4660, 1},
+	{0xF9CD, 4661, 1, 4661, 1},
+	{0xF9CE, 4662, 1, 4662, 1},
+	{0xF9CF, 4663, 1, 4663, 1},
+	{0xF9D0, 4664, 1, 4664, 1},
+	{0xF9D1, 3545, 1, 3545, 1},
+	{0xF9D2, 4665, 1, 4665, 1},
+	{0xF9D3, 4666, 1, 4666, 1},
+	{0xF9D4, 4667, 1, 4667, 1},
+	{0xF9D5, 4668, 1, 4668, 1},
+	{0xF9D6, 4669, 1, 4669, 1},
+	{0xF9D7, 4670, 1, 4670, 1},
+	{0xF9D8, 4671, 1, 4671, 1},
+	{0xF9D9, 4672, 1, 4672, 1},
+	{0xF9DA, 4673, 1, 4673, 1},
+	{0xF9DB, 4560, 1, 4560, 1},
+	{0xF9DC, 4674, 1, 4674, 1},
+	{0xF9DD, 4675, 1, 4675, 1},
+	{0xF9DE, 4676, 1, 4676, 1},
+	{0xF9DF, 4677, 1, 4677, 1},
+	{0xF9E0, 4678, 1, 4678, 1},
+	{0xF9E1, 4679, 1, 4679, 1},
+	{0xF9E2, 4680, 1, 4680, 1},
+	{0xF9E3, 4681, 1, 4681, 1},
+	{0xF9E4, 4682, 1, 4682, 1},
+	{0xF9E5, 4683, 1, 4683, 1},
+	{0xF9E6, 4684, 1, 4684, 1},
+	{0xF9E7, 4685, 1, 4685, 1},
+	{0xF9E8, 4686, 1, 4686, 1},
+	{0xF9E9, 2966, 1, 2966, 1},
+	{0xF9EA, 4687, 1, 4687, 1},
+	{0xF9EB, 4688, 1, 4688, 1},
+	{0xF9EC, 4689, 1, 4689, 1},
+	{0xF9ED, 4690, 1, 4690, 1},
+	{0xF9EE, 4691, 1, 4691, 1},
+	{0xF9EF, 4692, 1, 4692, 1},
+	{0xF9F0, 4693, 1, 4693, 1},
+	{0xF9F1, 4694, 1, 4694, 1},
+	{0xF9F2, 4695, 1, 4695, 1},
+	{0xF9F3, 4696, 1, 4696, 1},
+	{0xF9F4, 4697, 1, 4697, 1},
+	{0xF9F5, 4698, 1, 4698, 1},
+	{0xF9F6, 4699, 1, 4699, 1},
+	{0xF9F7, 2917, 1, 2917, 1},
+	{0xF9F8, 4700, 1, 4700, 1},
+	{0xF9F9, 4701, 1, 4701, 1},
+	{0xF9FA, 4702, 1, 4702, 1},
+	{0xF9FB, 4703, 1, 4703, 1},
+	{0xF9FC, 4704, 1, 4704, 1},
+	{0xF9FD, 4705, 1, 4705, 1},
+	{0xF9FE, 4706, 1, 4706, 1},
+	{0xF9FF, 4707, 1, 4707, 1},
+	{0xFA00, 4708, 1, 4708, 1},
+	{0xFA01, 4709, 1, 4709, 1},
+	{0xFA02, 4710, 1, 4710, 1},
+	{0xFA03, 4711, 1, 4711, 1},
+	{0xFA04, 4712, 1, 4712, 1},
+	{0xFA05, 4713, 1, 4713, 1},
+	{0xFA06, 4714, 1, 4714, 1},
+	{0xFA07, 4715, 1, 4715, 1},
+	{0xFA08, 2944, 1, 2944, 1},
+	{0xFA09, 4716, 1, 4716, 1},
+	{0xFA0A, 2947, 1, 2947, 1},
+	{0xFA0B, 4717, 1, 4717, 1},
+	{0xFA0C, 4718, 1, 4718, 1},
+	{0xFA0D, 4719, 1, 4719, 1},
+	{0xFA10, 4720, 1, 4720, 1},
+	{0xFA12, 4721, 1, 4721, 1},
+	{0xFA15, 4722, 1, 4722, 1},
+	{0xFA16, 4723, 1, 4723, 1},
+	{0xFA17, 4724, 1, 4724, 1},
+	{0xFA18, 4725, 1, 4725, 1},
+	{0xFA19, 4726, 1, 4726, 1},
+	{0xFA1A, 4727, 1, 4727, 1},
+	{0xFA1B, 4728, 1, 4728, 1},
+	{0xFA1C, 4729, 1, 4729, 1},
+	{0xFA1D, 4730, 1, 4730, 1},
+	{0xFA1E, 2924, 1, 2924, 1},
+	{0xFA20, 4731, 1, 4731, 1},
+	{0xFA22, 4732, 1, 4732, 1},
+	{0xFA25, 4733, 1, 4733, 1},
+	{0xFA26, 4734, 1, 4734, 1},
+	{0xFA2A, 4735, 1, 4735, 1},
+	{0xFA2B, 4736, 1, 4736, 1},
+	{0xFA2C, 4737, 1, 4737, 1},
+	{0xFA2D, 4738, 1, 4738, 1},
+	{0xFA2E, 4739, 1, 4739, 1},
+	{0xFA2F, 4740, 1, 4740, 1},
+	{0xFA30, 4741, 1, 4741, 1},
+	{0xFA31, 4742, 1, 4742, 1},
+	{0xFA32, 4743, 1, 4743, 1},
+	{0xFA33, 4744, 1, 4744, 1},
+	{0xFA34, 4745, 1, 4745, 1},
+	{0xFA35, 4746, 1, 4746, 1},
+	{0xFA36, 4747, 1, 4747, 1},
+	{0xFA37, 4748, 1, 4748, 1},
+	{0xFA38, 4749, 1, 4749, 1},
+	{0xFA39, 4750, 1, 4750, 1},
+	{0xFA3A, 4751, 1, 4751, 1},
+	{0xFA3B, 4752, 1, 4752, 1},
+	{0xFA3C, 2845, 1, 2845, 1},
+	{0xFA3D, 4753, 1, 4753, 1},
+	{0xFA3E, 4754, 1, 4754, 1},
+	{0xFA3F, 4755, 1, 4755, 1},
+	{0xFA40, 4756, 1, 4756, 1},
+	{0xFA41, 4757, 1, 4757, 1},
+	{0xFA42, 4758, 1, 4758, 1},
+	{0xFA43, 4759, 1, 4759, 1},
+	{0xFA44, 4760, 1, 4760, 1},
+	{0xFA45, 4761, 1, 4761, 1},
+	{0xFA46, 4762, 1, 4762, 1},
+	{0xFA47, 4763, 1, 4763, 1},
+	{0xFA48, 4764, 1, 4764, 1},
+	{0xFA49, 4765, 1, 4765, 1},
+	{0xFA4A, 4766, 1, 4766, 1},
+	{0xFA4B, 4767, 1, 4767, 1},
+	{0xFA4C, 3550, 1, 3550, 1},
+	{0xFA4D, 4768, 1, 4768, 1},
+	{0xFA4E, 4769, 1, 4769, 1},
+	{0xFA4F, 4770, 1, 4770, 1},
+	{0xFA50, 4771, 1, 4771, 1},
+	{0xFA51, 3554, 1, 3554, 1},
+	{0xFA52, 4772, 1, 4772, 1},
+	{0xFA53, 4773, 1, 4773, 1},
+	{0xFA54, 4774, 1, 4774, 1},
+	{0xFA55, 4775, 1, 4775, 1},
+	{0xFA56, 4776, 1, 4776, 1},
+	{0xFA57, 4610, 1, 4610, 1},
+	{0xFA58, 4777, 1, 4777, 1},
+	{0xFA59, 4778, 1, 4778, 1},
+	{0xFA5A, 4779, 1, 4779, 1},
+	{0xFA5B, 4780, 1, 4780, 1},
+	{0xFA5C, 4781, 1, 4781, 1},
+	{0xFA5D, 4782, 1, 4782, 1},
+	{0xFA5E, 4782, 1, 4782, 1},
+	{0xFA5F, 4783, 1, 4783, 1},
+	{0xFA60, 4784, 1, 4784, 1},
+	{0xFA61, 4785, 1, 4785, 1},
+	{0xFA62, 4786, 1, 4786, 1},
+	{0xFA63, 4787, 1, 4787, 1},
+	{0xFA64, 4788, 1, 4788, 1},
+	{0xFA65, 4789, 1, 4789, 1},
+	{0xFA66, 4790, 1, 4790, 1},
+	{0xFA67, 4733, 1, 4733, 1},
+	{0xFA68, 4791, 1, 4791, 1},
+	{0xFA69, 4792, 1, 4792, 1},
+	{0xFA6A, 4793, 1, 4793, 1},
+	{0xFA6B, 4794, 1, 4794, 1},
+	{0xFA6C, 4795, 1, 4795, 1},
+	{0xFA6D, 4796, 1, 4796, 1},
+	{0xFA70, 4797, 1, 4797, 1},
+	{0xFA71, 4798, 1, 4798, 1},
+	{0xFA72, 4799, 1, 4799, 1},
+	{0xFA73, 4800, 1, 4800, 1},
+	{0xFA74, 4801, 1, 4801, 1},
+	{0xFA75, 4802, 1, 4802, 1},
+	{0xFA76, 4803, 1, 4803, 1},
+	{0xFA77, 4804, 1, 4804, 1},
+	{0xFA78, 4747, 1, 4747, 1},
+	{0xFA79, 4805, 1, 4805, 1},
+	{0xFA7A, 4806, 1, 4806, 1},
+	{0xFA7B, 4807, 1, 4807, 1},
+	{0xFA7C, 4720, 1, 4720, 1},
+	{0xFA7D, 4808, 1, 4808, 1},
+	{0xFA7E, 4809, 1, 4809, 1},
+	{0xFA7F, 4810, 1, 4810, 1},
+	{0xFA80, 4811, 1, 4811, 1},
+	{0xFA81, 4812, 1, 4812, 1},
+	{0xFA82, 4813, 1, 4813, 1},
+	{0xFA83, 4814, 1, 4814, 1},
+	{0xFA84, 4815, 1, 4815, 1},
+	{0xFA85, 4816, 1, 4816, 1},
+	{0xFA86, 4817, 1, 4817, 1},
+	{0xFA87, 4818, 1, 4818, 1},
+	{0xFA88, 4819, 1, 4819, 1},
+	{0xFA89, 4755, 1, 4755, 1},
+	{0xFA8A, 4820, 1, 4820, 1},
+	{0xFA8B, 4756, 1, 4756, 1},
+	{0xFA8C, 4821, 1, 4821, 1},
+	{0xFA8D, 4822, 1, 4822, 1},
+	{0xFA8E, 4823, 1, 4823, 1},
+	{0xFA8F, 4824, 1, 4824, 1},
+	{0xFA90, 4825, 1, 4825, 1},
+	{0xFA91, 4721, 1, 4721, 1},
+	{0xFA92, 4507, 1, 4507, 1},
+	{0xFA93, 4826, 1, 4826, 1},
+	{0xFA94, 4827, 1, 4827, 1},
+	{0xFA95, 2878, 1, 2878, 1},
+	{0xFA96, 4575, 1, 4575, 1},
+	{0xFA97, 4658, 1, 4658, 1},
+	{0xFA98, 4828, 1, 4828, 1},
+	{0xFA99, 4829, 1, 4829, 1},
+	{0xFA9A, 4763, 1, 4763, 1},
+	{0xFA9B, 4830, 1, 4830, 1},
+	{0xFA9C, 4764, 1, 4764, 1},
+	{0xFA9D, 4831, 1, 4831, 1},
+	{0xFA9E, 4832, 1, 4832, 1},
+	{0xFA9F, 4833, 1, 4833, 1},
+	{0xFAA0, 4723, 1, 4723, 1},
+	{0xFAA1, 4834, 1, 4834, 1},
+	{0xFAA2, 4835, 1, 4835, 1},
+	{0xFAA3, 4836, 1, 4836, 1},
+	{0xFAA4, 4837, 1, 4837, 1},
+	{0xFAA5, 4838, 1, 4838, 1},
+	{0xFAA6, 4724, 1, 4724, 1},
+	{0xFAA7, 4839, 1, 4839, 1},
+	{0xFAA8, 4840, 1, 4840, 1},
+	{0xFAA9, 4841, 1, 4841, 1},
+	{0xFAAA, 4842, 1, 4842, 1},
+	{0xFAAB, 4843, 1, 4843, 1},
+	{0xFAAC, 4844, 1, 4844, 1},
+	{0xFAAD, 4776, 1, 4776, 1},
+	{0xFAAE, 4845, 1, 4845, 1},
+	{0xFAAF, 4846, 1, 4846, 1},
+	{0xFAB0, 4610, 1, 4610, 1},
+	{0xFAB1, 4847, 1, 4847, 1},
+	{0xFAB2, 4780, 1, 4780, 1},
+	{0xFAB3, 4848, 1, 4848, 1},
+	{0xFAB4, 4849, 1, 4849, 1},
+	{0xFAB5, 4850, 1, 4850, 1},
+	{0xFAB6, 4851, 1, 4851, 1},
+	{0xFAB7, 4852, 1, 4852, 1},
+	{0xFAB8, 4785, 1, 4785, 1},
+	{0xFAB9, 4853, 1, 4853, 1},
+	{0xFABA, 4732, 1, 4732, 1},
+	{0xFABB, 4854, 1, 4854, 1},
+	{0xFABC, 4786, 1, 4786, 1},
+	{0xFABD, 4556, 1, 4556, 1},
+	{0xFABE, 4855, 1, 4855, 1},
+	{0xFABF, 4787, 1, 4787, 1},
+	{0xFAC0, 4856, 1, 4856, 1},
+	{0xFAC1, 4789, 1, 4789, 1},
+	{0xFAC2, 4857, 1, 4857, 1},
+	{0xFAC3, 4858, 1, 4858, 1},
+	{0xFAC4, 4859, 1, 4859, 1},
+	{0xFAC5, 4860, 1, 4860, 1},
+	{0xFAC6, 4861, 1, 4861, 1},
+	{0xFAC7, 4791, 1, 4791, 1},
+	{0xFAC8, 4729, 1, 4729, 1},
+	{0xFAC9, 4862, 1, 4862, 1},
+	{0xFACA, 4792, 1, 4792, 1},
+	{0xFACB, 4863, 1, 4863, 1},
+	{0xFACC, 4793, 1, 4793, 1},
+	{0xFACD, 4864, 1, 4864, 1},
+	{0xFACE, 3013, 1, 3013, 1},
+	{0xFACF, 4865, 1, 4865, 1},
+	{0xFAD0, 4866, 1, 4866, 1},
+	{0xFAD1, 4867, 1, 4867, 1},
+	{0xFAD2, 4868, 1, 4868, 1},
+	{0xFAD3, 4869, 1, 4869, 1},
+	{0xFAD4, 4870, 1, 4870, 1},
+	{0xFAD5, 4871, 1, 4871, 1},
+	{0xFAD6, 4872, 1, 4872, 1},
+	{0xFAD7, 4873, 1, 4873, 1},
+	{0xFAD8, 4874, 1, 4874, 1},
+	{0xFAD9, 4875, 1, 4875, 1},
+	{0xFB00, 0, 0, 4876, 2},
+	{0xFB01, 0, 0, 4878, 2},
+	{0xFB02, 0, 0, 4880, 2},
+	{0xFB03, 0, 0, 4882, 3},
+	{0xFB04, 0, 0, 4885, 3},
+	{0xFB05, 0, 0, 4888, 2},
+	{0xFB06, 0, 0, 4888, 2},
+	{0xFB13, 0, 0, 4890, 2},
+	{0xFB14, 0, 0, 4892, 2},
+	{0xFB15, 0, 0, 4894, 2},
+	{0xFB16, 0, 0, 4896, 2},
+	{0xFB17, 0, 0, 4898, 2},
+	{0xFB1D, 4900, 2, 4900, 2},
+	{0xFB1F, 4902, 2, 4902, 2},
+	{0xFB20, 0, 0, 4904, 1},
+	{0xFB21, 0, 0, 2350, 1},
+	{0xFB22, 0, 0, 2353, 1},
+	{0xFB23, 0, 0, 4905, 1},
+	{0xFB24, 0, 0, 4906, 1},
+	{0xFB25, 0, 0, 4907, 1},
+	{0xFB26, 0, 0, 4908, 1},
+	{0xFB27, 0, 0, 4909, 1},
+	{0xFB28, 0, 0, 4910, 1},
+	{0xFB29, 0, 0, 2310, 1},
+	{0xFB2A, 4911, 2, 4911, 2},
+	{0xFB2B, 4913, 2, 4913, 2},
+	{0xFB2C, 4915, 3, 4915, 3},
+	{0xFB2D, 4918, 3, 4918, 3},
+	{0xFB2E, 4921, 2, 4921, 2},
+	{0xFB2F, 4923, 2, 4923, 2},
+	{0xFB30, 4925, 2, 4925, 2},
+	{0xFB31, 4927, 2, 4927, 2},
+	{0xFB32, 4929, 2, 4929, 2},
+	{0xFB33, 4931, 2, 4931, 2},
+	{0xFB34, 4933, 2, 4933, 2},
+	{0xFB35, 4935, 2, 4935, 2},
+	{0xFB36, 4937, 2, 4937, 2},
+	{0xFB38, 4939, 2, 4939, 2},
+	{0xFB39, 4941, 2, 4941, 2},
+	{0xFB3A, 4943, 2, 4943, 2},
+	{0xFB3B, 4945, 2, 4945, 2},
+	{0xFB3C, 4947, 2, 4947, 2},
+	{0xFB3E, 4949, 2, 4949, 2},
+	{0xFB40, 4951, 2, 4951, 2},
+	{0xFB41, 4953, 2, 4953, 2},
+	{0xFB43, 4955, 2, 4955, 2},
+	{0xFB44, 4957, 2, 4957, 2},
+	{0xFB46, 4959, 2, 4959, 2},
+	{0xFB47, 4961, 2, 4961, 2},
+	{0xFB48, 4963, 2, 4963, 2},
+	{0xFB49, 4965, 2, 4965, 2},
+	{0xFB4A, 4967, 2, 4967, 2},
+	{0xFB4B, 4969, 2, 4969, 2},
+	{0xFB4C, 4971, 2, 4971, 2},
+	{0xFB4D, 4973, 2, 4973, 2},
+	{0xFB4E, 4975, 2, 4975, 2},
+	{0xFB4F, 0, 0, 4977, 2},
+	{0xFB50, 0, 0, 4979, 1},
+	{0xFB51, 0, 0, 4979, 1},
+	{0xFB52, 0, 0, 4980, 1},
+	{0xFB53, 0, 0, 4980, 1},
+	{0xFB54, 0, 0, 4980, 1},
+	{0xFB55, 0, 0, 4980, 1},
+	{0xFB56, 0, 0, 4981, 1},
+	{0xFB57, 0, 0, 4981, 1},
+	{0xFB58, 0, 0, 4981, 1},
+	{0xFB59, 0, 0, 4981, 1},
+	{0xFB5A, 0, 0, 4982, 1},
+	{0xFB5B, 0, 0, 4982, 1},
+	{0xFB5C, 0, 0, 4982, 1},
+	{0xFB5D, 0, 0, 4982, 1},
+	{0xFB5E, 0, 0, 4983, 1},
+	{0xFB5F, 0, 0, 4983, 1},
+	{0xFB60, 0, 0, 4983, 1},
+	{0xFB61, 0, 0, 4983, 1},
+	{0xFB62, 0, 0, 4984, 1},
+	{0xFB63, 0, 0, 4984, 1},
+	{0xFB64, 0, 0, 4984, 1},
+	{0xFB65, 0, 0, 4984, 1},
+	{0xFB66, 0, 0, 4985, 1},
+	{0xFB67, 0, 0, 4985, 1},
+	{0xFB68, 0, 0, 4985, 1},
+	{0xFB69, 0, 0, 4985, 1},
+	{0xFB6A, 0, 0, 4986, 1},
+	{0xFB6B, 0, 0, 4986, 1},
+	{0xFB6C, 0, 0, 4986, 1},
+	{0xFB6D, 0, 0, 4986, 1},
+	{0xFB6E, 0, 0, 4987, 1},
+	{0xFB6F, 0, 0, 4987, 1},
+	{0xFB70, 0, 0, 4987, 1},
+	{0xFB71, 0, 0, 4987, 1},
+	{0xFB72, 0, 0, 4988, 1},
+	{0xFB73, 0, 0, 4988, 1},
+	{0xFB74, 0, 0, 4988, 1},
+	{0xFB75, 0, 0, 4988, 1},
+	{0xFB76, 0, 0, 4989, 1},
+	{0xFB77, 0, 0, 4989, 1},
+	{0xFB78, 0, 0, 4989, 1},
+	{0xFB79, 0, 0, 4989, 1},
+	{0xFB7A, 0, 0, 4990, 1},
+	{0xFB7B, 0, 0, 4990, 1},
+	{0xFB7C, 0, 0, 4990, 1},
+	{0xFB7D, 0, 0, 4990, 1},
+	{0xFB7E, 0, 0, 4991, 1},
+	{0xFB7F, 0, 0, 4991, 1},
+	{0xFB80, 0, 0, 4991, 1},
+	{0xFB81, 0, 0, 4991, 1},
+	{0xFB82, 0, 0, 4992, 1},
+	{0xFB83, 0, 0, 4992, 1},
+	{0xFB84, 0, 0, 4993, 1},
+	{0xFB85, 0, 0, 4993, 1},
+	{0xFB86, 0, 0, 4994, 1},
+	{0xFB87, 0, 0, 4994, 1},
+	{0xFB88, 0, 0, 4995, 1},
+	{0xFB89, 0, 0, 4995, 1},
+	{0xFB8A, 0, 0, 4996, 1},
+	{0xFB8B, 0, 0, 4996, 1},
+	{0xFB8C, 0, 0, 4997, 1},
+	{0xFB8D, 0, 0, 4997, 1},
+	{0xFB8E, 0, 0, 4998, 1},
+	{0xFB8F, 0, 0, 4998, 1},
+	{0xFB90, 0, 0, 4998, 1},
+	{0xFB91, 0, 0, 4998, 1},
+	{0xFB92, 0, 0, 4999, 1},
+	{0xFB93, 0, 0, 4999, 1},
+	{0xFB94, 0, 0, 4999, 1},
+	{0xFB95, 0, 0, 4999, 1},
+	{0xFB96, 0, 0, 5000, 1},
+	{0xFB97, 0, 0, 5000, 1},
+	{0xFB98, 0, 0, 5000, 1},
+	{0xFB99, 0, 0, 5000, 1},
+	{0xFB9A, 0, 0, 5001, 1},
+	{0xFB9B, 0, 0, 5001, 1},
+	{0xFB9C, 0, 0, 5001, 1},
+	{0xFB9D, 0, 0, 5001, 1},
+	{0xFB9E, 0, 0, 5002, 1},
+	{0xFB9F, 0, 0, 5002, 1},
+	{0xFBA0, 0, 0, 5003, 1},
+	{0xFBA1, 0, 0, 5003, 1},
+	{0xFBA2, 0, 0, 5003, 1},
+	{0xFBA3, 0, 0, 5003, 1},
+	{0xFBA4, 0, 0, 809, 2},
+	{0xFBA5, 0, 0, 809, 2},
+	{0xFBA6, 0, 0, 5004, 1},
+	{0xFBA7, 0, 0, 5004, 1},
+	{0xFBA8, 0, 0, 5004, 1},
+	{0xFBA9, 0, 0, 5004, 1},
+	{0xFBAA, 0, 0, 5005, 1},
+	{0xFBAB, 0, 0, 5005, 1},
+	{0xFBAC, 0, 0, 5005, 1},
+	{0xFBAD, 0, 0, 5005, 1},
+	{0xFBAE, 0, 0, 5006, 1},
+	{0xFBAF, 0, 0, 5006, 1},
+	{0xFBB0, 0, 0, 813, 2},
+	{0xFBB1, 0, 0, 813, 2},
+	{0xFBD3, 0, 0, 5007, 1},
+	{0xFBD4, 0, 0, 5007, 1},
+	{0xFBD5, 0, 0, 5007, 1},
+	{0xFBD6, 0, 0, 5007, 1},
+	{0xFBD7, 0, 0, 5008, 1},
+	{0xFBD8, 0, 0, 5008, 1},
+	{0xFBD9, 0, 0, 5009, 1},
+	{0xFBDA, 0, 0, 5009, 1},
+	{0xFBDB, 0, 0, 5010, 1},
+	{0xFBDC, 0, 0, 5010, 1},
+	{0xFBDD, 0, 0, 805, 2},
+	{0xFBDE, 0, 0, 5011, 1},
+	{0xFBDF, 0, 0, 5011, 1},
+	{0xFBE0, 0, 0, 5012, 1},
+	{0xFBE1, 0, 0, 5012, 1},
+	{0xFBE2, 0, 0, 5013, 1},
+	{0xFBE3, 0, 0, 5013, 1},
+	{0xFBE4, 0, 0, 5014, 1},
+	{0xFBE5, 0, 0, 5014, 1},
+	{0xFBE6, 0, 0, 5014, 1},
+	{0xFBE7, 0, 0, 5014, 1},
+	{0xFBE8, 0, 0, 5015, 1},
+	{0xFBE9, 0, 0, 5015, 1},
+	{0xFBEA, 0, 0, 5016, 3},
+	{0xFBEB, 0, 0, 5016, 3},
+	{0xFBEC, 0, 0, 5019, 3},
+	{0xFBED, 0, 0, 5019, 3},
+	{0xFBEE, 0, 0, 5022, 3},
+	{0xFBEF, 0, 0, 5022, 3},
+	{0xFBF0, 0, 0, 5025, 3},
+	{0xFBF1, 0, 0, 5025, 3},
+	{0xFBF2, 0, 0, 5028, 3},
+	{0xFBF3, 0, 0, 5028, 3},
+	{0xFBF4, 0, 0, 5031, 3},
+	{0xFBF5, 0, 0, 5031, 3},
+	{0xFBF6, 0, 0, 5034, 3},
+	{0xFBF7, 0, 0, 5034, 3},
+	{0xFBF8, 0, 0, 5034, 3},
+	{0xFBF9, 0, 0, 5037, 3},
+	{0xFBFA, 0, 0, 5037, 3},
+	{0xFBFB, 0, 0, 5037, 3},
+	{0xFBFC, 0, 0, 5040, 1},
+	{0xFBFD, 0, 0, 5040, 1},
+	{0xFBFE, 0, 0, 5040, 1},
+	{0xFBFF, 0, 0, 5040, 1},
+	{0xFC00, 0, 0, 5041, 3},
+	{0xFC01, 0, 0, 5044, 3},
+	{0xFC02, 0, 0, 5047, 3},
+	{0xFC03, 0, 0, 5037, 3},
+	{0xFC04, 0, 0, 5050, 3},
+	{0xFC05, 0, 0, 5053, 2},
+	{0xFC06, 0, 0, 5055, 2},
+	{0xFC07, 0, 0, 5057, 2},
+	{0xFC08, 0, 0, 5059, 2},
+	{0xFC09, 0, 0, 5061, 2},
+	{0xFC0A, 0, 0, 5063, 2},
+	{0xFC0B, 0, 0, 5065, 2},
+	{0xFC0C, 0, 0, 5067, 2},
+	{0xFC0D, 0, 0, 5069, 2},
+	{0xFC0E, 0, 0, 5071, 2},
+	{0xFC0F, 0, 0, 5073, 2},
+	{0xFC10, 0, 0, 5075, 2},
+	{0xFC11, 0, 0, 5077, 2},
+	{0xFC12, 0, 0, 5079, 2},
+	{0xFC13, 0, 0, 5081, 2},
+	{0xFC14, 0, 0, 5083, 2},
+	{0xFC15, 0, 0, 5085, 2},
+	{0xFC16, 0, 0, 5087, 2},
+	{0xFC17, 0, 0, 5089, 2},
+	{0xFC18, 0, 0, 5091, 2},
+	{0xFC19, 0, 0, 5093, 2},
+	{0xFC1A, 0, 0, 5095, 2},
+	{0xFC1B, 0, 0, 5097, 2},
+	{0xFC1C, 0, 0, 5099, 2},
+	{0xFC1D, 0, 0, 5101, 2},
+	{0xFC1E, 0, 0, 5103, 2},
+	{0xFC1F, 0, 0, 5105, 2},
+	{0xFC20, 0, 0, 5107, 2},
+	{0xFC21, 0, 0, 5109, 2},
+	{0xFC22, 0, 0, 5111, 2},
+	{0xFC23, 0, 0, 5113, 2},
+	{0xFC24, 0, 0, 5115, 2},
+	{0xFC25, 0, 0, 5117, 2},
+	{0xFC26, 0, 0, 5119, 2},
+	{0xFC27, 0, 0, 5121, 2},
+	{0xFC28, 0, 0, 5123, 2},
+	{0xFC29, 0, 0, 5125, 2},
+	{0xFC2A, 0, 0, 5127, 2},
+	{0xFC2B, 0, 0, 5129, 2},
+	{0xFC2C, 0, 0, 5131, 2},
+	{0xFC2D, 0, 0, 5133, 2},
+	{0xFC2E, 0, 0, 5135, 2},
+	{0xFC2F, 0, 0, 5137, 2},
+	{0xFC30, 0, 0, 5139, 2},
+	{0xFC31, 0, 0, 5141, 2},
+	{0xFC32, 0, 0, 5143, 2},
+	{0xFC33, 0, 0, 5145, 2},
+	{0xFC34, 0, 0, 5147, 2},
+	{0xFC35, 0, 0, 5149, 2},
+	{0xFC36, 0, 0, 5151, 2},
+	{0xFC37, 0, 0, 5153, 2},
+	{0xFC38, 0, 0, 5155, 2},
+	{0xFC39, 0, 0, 5157, 2},
+	{0xFC3A, 0, 0, 5159, 2},
+	{0xFC3B, 0, 0, 5161, 2},
+	{0xFC3C, 0, 0, 5163, 2},
+	{0xFC3D, 0, 0, 5165, 2},
+	{0xFC3E, 0, 0, 5167, 2},
+	{0xFC3F, 0, 0, 5169, 2},
+	{0xFC40, 0, 0, 5171, 2},
+	{0xFC41, 0, 0, 5173, 2},
+	{0xFC42, 0, 0, 5175, 2},
+	{0xFC43, 0, 0, 5177, 2},
+	{0xFC44, 0, 0, 5179, 2},
+	{0xFC45, 0, 0, 5181, 2},
+	{0xFC46, 0, 0, 5183, 2},
+	{0xFC47, 0, 0, 5185, 2},
+	{0xFC48, 0, 0, 5187, 2},
+	{0xFC49, 0, 0, 5189, 2},
+	{0xFC4A, 0, 0, 5191, 2},
+	{0xFC4B, 0, 0, 5193, 2},
+	{0xFC4C, 0, 0, 5195, 2},
+	{0xFC4D, 0, 0, 5197, 2},
+	{0xFC4E, 0, 0, 5199, 2},
+	{0xFC4F, 0, 0, 5201, 2},
+	{0xFC50, 0, 0, 5203, 2},
+	{0xFC51, 0, 0, 5205, 2},
+	{0xFC52, 0, 0, 5207, 2},
+	{0xFC53, 0, 0, 5209, 2},
+	{0xFC54, 0, 0, 5211, 2},
+	{0xFC55, 0, 0, 5213, 2},
+	{0xFC56, 0, 0, 5215, 2},
+	{0xFC57, 0, 0, 5217, 2},
+	{0xFC58, 0, 0, 5219, 2},
+	{0xFC59, 0, 0, 5221, 2},
+	{0xFC5A, 0, 0, 5223, 2},
+	{0xFC5B, 0, 0, 5225, 2},
+	{0xFC5C, 0, 0, 5227, 2},
+	{0xFC5D, 0, 0, 5229, 2},
+	{0xFC5E, 0, 0, 5231, 3},
+	{0xFC5F, 0, 0, 5234, 3},
+	{0xFC60, 0, 0, 5237, 3},
+	{0xFC61, 0, 0, 5240, 3},
+	{0xFC62, 0, 0, 5243, 3},
+	{0xFC63, 0, 0, 5246, 3},
+	{0xFC64, 0, 0, 5249, 3},
+	{0xFC65, 0, 0, 5252, 3},
+	{0xFC66, 0, 0, 5047, 3},
+	{0xFC67, 0, 0, 5255, 3},
+	{0xFC68, 0, 0, 5037, 3},
+	{0xFC69, 0, 0, 5050, 3},
+	{0xFC6A, 0, 0, 5258, 2},
+	{0xFC6B, 0, 0, 5260, 2},
+	{0xFC6C, 0, 0, 5059, 2},
+	{0xFC6D, 0, 0, 5262, 2},
+	{0xFC6E, 0, 0, 5061, 2},
+	{0xFC6F, 0, 0, 5063, 2},
+	{0xFC70, 0, 0, 5264, 2},
+	{0xFC71, 0, 0, 5266, 2},
+	{0xFC72, 0, 0, 5071, 2},
+	{0xFC73, 0, 0, 5268, 2},
+	{0xFC74, 0, 0, 5073, 2},
+	{0xFC75, 0, 0, 5075, 2},
+	{0xFC76, 0, 0, 5270, 2},
+	{0xFC77, 0, 0, 5272, 2},
+	{0xFC78, 0, 0, 5079, 2},
+	{0xFC79, 0, 0, 5274, 2},
+	{0xFC7A, 0, 0, 5081, 2},
+	{0xFC7B, 0, 0, 5083, 2},
+	{0xFC7C, 0, 0, 5141, 2},
+	{0xFC7D, 0, 0, 5143, 2},
+	{0xFC7E, 0, 0, 5149, 2},
+	{0xFC7F, 0, 0, 5151, 2},
+	{0xFC80, 0, 0, 5153, 2},
+	{0xFC81, 0, 0, 5161, 2},
+	{0xFC82, 0, 0, 5163, 2},
+	{0xFC83, 0, 0, 5165, 2},
+	{0xFC84, 0, 0, 5167, 2},
+	{0xFC85, 0, 0, 5175, 2},
+	{0xFC86, 0, 0, 5177, 2},
+	{0xFC87, 0, 0, 5179, 2},
+	{0xFC88, 0, 0, 5276, 2},
+	{0xFC89, 0, 0, 5187, 2},
+	{0xFC8A, 0, 0, 5278, 2},
+	{0xFC8B, 0, 0, 5280, 2},
+	{0xFC8C, 0, 0, 5199, 2},
+	{0xFC8D, 0, 0, 5282, 2},
+	{0xFC8E, 0, 0, 5201, 2},
+	{0xFC8F, 0, 0, 5203, 2},
+	{0xFC90, 0, 0, 5229, 2},
+	{0xFC91, 0, 0, 5284, 2},
+	{0xFC92, 0, 0, 5286, 2},
+	{0xFC93, 0, 0, 5219, 2},
+	{0xFC94, 0, 0, 5288, 2},
+	{0xFC95, 0, 0, 5221, 2},
+	{0xFC96, 0, 0, 5223, 2},
+	{0xFC97, 0, 0, 5041, 3},
+	{0xFC98, 0, 0, 5044, 3},
+	{0xFC99, 0, 0, 5290, 3},
+	{0xFC9A, 0, 0, 5047, 3},
+	{0xFC9B, 0, 0, 5293, 3},
+	{0xFC9C, 0, 0, 5053, 2},
+	{0xFC9D, 0, 0, 5055, 2},
+	{0xFC9E, 0, 0, 5057, 2},
+	{0xFC9F, 0, 0, 5059, 2},
+	{0xFCA0, 0, 0, 5296, 2},
+	{0xFCA1, 0, 0, 5065, 2},
+	{0xFCA2, 0, 0, 5067, 2},
+	{0xFCA3, 0, 0, 5069, 2},
+	{0xFCA4, 0, 0, 5071, 2},
+	{0xFCA5, 0, 0, 5298, 2},
+	{0xFCA6, 0, 0, 5079, 2},
+	{0xFCA7, 0, 0, 5085, 2},
+	{0xFCA8, 0, 0, 5087, 2},
+	{0xFCA9, 0, 0, 5089, 2},
+	{0xFCAA, 0, 0, 5091, 2},
+	{0xFCAB, 0, 0, 5093, 2},
+	{0xFCAC, 0, 0, 5097, 2},
+	{0xFCAD, 0, 0, 5099, 2},
+	{0xFCAE, 0, 0, 5101, 2},
+	{0xFCAF, 0, 0, 5103, 2},
+	{0xFCB0, 0, 0, 5105, 2},
+	{0xFCB1, 0, 0, 5107, 2},
+	{0xFCB2, 0, 0, 5300, 2},
+	{0xFCB3, 0, 0, 5109, 2},
+	{0xFCB4, 0, 0, 5111, 2},
+	{0xFCB5, 0, 0, 5113, 2},
+	{0xFCB6, 0, 0, 5115, 2},
+	{0xFCB7, 0, 0, 5117, 2},
+	{0xFCB8, 0, 0, 5119, 2},
+	{0xFCB9, 0, 0, 5123, 2},
+	{0xFCBA, 0, 0, 5125, 2},
+	{0xFCBB, 0, 0, 5127, 2},
+	{0xFCBC, 0, 0, 5129, 2},
+	{0xFCBD, 0, 0, 5131, 2},
+	{0xFCBE, 0, 0, 5133, 2},
+	{0xFCBF, 0, 0, 5135, 2},
+	{0xFCC0, 0, 0, 5137, 2},
+	{0xFCC1, 0, 0, 5139, 2},
+	{0xFCC2, 0, 0, 5145, 2},
+	{0xFCC3, 0, 0, 5147, 2},
+	{0xFCC4, 0, 0, 5155, 2},
+	{0xFCC5, 0, 0, 5157, 2},
+	{0xFCC6, 0, 0, 5159, 2},
+	{0xFCC7, 0, 0, 5161, 2},
+	{0xFCC8, 0, 0, 5163, 2},
+	{0xFCC9, 0, 0, 5169, 2},
+	{0xFCCA, 0, 0, 5171, 2},
+	{0xFCCB, 0, 0, 5173, 2},
+	{0xFCCC, 0, 0, 5175, 2},
+	{0xFCCD, 0, 0, 5302, 2},
+	{0xFCCE, 0, 0, 5181, 2},
+	{0xFCCF, 0, 0, 5183, 2},
+	{0xFCD0, 0, 0, 5185, 2},
+	{0xFCD1, 0, 0, 5187, 2},
+	{0xFCD2, 0, 0, 5193, 2},
+	{0xFCD3, 0, 0, 5195, 2},
+	{0xFCD4, 0, 0, 5197, 2},
+	{0xFCD5, 0, 0, 5199, 2},
+	{0xFCD6, 0, 0, 5304, 2},
+	{0xFCD7, 0, 0, 5205, 2},
+	{0xFCD8, 0, 0, 5207, 2},
+	{0xFCD9, 0, 0, 5306, 2},
+	{0xFCDA, 0, 0, 5213, 2},
+	{0xFCDB, 0, 0, 5215, 2},
+	{0xFCDC, 0, 0, 5217, 2},
+	{0xFCDD, 0, 0, 5219, 2},
+	{0xFCDE, 0, 0, 5308, 2},
+	{0xFCDF, 0, 0, 5047, 3},
+	{0xFCE0, 0, 0, 5293, 3},
+	{0xFCE1, 0, 0, 5059, 2},
+	{0xFCE2, 0, 0, 5296, 2},
+	{0xFCE3, 0, 0, 5071, 2},
+	{0xFCE4, 0, 0, 5298, 2},
+	{0xFCE5, 0, 0, 5079, 2},
+	{0xFCE6, 0, 0, 5310, 2},
+	{0xFCE7, 0, 0, 5105, 2},
+	{0xFCE8, 0, 0, 5312, 2},
+	{0xFCE9, 0, 0, 5314, 2},
+	{0xFCEA, 0, 0, 5316, 2},
+	{0xFCEB, 0, 0, 5161, 2},
+	{0xFCEC, 0, 0, 5163, 2},
+	{0xFCED, 0, 0, 5175, 2},
+	{0xFCEE, 0, 0, 5199, 2},
+	{0xFCEF, 0, 0, 5304, 2},
+	{0xFCF0, 0, 0, 5219, 2},
+	{0xFCF1, 0, 0, 5308, 2},
+	{0xFCF2, 0, 0, 5318, 3},
+	{0xFCF3, 0, 0, 5321, 3},
+	{0xFCF4, 0, 0, 5324, 3},
+	{0xFCF5, 0, 0, 5327, 2},
+	{0xFCF6, 0, 0, 5329, 2},
+	{0xFCF7, 0, 0, 5331, 2},
+	{0xFCF8, 0, 0, 5333, 2},
+	{0xFCF9, 0, 0, 5335, 2},
+	{0xFCFA, 0, 0, 5337, 2},
+	{0xFCFB, 0, 0, 5339, 2},
+	{0xFCFC, 0, 0, 5341, 2},
+	{0xFCFD, 0, 0, 5343, 2},
+	{0xFCFE, 0, 0, 5345, 2},
+	{0xFCFF, 0, 0, 5347, 2},
+	{0xFD00, 0, 0, 5349, 2},
+	{0xFD01, 0, 0, 5351, 2},
+	{0xFD02, 0, 0, 5353, 2},
+	{0xFD03, 0, 0, 5355, 2},
+	{0xFD04, 0, 0, 5357, 2},
+	{0xFD05, 0, 0, 5359, 2},
+	{0xFD06, 0, 0, 5361, 2},
+	{0xFD07, 0, 0, 5363, 2},
+	{0xFD08, 0, 0, 5365, 2},
+	{0xFD09, 0, 0, 5367, 2},
+	{0xFD0A, 0, 0, 5369, 2},
+	{0xFD0B, 0, 0, 5371, 2},
+	{0xFD0C, 0, 0, 5314, 2},
+	{0xFD0D, 0, 0, 5373, 2},
+	{0xFD0E, 0, 0, 5375, 2},
+	{0xFD0F, 0, 0, 5377, 2},
+	{0xFD10, 0, 0, 5379, 2},
+	{0xFD11, 0, 0, 5327, 2},
+	{0xFD12, 0, 0, 5329, 2},
+	{0xFD13, 0, 0, 5331, 2},
+	{0xFD14, 0, 0, 5333, 2},
+	{0xFD15, 0, 0, 5335, 2},
+	{0xFD16, 0, 0, 5337, 2},
+	{0xFD17, 0, 0, 5339, 2},
+	{0xFD18, 0, 0, 5341, 2},
+	{0xFD19, 0, 0, 5343, 2},
+	{0xFD1A, 0, 0, 5345, 2},
+	{0xFD1B, 0, 0, 5347, 2},
+	{0xFD1C, 0, 0, 5349, 2},
+	{0xFD1D, 0, 0, 5351, 2},
+	{0xFD1E, 0, 0, 5353, 2},
+	{0xFD1F, 0, 0, 5355, 2},
+	{0xFD20, 0, 0, 5357, 2},
+	{0xFD21, 0, 0, 5359, 2},
+	{0xFD22, 0, 0, 5361, 2},
+	{0xFD23, 0, 0, 5363, 2},
+	{0xFD24, 0, 0, 5365, 2},
+	{0xFD25, 0, 0, 5367, 2},
+	{0xFD26, 0, 0, 5369, 2},
+	{0xFD27, 0, 0, 5371, 2},
+	{0xFD28, 0, 0, 5314, 2},
+	{0xFD29, 0, 0, 5373, 2},
+	{0xFD2A, 0, 0, 5375, 2},
+	{0xFD2B, 0, 0, 5377, 2},
+	{0xFD2C, 0, 0, 5379, 2},
+	{0xFD2D, 0, 0, 5367, 2},
+	{0xFD2E, 0, 0, 5369, 2},
+	{0xFD2F, 0, 0, 5371, 2},
+	{0xFD30, 0, 0, 5314, 2},
+	{0xFD31, 0, 0, 5312, 2},
+	{0xFD32, 0, 0, 5316, 2},
+	{0xFD33, 0, 0, 5121, 2},
+	{0xFD34, 0, 0, 5099, 2},
+	{0xFD35, 0, 0, 5101, 2},
+	{0xFD36, 0, 0, 5103, 2},
+	{0xFD37, 0, 0, 5367, 2},
+	{0xFD38, 0, 0, 5369, 2},
+	{0xFD39, 0, 0, 5371, 2},
+	{0xFD3A, 0, 0, 5121, 2},
+	{0xFD3B, 0, 0, 5123, 2},
+	{0xFD3C, 0, 0, 5381, 2},
+	{0xFD3D, 0, 0, 5381, 2},
+	{0xFD50, 0, 0, 5383, 3},
+	{0xFD51, 0, 0, 5386, 3},
+	{0xFD52, 0, 0, 5386, 3},
+	{0xFD53, 0, 0, 5389, 3},
+	{0xFD54, 0, 0, 5392, 3},
+	{0xFD55, 0, 0, 5395, 3},
+	{0xFD56, 0, 0, 5398, 3},
+	{0xFD57, 0, 0, 5401, 3},
+	{0xFD58, 0, 0, 5404, 3},
+	{0xFD59, 0, 0, 5404, 3},
+	{0xFD5A, 0, 0, 5407, 3},
+	{0xFD5B, 0, 0, 5410, 3},
+	{0xFD5C, 0, 0, 5413, 3},
+	{0xFD5D, 0, 0, 5416, 3},
+	{0xFD5E, 0, 0, 5419, 3},
+	{0xFD5F, 0, 0, 5422, 3},
+	{0xFD60, 0, 0, 5422, 3},
+	{0xFD61, 0, 0, 5425, 3},
+	{0xFD62, 0, 0, 5428, 3},
+	{0xFD63, 0, 0, 5428, 3},
+	{0xFD64, 0, 0, 5431, 3},
+	{0xFD65, 0, 0, 5431, 3},
+	{0xFD66, 0, 0, 5434, 3},
+	{0xFD67, 0, 0, 5437, 3},
+	{0xFD68, 0, 0, 5437, 3},
+	{0xFD69, 0, 0, 5440, 3},
+	{0xFD6A, 0, 0, 5443, 3},
+	{0xFD6B, 0, 0, 5443, 3},
+	{0xFD6C, 0, 0, 5446, 3},
+	{0xFD6D, 0, 0, 5446, 3},
+	{0xFD6E, 0, 0, 5449, 3},
+	{0xFD6F, 0, 0, 5452, 3},
+	{0xFD70, 0, 0, 5452, 3},
+	{0xFD71, 0, 0, 5455, 3},
+	{0xFD72, 0, 0, 5455, 3},
+	{0xFD73, 0, 0, 5458, 3},
+	{0xFD74, 0, 0, 5461, 3},
+	{0xFD75, 0, 0, 5464, 3},
+	{0xFD76, 0, 0, 5467, 3},
+	{0xFD77, 0, 0, 5467, 3},
+	{0xFD78, 0, 0, 5470, 3},
+	{0xFD79, 0, 0, 5473, 3},
+	{0xFD7A, 0, 0, 5476, 3},
+	{0xFD7B, 0, 0, 5479, 3},
+	{0xFD7C, 0, 0, 5482, 3},
+	{0xFD7D, 0, 0, 5482, 3},
+	{0xFD7E, 0, 0, 5485, 3},
+	{0xFD7F, 0, 0, 5488, 3},
+	{0xFD80, 0, 0, 5491, 3},
+	{0xFD81, 0, 0, 5494, 3},
+	{0xFD82, 0, 0, 5497, 3},
+	{0xFD83, 0, 0, 5500, 3},
+	{0xFD84, 0, 0, 5500, 3},
+	{0xFD85, 0, 0, 5503, 3},
+	{0xFD86, 0, 0, 5503, 3},
+	{0xFD87, 0, 0, 5506, 3},
+	{0xFD88, 0, 0, 5506, 3},
+	{0xFD89, 0, 0, 5509, 3},
+	{0xFD8A, 0, 0, 5512, 3},
+	{0xFD8B, 0, 0, 5515, 3},
+	{0xFD8C, 0, 0, 5518, 3},
+	{0xFD8D, 0, 0, 5521, 3},
+	{0xFD8E, 0, 0, 5524, 3},
+	{0xFD8F, 0, 0, 5527, 3},
+	{0xFD92, 0, 0, 5530, 3},
+	{0xFD93, 0, 0, 5533, 3},
+	{0xFD94, 0, 0, 5536, 3},
+	{0xFD95, 0, 0, 5539, 3},
+	{0xFD96, 0, 0, 5542, 3},
+	{0xFD97, 0, 0, 5545, 3},
+	{0xFD98, 0, 0, 5545, 3},
+	{0xFD99, 0, 0, 5548, 3},
+	{0xFD9A, 0, 0, 5551, 3},
+	{0xFD9B, 0, 0, 5554, 3},
+	{0xFD9C, 0, 0, 5557, 3},
+	{0xFD9D, 0, 0, 5557, 3},
+	{0xFD9E, 0, 0, 5560, 3},
+	{0xFD9F, 0, 0, 5563, 3},
+	{0xFDA0, 0, 0, 5566, 3},
+	{0xFDA1, 0, 0, 5569, 3},
+	{0xFDA2, 0, 0, 5572, 3},
+	{0xFDA3, 0, 0, 5575, 3},
+	{0xFDA4, 0, 0, 5578, 3},
+	{0xFDA5, 0, 0, 5581, 3},
+	{0xFDA6, 0, 0, 5584, 3},
+	{0xFDA7, 0, 0, 5587, 3},
+	{0xFDA8, 0, 0, 5590, 3},
+	{0xFDA9, 0, 0, 5593, 3},
+	{0xFDAA, 0, 0, 5596, 3},
+	{0xFDAB, 0, 0, 5599, 3},
+	{0xFDAC, 0, 0, 5602, 3},
+	{0xFDAD, 0, 0, 5605, 3},
+	{0xFDAE, 0, 0, 5608, 3},
+	{0xFDAF, 0, 0, 5611, 3},
+	{0xFDB0, 0, 0, 5614, 3},
+	{0xFDB1, 0, 0, 5617, 3},
+	{0xFDB2, 0, 0, 5620, 3},
+	{0xFDB3, 0, 0, 5623, 3},
+	{0xFDB4, 0, 0, 5485, 3},
+	{0xFDB5, 0, 0, 5491, 3},
+	{0xFDB6, 0, 0, 5626, 3},
+	{0xFDB7, 0, 0, 5629, 3},
+	{0xFDB8, 0, 0, 5632, 3},
+	{0xFDB9, 0, 0, 5635, 3},
+	{0xFDBA, 0, 0, 5638, 3},
+	{0xFDBB, 0, 0, 5641, 3},
+	{0xFDBC, 0, 0, 5638, 3},
+	{0xFDBD, 0, 0, 5632, 3},
+	{0xFDBE, 0, 0, 5644, 3},
+	{0xFDBF, 0, 0, 5647, 3},
+	{0xFDC0, 0, 0, 5650, 3},
+	{0xFDC1, 0, 0, 5653, 3},
+	{0xFDC2, 0, 0, 5656, 3},
+	{0xFDC3, 0, 0, 5641, 3},
+	{0xFDC4, 0, 0, 5464, 3},
+	{0xFDC5, 0, 0, 5434, 3},
+	{0xFDC6, 0, 0, 5659, 3},
+	{0xFDC7, 0, 0, 5662, 3},
+	{0xFDF0, 0, 0, 5665, 3},
+	{0xFDF1, 0, 0, 5668, 3},
+	{0xFDF2, 0, 0, 5671, 4},
+	{0xFDF3, 0, 0, 5675, 4},
+	{0xFDF4, 0, 0, 5679, 4},
+	{0xFDF5, 0, 0, 5683, 4},
+	{0xFDF6, 0, 0, 5687, 4},
+	{0xFDF7, 0, 0, 5691, 4},
+	{0xFDF8, 0, 0, 5695, 4},
+	{0xFDF9, 0, 0, 5699, 3},
+	{0xFDFA, 0, 0, 5702, 18},
+	{0xFDFB, 0, 0, 5720, 8},
+	{0xFDFC, 0, 0, 5728, 4},
+	{0xFE10, 0, 0, 5732, 1},
+	{0xFE11, 0, 0, 5733, 1},
+	{0xFE12, 0, 0, 5734, 1},
+	{0xFE13, 0, 0, 5735, 1},
+	{0xFE14, 0, 0, 621, 1},
+	{0xFE15, 0, 0, 5736, 1},
+	{0xFE16, 0, 0, 5737, 1},
+	{0xFE17, 0, 0, 5738, 1},
+	{0xFE18, 0, 0, 5739, 1},
+	{0xFE19, 0, 0, 2276, 3},
+	{0xFE30, 0, 0, 2274, 2},
+	{0xFE31, 0, 0, 5740, 1},
+	{0xFE32, 0, 0, 5741, 1},
+	{0xFE33, 0, 0, 5742, 1},
+	{0xFE34, 0, 0, 5742, 1},
+	{0xFE35, 0, 0, 2313, 1},
+	{0xFE36, 0, 0, 2314, 1},
+	{0xFE37, 0, 0, 5743, 1},
+	{0xFE38, 0, 0, 5744, 1},
+	{0xFE39, 0, 0, 5745, 1},
+	{0xFE3A, 0, 0, 5746, 1},
+	{0xFE3B, 0, 0, 5747, 1},
+	{0xFE3C, 0, 0, 5748, 1},
+	{0xFE3D, 0, 0, 5749, 1},
+	{0xFE3E, 0, 0, 5750, 1},
+	{0xFE3F, 0, 0, 2557, 1},
+	{0xFE40, 0, 0, 2558, 1},
+	{0xFE41, 0, 0, 5751, 1},
+	{0xFE42, 0, 0, 5752, 1},
+	{0xFE43, 0, 0, 5753, 1},
+	{0xFE44, 0, 0, 5754, 1},
+	{0xFE47, 0, 0, 5755, 1},
+	{0xFE48, 0, 0, 5756, 1},
+	{0xFE49, 0, 0, 2291, 2},
+	{0xFE4A, 0, 0, 2291, 2},
+	{0xFE4B, 0, 0, 2291, 2},
+	{0xFE4C, 0, 0, 2291, 2},
+	{0xFE4D, 0, 0, 5742, 1},
+	{0xFE4E, 0, 0, 5742, 1},
+	{0xFE4F, 0, 0, 5742, 1},
+	{0xFE50, 0, 0, 5732, 1},
+	{0xFE51, 0, 0, 5733, 1},
+	{0xFE52, 0, 0, 2273, 1},
+	{0xFE54, 0, 0, 621, 1},
+	{0xFE55, 0, 0, 5735, 1},
+	{0xFE56, 0, 0, 5737, 1},
+	{0xFE57, 0, 0, 5736, 1},
+	{0xFE58, 0, 0, 5740, 1},
+	{0xFE59, 0, 0, 2313, 1},
+	{0xFE5A, 0, 0, 2314, 1},
+	{0xFE5B, 0, 0, 5743, 1},
+	{0xFE5C, 0, 0, 5744, 1},
+	{0xFE5D, 0, 0, 5745, 1},
+	{0xFE5E, 0, 0, 5746, 1},
+	{0xFE5F, 0, 0, 5757, 1},
+	{0xFE60, 0, 0, 5758, 1},
+	{0xFE61, 0, 0, 5759, 1},
+	{0xFE62, 0, 0, 2310, 1},
+	{0xFE63, 0, 0, 5760, 1},
+	{0xFE64, 0, 0, 5761, 1},
+	{0xFE65, 0, 0, 5762, 1},
+	{0xFE66, 0, 0, 2312, 1},
+	{0xFE68, 0, 0, 5763, 1},
+	{0xFE69, 0, 0, 5764, 1},
+	{0xFE6A, 0, 0, 5765, 1},
+	{0xFE6B, 0, 0, 5766, 1},
+	{0xFE70, 0, 0, 5767, 2},
+	{0xFE71, 0, 0, 5769, 2},
+	{0xFE72, 0, 0, 5771, 2},
+	{0xFE74, 0, 0, 5773, 2},
+	{0xFE76, 0, 0, 5775, 2},
+	{0xFE77, 0, 0, 5777, 2},
+	{0xFE78, 0, 0, 5779, 2},
+	{0xFE79, 0, 0, 5781, 2},
+	{0xFE7A, 0, 0, 5783, 2},
+	{0xFE7B, 0, 0, 5785, 2},
+	{0xFE7C, 0, 0, 5787, 2},
+	{0xFE7D, 0, 0, 5789, 2},
+	{0xFE7E, 0, 0, 5791, 2},
+	{0xFE7F, 0, 0, 5793, 2},
+	{0xFE80, 0, 0, 5795, 1},
+	{0xFE81, 0, 0, 791, 2},
+	{0xFE82, 0, 0, 791, 2},
+	{0xFE83, 0, 0, 793, 2},
+	{0xFE84, 0, 0, 793, 2},
+	{0xFE85, 0, 0, 795, 2},
+	{0xFE86, 0, 0, 795, 2},
+	{0xFE87, 0, 0, 797, 2},
+	{0xFE88, 0, 0, 797, 2},
+	{0xFE89, 0, 0, 799, 2},
+	{0xFE8A, 0, 0, 799, 2},
+	{0xFE8B, 0, 0, 799, 2},
+	{0xFE8C, 0, 0, 799, 2},
+	{0xFE8D, 0, 0, 5796, 1},
+	{0xFE8E, 0, 0, 5796, 1},
+	{0xFE8F, 0, 0, 5797, 1},
+	{0xFE90, 0, 0, 5797, 1},
+	{0xFE91, 0, 0, 5797, 1},
+	{0xFE92, 0, 0, 5797, 1},
+	{0xFE93, 0, 0, 5798, 1},
+	{0xFE94, 0, 0, 5798, 1},
+	{0xFE95, 0, 0, 5799, 1},
+	{0xFE96, 0, 0, 5799, 1},
+	{0xFE97, 0, 0, 5799, 1},
+	{0xFE98, 0, 0, 5799, 1},
+	{0xFE99, 0, 0, 5800, 1},
+	{0xFE9A, 0, 0, 5800, 1},
+	{0xFE9B, 0, 0, 5800, 1},
+	{0xFE9C, 0, 0, 5800, 1},
+	{0xFE9D, 0, 0, 5801, 1},
+	{0xFE9E, 0, 0, 5801, 1},
+	{0xFE9F, 0, 0, 5801, 1},
+	{0xFEA0, 0, 0, 5801, 1},
+	{0xFEA1, 0, 0, 5802, 1},
+	{0xFEA2, 0, 0, 5802, 1},
+	{0xFEA3, 0, 0, 5802, 1},
+	{0xFEA4, 0, 0, 5802, 1},
+	{0xFEA5, 0, 0, 5803, 1},
+	{0xFEA6, 0, 0, 5803, 1},
+	{0xFEA7, 0, 0, 5803, 1},
+	{0xFEA8, 0, 0, 5803, 1},
+	{0xFEA9, 0, 0, 5804, 1},
+	{0xFEAA, 0, 0, 5804, 1},
+	{0xFEAB, 0, 0, 5805, 1},
+	{0xFEAC, 0, 0, 5805, 1},
+	{0xFEAD, 0, 0, 5806, 1},
+	{0xFEAE, 0, 0, 5806, 1},
+	{0xFEAF, 0, 0, 5807, 1},
+	{0xFEB0, 0, 0, 5807, 1},
+	{0xFEB1, 0, 0, 5808, 1},
+	{0xFEB2, 0, 0, 5808, 1},
+	{0xFEB3, 0, 0, 5808, 1},
+	{0xFEB4, 0, 0, 5808, 1},
+	{0xFEB5, 0, 0, 5809, 1},
+	{0xFEB6, 0, 0, 5809, 1},
+	{0xFEB7, 0, 0, 5809, 1},
+	{0xFEB8, 0, 0, 5809, 1},
+	{0xFEB9, 0, 0, 5810, 1},
+	{0xFEBA, 0, 0, 5810, 1},
+	{0xFEBB, 0, 0, 5810, 1},
+	{0xFEBC, 0, 0, 5810, 1},
+	{0xFEBD, 0, 0, 5811, 1},
+	{0xFEBE, 0, 0, 5811, 1},
+	{0xFEBF, 0, 0, 5811, 1},
+	{0xFEC0, 0, 0, 5811, 1},
+	{0xFEC1, 0, 0, 5812, 1},
+	{0xFEC2, 0, 0, 5812, 1},
+	{0xFEC3, 0, 0, 5812, 1},
+	{0xFEC4, 0, 0, 5812, 1},
+	{0xFEC5, 0, 0, 5813, 1},
+	{0xFEC6, 0, 0, 5813, 1},
+	{0xFEC7, 0, 0, 5813, 1},
+	{0xFEC8, 0, 0, 5813, 1},
+	{0xFEC9, 0, 0, 5814, 1},
+	{0xFECA, 0, 0, 5814, 1},
+	{0xFECB, 0, 0, 5814, 1},
+	{0xFECC, 0, 0, 5814, 1},
+	{0xFECD, 0, 0, 5815, 1},
+	{0xFECE, 0, 0, 5815, 1},
+	{0xFECF, 0, 0, 5815, 1},
+	{0xFED0, 0, 0, 5815, 1},
+	{0xFED1, 0, 0, 5816, 1},
+	{0xFED2, 0, 0, 5816, 1},
+	{0xFED3, 0, 0, 5816, 1},
+	{0xFED4, 0, 0, 5816, 1},
+	{0xFED5, 0, 0, 5817, 1},
+	{0xFED6, 0, 0, 5817, 1},
+	{0xFED7, 0, 0, 5817, 1},
+	{0xFED8, 0, 0, 5817, 1},
+	{0xFED9, 0, 0, 5818, 1},
+	{0xFEDA, 0, 0, 5818, 1},
+	{0xFEDB, 0, 0, 5818, 1},
+	{0xFEDC, 0, 0, 5818, 1},
+	{0xFEDD, 0, 0, 5819, 1},
+	{0xFEDE, 0, 0, 5819, 1},
+	{0xFEDF, 0, 0, 5819, 1},
+	{0xFEE0, 0, 0, 5819, 1},
+	{0xFEE1, 0, 0, 5820, 1},
+	{0xFEE2, 0, 0, 5820, 1},
+	{0xFEE3, 0, 0, 5820, 1},
+	{0xFEE4, 0, 0, 5820, 1},
+	{0xFEE5, 0, 0, 5821, 1},
+	{0xFEE6, 0, 0, 5821, 1},
+	{0xFEE7, 0, 0, 5821, 1},
+	{0xFEE8, 0, 0, 5821, 1},
+	{0xFEE9, 0, 0, 5822, 1},
+	{0xFEEA, 0, 0, 5822, 1},
+	{0xFEEB, 0, 0, 5822, 1},
+	{0xFEEC, 0, 0, 5822, 1},
+	{0xFEED, 0, 0, 5823, 1},
+	{0xFEEE, 0, 0, 5823, 1},
+	{0xFEEF, 0, 0, 5015, 1},
+	{0xFEF0, 0, 0, 5015, 1},
+	{0xFEF1, 0, 0, 5824, 1},
+	{0xFEF2, 0, 0, 5824, 1},
+	{0xFEF3, 0, 0, 5824, 1},
+	{0xFEF4, 0, 0, 5824, 1},
+	{0xFEF5, 0, 0, 5825, 3},
+	{0xFEF6, 0, 0, 5825, 3},
+	{0xFEF7, 0, 0, 5828, 3},
+	{0xFEF8, 0, 0, 5828, 3},
+	{0xFEF9, 0, 0, 5831, 3},
+	{0xFEFA, 0, 0, 5831, 3},
+	{0xFEFB, 0, 0, 5834, 2},
+	{0xFEFC, 0, 0, 5834, 2},
+	{0xFF01, 0, 0, 5736, 1},
+	{0xFF02, 0, 0, 5836, 1},
+	{0xFF03, 0, 0, 5757, 1},
+	{0xFF04, 0, 0, 5764, 1},
+	{0xFF05, 0, 0, 5765, 1},
+	{0xFF06, 0, 0, 5758, 1},
+	{0xFF07, 0, 0, 5837, 1},
+	{0xFF08, 0, 0, 2313, 1},
+	{0xFF09, 0, 0, 2314, 1},
+	{0xFF0A, 0, 0, 5759, 1},
+	{0xFF0B, 0, 0, 2310, 1},
+	{0xFF0C, 0, 0, 5732, 1},
+	{0xFF0D, 0, 0, 5760, 1},
+	{0xFF0E, 0, 0, 2273, 1},
+	{0xFF0F, 0, 0, 5838, 1},
+	{0xFF10, 0, 0, 2303, 1},
+	{0xFF11, 0, 0, 13, 1},
+	{0xFF12, 0, 0, 6, 1},
+	{0xFF13, 0, 0, 7, 1},
+	{0xFF14, 0, 0, 2304, 1},
+	{0xFF15, 0, 0, 2305, 1},
+	{0xFF16, 0, 0, 2306, 1},
+	{0xFF17, 0, 0, 2307, 1},
+	{0xFF18, 0, 0, 2308, 1},
+	{0xFF19, 0, 0, 2309, 1},
+	{0xFF1A, 0, 0, 5735, 1},
+	{0xFF1B, 0, 0, 621, 1},
+	{0xFF1C, 0, 0, 5761, 1},
+	{0xFF1D, 0, 0, 2312, 1},
+	{0xFF1E, 0, 0, 5762, 1},
+	{0xFF1F, 0, 0, 5737, 1},
+	{0xFF20, 0, 0, 5766, 1},
+	{0xFF21, 0, 0, 979, 1},
+	{0xFF22, 0, 0, 981, 1},
+	{0xFF23, 0, 0, 2324, 1},
+	{0xFF24, 0, 0, 982, 1},
+	{0xFF25, 0, 0, 983, 1},
+	{0xFF26, 0, 0, 2349, 1},
+	{0xFF27, 0, 0, 985, 1},
+	{0xFF28, 0, 0, 986, 1},
+	{0xFF29, 0, 0, 987, 1},
+	{0xFF2A, 0, 0, 988, 1},
+	{0xFF2B, 0, 0, 989, 1},
+	{0xFF2C, 0, 0, 990, 1},
+	{0xFF2D, 0, 0, 991, 1},
+	{0xFF2E, 0, 0, 992, 1},
+	{0xFF2F, 0, 0, 993, 1},
+	{0xFF30, 0, 0, 995, 1},
+	{0xFF31, 0, 0, 2339, 1},
+	{0xFF32, 0, 0, 996, 1},
+	{0xFF33, 0, 0, 2781, 1},
+	{0xFF34, 0, 0, 997, 1},
+	{0xFF35, 0, 0, 998, 1},
+	{0xFF36, 0, 0, 2415, 1},
+	{0xFF37, 0, 0, 999, 1},
+	{0xFF38, 0, 0, 2427, 1},
+	{0xFF39, 0, 0, 2782, 1},
+	{0xFF3A, 0, 0, 2347, 1},
+	{0xFF3B, 0, 0, 5755, 1},
+	{0xFF3C, 0, 0, 5763, 1},
+	{0xFF3D, 0, 0, 5756, 1},
+	{0xFF3E, 0, 0, 5839, 1},
+	{0xFF3F, 0, 0, 5742, 1},
+	{0xFF40, 0, 0, 2245, 1},
+	{0xFF41, 0, 0, 3, 1},
+	{0xFF42, 0, 0, 1003, 1},
+	{0xFF43, 0, 0, 1029, 1},
+	{0xFF44, 0, 0, 1004, 1},
+	{0xFF45, 0, 0, 1005, 1},
+	{0xFF46, 0, 0, 1032, 1},
+	{0xFF47, 0, 0, 1009, 1},
+	{0xFF48, 0, 0, 588, 1},
+	{0xFF49, 0, 0, 1026, 1},
+	{0xFF4A, 0, 0, 590, 1},
+	{0xFF4B, 0, 0, 1010, 1},
+	{0xFF4C, 0, 0, 610, 1},
+	{0xFF4D, 0, 0, 1011, 1},
+	{0xFF4E, 0, 0, 2315, 1},
+	{0xFF4F, 0, 0, 14, 1},
+	{0xFF50, 0, 0, 1016, 1},
+	{0xFF51, 0, 0, 2783, 1},
+	{0xFF52, 0, 0, 591, 1},
+	{0xFF53, 0, 0, 356, 1},
+	{0xFF54, 0, 0, 1017, 1},
+	{0xFF55, 0, 0, 1018, 1},
+	{0xFF56, 0, 0, 1021, 1},
+	{0xFF57, 0, 0, 595, 1},
+	{0xFF58, 0, 0, 611, 1},
+	{0xFF59, 0, 0, 596, 1},
+	{0xFF5A, 0, 0, 1059, 1},
+	{0xFF5B, 0, 0, 5743, 1},
+	{0xFF5C, 0, 0, 5840, 1},
+	{0xFF5D, 0, 0, 5744, 1},
+	{0xFF5E, 0, 0, 5841, 1},
+	{0xFF5F, 0, 0, 5842, 1},
+	{0xFF60, 0, 0, 5843, 1},
+	{0xFF61, 0, 0, 5734, 1},
+	{0xFF62, 0, 0, 5751, 1},
+	{0xFF63, 0, 0, 5752, 1},
+	{0xFF64, 0, 0, 5733, 1},
+	{0xFF65, 0, 0, 5844, 1},
+	{0xFF66, 0, 0, 3689, 1},
+	{0xFF67, 0, 0, 5845, 1},
+	{0xFF68, 0, 0, 5846, 1},
+	{0xFF69, 0, 0, 5847, 1},
+	{0xFF6A, 0, 0, 5848, 1},
+	{0xFF6B, 0, 0, 5849, 1},
+	{0xFF6C, 0, 0, 5850, 1},
+	{0xFF6D, 0, 0, 5851, 1},
+	{0xFF6E, 0, 0, 5852, 1},
+	{0xFF6F, 0, 0, 5853, 1},
+	{0xFF70, 0, 0, 5854, 1},
+	{0xFF71, 0, 0, 3643, 1},
+	{0xFF72, 0, 0, 3644, 1},
+	{0xFF73, 0, 0, 3645, 1},
+	{0xFF74, 0, 0, 3646, 1},
+	{0xFF75, 0, 0, 3647, 1},
+	{0xFF76, 0, 0, 3648, 1},
+	{0xFF77, 0, 0, 3649, 1},
+	{0xFF78, 0, 0, 3650, 1},
+	{0xFF79, 0, 0, 3651, 1},
+	{0xFF7A, 0, 0, 3652, 1},
+	{0xFF7B, 0, 0, 3653, 1},
+	{0xFF7C, 0, 0, 3654, 1},
+	{0xFF7D, 0, 0, 3655, 1},
+	{0xFF7E, 0, 0, 3656, 1},
+	{0xFF7F, 0, 0, 3657, 1},
+	{0xFF80, 0, 0, 3658, 1},
+	{0xFF81, 0, 0, 3659, 1},
+	{0xFF82, 0, 0, 3660, 1},
+	{0xFF83, 0, 0, 3661, 1},
+	{0xFF84, 0, 0, 3662, 1},
+	{0xFF85, 0, 0, 3663, 1},
+	{0xFF86, 0, 0, 3664, 1},
+	{0xFF87, 0, 0, 3665, 1},
+	{0xFF88, 0, 0, 3666, 1},
+	{0xFF89, 0, 0, 3667, 1},
+	{0xFF8A, 0, 0, 3668, 1},
+	{0xFF8B, 0, 0, 3669, 1},
+	{0xFF8C, 0, 0, 3670, 1},
+	{0xFF8D, 0, 0, 3671, 1},
+	{0xFF8E, 0, 0, 3672, 1},
+	{0xFF8F, 0, 0, 3673, 1},
+	{0xFF90, 0, 0, 3674, 1},
+	{0xFF91, 0, 0, 3675, 1},
+	{0xFF92, 0, 0, 3676, 1},
+	{0xFF93, 0, 0, 3677, 1},
+	{0xFF94, 0, 0, 3678, 1},
+	{0xFF95, 0, 0, 3679, 1},
+	{0xFF96, 0, 0, 3680, 1},
+	{0xFF97, 0, 0, 3681, 1},
+	{0xFF98, 0, 0, 3682, 1},
+	{0xFF99, 0, 0, 3683, 1},
+	{0xFF9A, 0, 0, 3684, 1},
+	{0xFF9B, 0, 0, 3685, 1},
+	{0xFF9C, 0, 0, 3686, 1},
+	{0xFF9D, 0, 0, 5855, 1},
+	{0xFF9E, 0, 0, 5856, 1},
+	{0xFF9F, 0, 0, 5857, 1},
+	{0xFFA0, 0, 0, 3193, 1},
+	{0xFFA1, 0, 0, 3142, 1},
+	{0xFFA2, 0, 0, 3143, 1},
+	{0xFFA3, 0, 0, 3144, 1},
+	{0xFFA4, 0, 0, 3145, 1},
+	{0xFFA5, 0, 0, 3146, 1},
+	{0xFFA6, 0, 0, 3147, 1},
+	{0xFFA7, 0, 0, 3148, 1},
+	{0xFFA8, 0, 0, 3149, 1},
+	{0xFFA9, 0, 0, 3150, 1},
+	{0xFFAA, 0, 0, 3151, 1},
+	{0xFFAB, 0, 0, 3152, 1},
+	{0xFFAC, 0, 0, 3153, 1},
+	{0xFFAD, 0, 0, 3154, 1},
+	{0xFFAE, 0, 0, 3155, 1},
+	{0xFFAF, 0, 0, 3156, 1},
+	{0xFFB0, 0, 0, 3157, 1},
+	{0xFFB1, 0, 0, 3158, 1},
+	{0xFFB2, 0, 0, 3159, 1},
+	{0xFFB3, 0, 0, 3160, 1},
+	{0xFFB4, 0, 0, 3161, 1},
+	{0xFFB5, 0, 0, 3162, 1},
+	{0xFFB6, 0, 0, 3163, 1},
+	{0xFFB7, 0, 0, 3164, 1},
+	{0xFFB8, 0, 0, 3165, 1},
+	{0xFFB9, 0, 0, 3166, 1},
+	{0xFFBA, 0, 0, 3167, 1},
+	{0xFFBB, 0, 0, 3168, 1},
+	{0xFFBC, 0, 0, 3169, 1},
+	{0xFFBD, 0, 0, 3170, 1},
+	{0xFFBE, 0, 0, 3171, 1},
+	{0xFFC2, 0, 0, 3172, 1},
+	{0xFFC3, 0, 0, 3173, 1},
+	{0xFFC4, 0, 0, 3174, 1},
+	{0xFFC5, 0, 0, 3175, 1},
+	{0xFFC6, 0, 0, 3176, 1},
+	{0xFFC7, 0, 0, 3177, 1},
+	{0xFFCA, 0, 0, 3178, 1},
+	{0xFFCB, 0, 0, 3179, 1},
+	{0xFFCC, 0, 0, 3180, 1},
+	{0xFFCD, 0, 0, 3181, 1},
+	{0xFFCE, 0, 0, 3182, 1},
+	{0xFFCF, 0, 0, 3183, 1},
+	{0xFFD2, 0, 0, 3184, 1},
+	{0xFFD3, 0, 0, 3185, 1},
+	{0xFFD4, 0, 0, 3186, 1},
+	{0xFFD5, 0, 0, 3187, 1},
+	{0xFFD6, 0, 0, 3188, 1},
+	{0xFFD7, 0, 0, 3189, 1},
+	{0xFFDA, 0, 0, 3190, 1},
+	{0xFFDB, 0, 0, 3191, 1},
+	{0xFFDC, 0, 0, 3192, 1},
+	{0xFFE0, 0, 0, 5858, 1},
+	{0xFFE1, 0, 0, 5859, 1},
+	{0xFFE2, 0, 0, 5860, 1},
+	{0xFFE3, 0, 0, 4, 2},
+	{0xFFE4, 0, 0, 5861, 1},
+	{0xFFE5, 0, 0, 5862, 1},
+	{0xFFE6, 0, 0, 5863, 1},
+	{0xFFE8, 0, 0, 5864, 1},
+	{0xFFE9, 0, 0, 5865, 1},
+	{0xFFEA, 0, 0, 5866, 1},
+	{0xFFEB, 0, 0, 5867, 1},
+	{0xFFEC, 0, 0, 5868, 1},
+	{0xFFED, 0, 0, 5869, 1},
+	{0xFFEE, 0, 0, 5870, 1},
+	{0x105C9, 5871, 2, 5871, 2},
+	{0x105E4, 5873, 2, 5873, 2},
+	{0x10781, 0, 0, 5875, 1},
+	{0x10782, 0, 0, 5876, 1},
+	{0x10783, 0, 0, 5877, 1},
+	{0x10784, 0, 0, 5878, 1},
+	{0x10785, 0, 0, 5879, 1},
+	{0x10787, 0, 0, 5880, 1},
+	{0x10788, 0, 0, 5881, 1},
+	{0x10789, 0, 0, 5882, 1},
+	{0x1078A, 0, 0, 5883, 1},
+	{0x1078B, 0, 0, 5884, 1},
+	{0x1078C, 0, 0, 5885, 1},
+	{0x1078D, 0, 0, 5886, 1},
+	{0x1078E, 0, 0, 5887, 1},
+	{0x1078F, 0, 0, 5888, 1},
+	{0x10790, 0, 0, 5889, 1},
+	{0x10791, 0, 0, 5890, 1},
+	{0x10792, 0, 0, 5891, 1},
+	{0x10793, 0, 0, 5892, 1},
+	{0x10794, 0, 0, 5893, 1},
+	{0x10795, 0, 0, 2336, 1},
+	{0x10796, 0, 0, 5894, 1},
+	{0x10797, 0, 0, 5895, 1},
+	{0x10798, 0, 0, 5896, 1},
+	{0x10799, 0, 0, 5897, 1},
+	{0x1079A, 0, 0, 5898, 1},
+	{0x1079B, 0, 0, 5899, 1},
+	{0x1079C, 0, 0, 5900, 1},
+	{0x1079D, 0, 0, 5901, 1},
+	{0x1079E, 0, 0, 5902, 1},
+	{0x1079F, 0, 0, 5903, 1},
+	{0x107A0, 0, 0, 5904, 1},
+	{0x107A1, 0, 0, 5905, 1},
+	{0x107A2, 0, 0, 5906, 1},
+	{0x107A3, 0, 0, 5907, 1},
+	{0x107A4, 0, 0, 5908, 1},
+	{0x107A5, 0, 0, 2783, 1},
+	{0x107A6, 0, 0, 5909, 1},
+	{0x107A7, 0, 0, 5910, 1},
+	{0x107A8, 0, 0, 5911, 1},
+	{0x107A9, 0, 0, 5912, 1},
+	{0x107AA, 0, 0, 5913, 1},
+	{0x107AB, 0, 0, 5914, 1},
+	{0x107AC, 0, 0, 5915, 1},
+	{0x107AD, 0, 0, 5916, 1},
+	{0x107AE, 0, 0, 5917, 1},
+	{0x107AF, 0, 0, 5918, 1},
+	{0x107B0, 0, 0, 5919, 1},
+	{0x107B2, 0, 0, 5920, 1},
+	{0x107B3, 0, 0, 5921, 1},
+	{0x107B4, 0, 0, 5922, 1},
+	{0x107B5, 0, 0, 5923, 1},
+	{0x107B6, 0, 0, 5924, 1},
+	{0x107B7, 0, 0, 5925, 1},
+	{0x107B8, 0, 0, 5926, 1},
+	{0x107B9, 0, 0, 5927, 1},
+	{0x107BA, 0, 0, 5928, 1},
+	{0x1109A, 5929, 2, 5929, 2},
+	{0x1109C, 5931, 2, 5931, 2},
+	{0x110AB, 5933, 2, 5933, 2},
+	{0x1112E, 5935, 2, 5935, 2},
+	{0x1112F, 5937, 2, 5937, 2},
+	{0x1134B, 5939, 2, 5939, 2},
+	{0x1134C, 5941, 2, 5941, 2},
+	{0x11383, 5943, 2, 5943, 2},
+	{0x11385, 5945, 2, 5945, 2},
+	{0x1138E, 5947, 2, 5947, 2},
+	{0x11391, 5949, 2, 5949, 2},
+	{0x113C5, 5951, 2, 5951, 2},
+	{0x113C7, 5953, 2, 5953, 2},
+	{0x113C8, 5955, 2, 5955, 2},
+	{0x114BB, 5957, 2, 5957, 2},
+	{0x114BC, 5959, 2, 5959, 2},
+	{0x114BE, 5961, 2, 5961, 2},
+	{0x115BA, 5963, 2, 5963, 2},
+	{0x115BB, 5965, 2, 5965, 2},
+	{0x11938, 5967, 2, 5967, 2},
+	{0x16121, 5969, 2, 5969, 2},
+	{0x16122, 5971, 2, 5971, 2},
+	{0x16123, 5973, 2, 5973, 2},
+	{0x16124, 5975, 2, 5975, 2},
+	{0x16125, 5977, 2, 5977, 2},
+	{0x16126, 5979, 3, 5979, 3},
+	{0x16127, 5982, 3, 5982, 3},
+	{0x16128, 5985, 3, 5985, 3},
+	{0x16D68, 5988, 2, 5988, 2},
+	{0x16D69, 5990, 2, 5990, 2},
+	{0x16D6A, 5992, 3, 5992, 3},
+	{0x1CCD6, 0, 0, 979, 1},
+	{0x1CCD7, 0, 0, 981, 1},
+	{0x1CCD8, 0, 0, 2324, 1},
+	{0x1CCD9, 0, 0, 982, 1},
+	{0x1CCDA, 0, 0, 983, 1},
+	{0x1CCDB, 0, 0, 2349, 1},
+	{0x1CCDC, 0, 0, 985, 1},
+	{0x1CCDD, 0, 0, 986, 1},
+	{0x1CCDE, 0, 0, 987, 1},
+	{0x1CCDF, 0, 0, 988, 1},
+	{0x1CCE0, 0, 0, 989, 1},
+	{0x1CCE1, 0, 0, 990, 1},
+	{0x1CCE2, 0, 0, 991, 1},
+	{0x1CCE3, 0, 0, 992, 1},
+	{0x1CCE4, 0, 0, 993, 1},
+	{0x1CCE5, 0, 0, 995, 1},
+	{0x1CCE6, 0, 0, 2339, 1},
+	{0x1CCE7, 0, 0, 996, 1},
+	{0x1CCE8, 0, 0, 2781, 1},
+	{0x1CCE9, 0, 0, 997, 1},
+	{0x1CCEA, 0, 0, 998, 1},
+	{0x1CCEB, 0, 0, 2415, 1},
+	{0x1CCEC, 0, 0, 999, 1},
+	{0x1CCED, 0, 0, 2427, 1},
+	{0x1CCEE, 0, 0, 2782, 1},
+	{0x1CCEF, 0, 0, 2347, 1},
+	{0x1CCF0, 0, 0, 2303, 1},
+	{0x1CCF1, 0, 0, 13, 1},
+	{0x1CCF2, 0, 0, 6, 1},
+	{0x1CCF3, 0, 0, 7, 1},
+	{0x1CCF4, 0, 0, 2304, 1},
+	{0x1CCF5, 0, 0, 2305, 1},
+	{0x1CCF6, 0, 0, 2306, 1},
+	{0x1CCF7, 0, 0, 2307, 1},
+	{0x1CCF8, 0, 0, 2308, 1},
+	{0x1CCF9, 0, 0, 2309, 1},
+	{0x1D15E, 5995, 2, 5995, 2},
+	{0x1D15F, 5997, 2, 5997, 2},
+	{0x1D160, 5999, 3, 5999, 3},
+	{0x1D161, 6002, 3, 6002, 3},
+	{0x1D162, 6005, 3, 6005, 3},
+	{0x1D163, 6008, 3, 6008, 3},
+	{0x1D164, 6011, 3, 6011, 3},
+	{0x1D1BB, 6014, 2, 6014, 2},
+	{0x1D1BC, 6016, 2, 6016, 2},
+	{0x1D1BD, 6018, 3, 6018, 3},
+	{0x1D1BE, 6021, 3, 6021, 3},
+	{0x1D1BF, 6024, 3, 6024, 3},
+	{0x1D1C0, 6027, 3, 6027, 3},
+	{0x1D400, 0, 0, 979, 1},
+	{0x1D401, 0, 0, 981, 1},
+	{0x1D402, 0, 0, 2324, 1},
+	{0x1D403, 0, 0, 982, 1},
+	{0x1D404, 0, 0, 983, 1},
+	{0x1D405, 0, 0, 2349, 1},
+	{0x1D406, 0, 0, 985, 1},
+	{0x1D407, 0, 0, 986, 1},
+	{0x1D408, 0, 0, 987, 1},
+	{0x1D409, 0, 0, 988, 1},
+	{0x1D40A, 0, 0, 989, 1},
+	{0x1D40B, 0, 0, 990, 1},
+	{0x1D40C, 0, 0, 991, 1},
+	{0x1D40D, 0, 0, 992, 1},
+	{0x1D40E, 0, 0, 993, 1},
+	{0x1D40F, 0, 0, 995, 1},
+	{0x1D410, 0, 0, 2339, 1},
+	{0x1D411, 0, 0, 996, 1},
+	{0x1D412, 0, 0, 2781, 1},
+	{0x1D413, 0, 0, 997, 1},
+	{0x1D414, 0, 0, 998, 1},
+	{0x1D415, 0, 0, 2415, 1},
+	{0x1D416, 0, 0, 999, 1},
+	{0x1D417, 0, 0, 2427, 1},
+	{0x1D418, 0, 0, 2782, 1},
+	{0x1D419, 0, 0, 2347, 1},
+	{0x1D41A, 0, 0, 3, 1},
+	{0x1D41B, 0, 0, 1003, 1},
+	{0x1D41C, 0, 0, 1029, 1},
+	{0x1D41D, 0, 0, 1004, 1},
+	{0x1D41E, 0, 0, 1005, 1},
+	{0x1D41F, 0, 0, 1032, 1},
+	{0x1D420, 0, 0, 1009, 1},
+	{0x1D421, 0, 0, 588, 1},
+	{0x1D422, 0, 0, 1026, 1},
+	{0x1D423, 0, 0, 590, 1},
+	{0x1D424, 0, 0, 1010, 1},
+	{0x1D425, 0, 0, 610, 1},
+	{0x1D426, 0, 0, 1011, 1},
+	{0x1D427, 0, 0, 2315, 1},
+	{0x1D428, 0, 0, 14, 1},
+	{0x1D429, 0, 0, 1016, 1},
+	{0x1D42A, 0, 0, 2783, 1},
+	{0x1D42B, 0, 0, 591, 1},
+	{0x1D42C, 0, 0, 356, 1},
+	{0x1D42D, 0, 0, 1017, 1},
+	{0x1D42E, 0, 0, 1018, 1},
+	{0x1D42F, 0, 0, 1021, 1},
+	{0x1D430, 0, 0, 595, 1},
+	{0x1D431, 0, 0, 611, 1},
+	{0x1D432, 0, 0, 596, 1},
+	{0x1D433, 0, 0, 1059, 1},
+	{0x1D434, 0, 0, 979, 1},
+	{0x1D435, 0, 0, 981, 1},
+	{0x1D436, 0, 0, 2324, 1},
+	{0x1D437, 0, 0, 982, 1},
+	{0x1D438, 0, 0, 983, 1},
+	{0x1D439, 0, 0, 2349, 1},
+	{0x1D43A, 0, 0, 985, 1},
+	{0x1D43B, 0, 0, 986, 1},
+	{0x1D43C, 0, 0, 987, 1},
+	{0x1D43D, 0, 0, 988, 1},
+	{0x1D43E, 0, 0, 989, 1},
+	{0x1D43F, 0, 0, 990, 1},
+	{0x1D440, 0, 0, 991, 1},
+	{0x1D441, 0, 0, 992, 1},
+	{0x1D442, 0, 0, 993, 1},
+	{0x1D443, 0, 0, 995, 1},
+	{0x1D444, 0, 0, 2339, 1},
+	{0x1D445, 0, 0, 996, 1},
+	{0x1D446, 0, 0, 2781, 1},
+	{0x1D447, 0, 0, 997, 1},
+	{0x1D448, 0, 0, 998, 1},
+	{0x1D449, 0, 0, 2415, 1},
+	{0x1D44A, 0, 0, 999, 1},
+	{0x1D44B, 0, 0, 2427, 1},
+	{0x1D44C, 0, 0, 2782, 1},
+	{0x1D44D, 0, 0, 2347, 1},
+	{0x1D44E, 0, 0, 3, 1},
+	{0x1D44F, 0, 0, 1003, 1},
+	{0x1D450, 0, 0, 1029, 1},
+	{0x1D451, 0, 0, 1004, 1},
+	{0x1D452, 0, 0, 1005, 1},
+	{0x1D453, 0, 0, 1032, 1},
+	{0x1D454, 0, 0, 1009, 1},
+	{0x1D456, 0, 0, 1026, 1},
+	{0x1D457, 0, 0, 590, 1},
+	{0x1D458, 0, 0, 1010, 1},
+	{0x1D459, 0, 0, 610, 1},
+	{0x1D45A, 0, 0, 1011, 1},
+	{0x1D45B, 0, 0, 2315, 1},
+	{0x1D45C, 0, 0, 14, 1},
+	{0x1D45D, 0, 0, 1016, 1},
+	{0x1D45E, 0, 0, 2783, 1},
+	{0x1D45F, 0, 0, 591, 1},
+	{0x1D460, 0, 0, 356, 1},
+	{0x1D461, 0, 0, 1017, 1},
+	{0x1D462, 0, 0, 1018, 1},
+	{0x1D463, 0, 0, 1021, 1},
+	{0x1D464, 0, 0, 595, 1},
+	{0x1D465, 0, 0, 611, 1},
+	{0x1D466, 0, 0, 596, 1},
+	{0x1D467, 0, 0, 1059, 1},
+	{0x1D468, 0, 0, 979, 1},
+	{0x1D469, 0, 0, 981, 1},
+	{0x1D46A, 0, 0, 2324, 1},
+	{0x1D46B, 0, 0, 982, 1},
+	{0x1D46C, 0, 0, 983, 1},
+	{0x1D46D, 0, 0, 2349, 1},
+	{0x1D46E, 0, 0, 985, 1},
+	{0x1D46F, 0, 0, 986, 1},
+	{0x1D470, 0, 0, 987, 1},
+	{0x1D471, 0, 0, 988, 1},
+	{0x1D472, 0, 0, 989, 1},
+	{0x1D473, 0, 0, 990, 1},
+	{0x1D474, 0, 0, 991, 1},
+	{0x1D475, 0, 0, 992, 1},
+	{0x1D476, 0, 0, 993, 1},
+	{0x1D477, 0, 0, 995, 1},
+	{0x1D478, 0, 0, 2339, 1},
+	{0x1D479, 0, 0, 996, 1},
+	{0x1D47A, 0, 0, 2781, 1},
+	{0x1D47B, 0, 0, 997, 1},
+	{0x1D47C, 0, 0, 998, 1},
+	{0x1D47D, 0, 0, 2415, 1},
+	{0x1D47E, 0, 0, 999, 1},
+	{0x1D47F, 0, 0, 2427, 1},
+	{0x1D480, 0, 0, 2782, 1},
+	{0x1D481, 0, 0, 2347, 1},
+	{0x1D482, 0, 0, 3, 1},
+	{0x1D483, 0, 0, 1003, 1},
+	{0x1D484, 0, 0, 1029, 1},
+	{0x1D485, 0, 0, 1004, 1},
+	{0x1D486, 0, 0, 1005, 1},
+	{0x1D487, 0, 0, 1032, 1},
+	{0x1D488, 0, 0, 1009, 1},
+	{0x1D489, 0, 0, 588, 1},
+	{0x1D48A, 0, 0, 1026, 1},
+	{0x1D48B, 0, 0, 590, 1},
+	{0x1D48C, 0, 0, 1010, 1},
+	{0x1D48D, 0, 0, 610, 1},
+	{0x1D48E, 0, 0, 1011, 1},
+	{0x1D48F, 0, 0, 2315, 1},
+	{0x1D490, 0, 0, 14, 1},
+	{0x1D491, 0, 0, 1016, 1},
+	{0x1D492, 0, 0, 2783, 1},
+	{0x1D493, 0, 0, 591, 1},
+	{0x1D494, 0, 0, 356, 1},
+	{0x1D495, 0, 0, 1017, 1},
+	{0x1D496, 0, 0, 1018, 1},
+	{0x1D497, 0, 0, 1021, 1},
+	{0x1D498, 0, 0, 595, 1},
+	{0x1D499, 0, 0, 611, 1},
+	{0x1D49A, 0, 0, 596, 1},
+	{0x1D49B, 0, 0, 1059, 1},
+	{0x1D49C, 0, 0, 979, 1},
+	{0x1D49E, 0, 0, 2324, 1},
+	{0x1D49F, 0, 0, 982, 1},
+	{0x1D4A2, 0, 0, 985, 1},
+	{0x1D4A5, 0, 0, 988, 1},
+	{0x1D4A6, 0, 0, 989, 1},
+	{0x1D4A9, 0, 0, 992, 1},
+	{0x1D4AA, 0, 0, 993, 1},
+	{0x1D4AB, 0, 0, 995, 1},
+	{0x1D4AC, 0, 0, 2339, 1},
+	{0x1D4AE, 0, 0, 2781, 1},
+	{0x1D4AF, 0, 0, 997, 1},
+	{0x1D4B0, 0, 0, 998, 1},
+	{0x1D4B1, 0, 0, 2415, 1},
+	{0x1D4B2, 0, 0, 999, 1},
+	{0x1D4B3, 0, 0, 2427, 1},
+	{0x1D4B4, 0, 0, 2782, 1},
+	{0x1D4B5, 0, 0, 2347, 1},
+	{0x1D4B6, 0, 0, 3, 1},
+	{0x1D4B7, 0, 0, 1003, 1},
+	{0x1D4B8, 0, 0, 1029, 1},
+	{0x1D4B9, 0, 0, 1004, 1},
+	{0x1D4BB, 0, 0, 1032, 1},
+	{0x1D4BD, 0, 0, 588, 1},
+	{0x1D4BE, 0, 0, 1026, 1},
+	{0x1D4BF, 0, 0, 590, 1},
+	{0x1D4C0, 0, 0, 1010, 1},
+	{0x1D4C1, 0, 0, 610, 1},
+	{0x1D4C2, 0, 0, 1011, 1},
+	{0x1D4C3, 0, 0, 2315, 1},
+	{0x1D4C5, 0, 0, 1016, 1},
+	{0x1D4C6, 0, 0, 2783, 1},
+	{0x1D4C7, 0, 0, 591, 1},
+	{0x1D4C8, 0, 0, 356, 1},
+	{0x1D4C9, 0, 0, 1017, 1},
+	{0x1D4CA, 0, 0, 1018, 1},
+	{0x1D4CB, 0, 0, 1021, 1},
+	{0x1D4CC, 0, 0, 595, 1},
+	{0x1D4CD, 0, 0, 611, 1},
+	{0x1D4CE, 0, 0, 596, 1},
+	{0x1D4CF, 0, 0, 1059, 1},
+	{0x1D4D0, 0, 0, 979, 1},
+	{0x1D4D1, 0, 0, 981, 1},
+	{0x1D4D2, 0, 0, 2324, 1},
+	{0x1D4D3, 0, 0, 982, 1},
+	{0x1D4D4, 0, 0, 983, 1},
+	{0x1D4D5, 0, 0, 2349, 1},
+	{0x1D4D6, 0, 0, 985, 1},
+	{0x1D4D7, 0, 0, 986, 1},
+	{0x1D4D8, 0, 0, 987, 1},
+	{0x1D4D9, 0, 0, 988, 1},
+	{0x1D4DA, 0, 0, 989, 1},
+	{0x1D4DB, 0, 0, 990, 1},
+	{0x1D4DC, 0, 0, 991, 1},
+	{0x1D4DD, 0, 0, 992, 1},
+	{0x1D4DE, 0, 0, 993, 1},
+	{0x1D4DF, 0, 0, 995, 1},
+	{0x1D4E0, 0, 0, 2339, 1},
+	{0x1D4E1, 0, 0, 996, 1},
+	{0x1D4E2, 0, 0, 2781, 1},
+	{0x1D4E3, 0, 0, 997, 1},
+	{0x1D4E4, 0, 0, 998, 1},
+	{0x1D4E5, 0, 0, 2415, 1},
+	{0x1D4E6, 0, 0, 999, 1},
+	{0x1D4E7, 0, 0, 2427, 1},
+	{0x1D4E8, 0, 0, 2782, 1},
+	{0x1D4E9, 0, 0, 2347, 1},
+	{0x1D4EA, 0, 0, 3, 1},
+	{0x1D4EB, 0, 0, 1003, 1},
+	{0x1D4EC, 0, 0, 1029, 1},
+	{0x1D4ED, 0, 0, 1004, 1},
+	{0x1D4EE, 0, 0, 1005, 1},
+	{0x1D4EF, 0, 0, 1032, 1},
+	{0x1D4F0, 0, 0, 1009, 1},
+	{0x1D4F1, 0, 0, 588, 1},
+	{0x1D4F2, 0, 0, 1026, 1},
+	{0x1D4F3, 0, 0, 590, 1},
+	{0x1D4F4, 0, 0, 1010, 1},
+	{0x1D4F5, 0, 0, 610, 1},
+	{0x1D4F6, 0, 0, 1011, 1},
+	{0x1D4F7, 0, 0, 2315, 1},
+	{0x1D4F8, 0, 0, 14, 1},
+	{0x1D4F9, 0, 0, 1016, 1},
+	{0x1D4FA, 0, 0, 2783, 1},
+	{0x1D4FB, 0, 0, 591, 1},
+	{0x1D4FC, 0, 0, 356, 1},
+	{0x1D4FD, 0, 0, 1017, 1},
+	{0x1D4FE, 0, 0, 1018, 1},
+	{0x1D4FF, 0, 0, 1021, 1},
+	{0x1D500, 0, 0, 595, 1},
+	{0x1D501, 0, 0, 611, 1},
+	{0x1D502, 0, 0, 596, 1},
+	{0x1D503, 0, 0, 1059, 1},
+	{0x1D504, 0, 0, 979, 1},
+	{0x1D505, 0, 0, 981, 1},
+	{0x1D507, 0, 0, 982, 1},
+	{0x1D508, 0, 0, 983, 1},
+	{0x1D509, 0, 0, 2349, 1},
+	{0x1D50A, 0, 0, 985, 1},
+	{0x1D50D, 0, 0, 988, 1},
+	{0x1D50E, 0, 0, 989, 1},
+	{0x1D50F, 0, 0, 990, 1},
+	{0x1D510, 0, 0, 991, 1},
+	{0x1D511, 0, 0, 992, 1},
+	{0x1D512, 0, 0, 993, 1},
+	{0x1D513, 0, 0, 995, 1},
+	{0x1D514, 0, 0, 2339, 1},
+	{0x1D516, 0, 0, 2781, 1},
+	{0x1D517, 0, 0, 997, 1},
+	{0x1D518, 0, 0, 998, 1},
+	{0x1D519, 0, 0, 2415, 1},
+	{0x1D51A, 0, 0, 999, 1},
+	{0x1D51B, 0, 0, 2427, 1},
+	{0x1D51C, 0, 0, 2782, 1},
+	{0x1D51E, 0, 0, 3, 1},
+	{0x1D51F, 0, 0, 1003, 1},
+	{0x1D520, 0, 0, 1029, 1},
+	{0x1D521, 0, 0, 1004, 1},
+	{0x1D522, 0, 0, 1005, 1},
+	{0x1D523, 0, 0, 1032, 1},
+	{0x1D524, 0, 0, 1009, 1},
+	{0x1D525, 0, 0, 588, 1},
+	{0x1D526, 0, 0, 1026, 1},
+	{0x1D527, 0, 0, 590, 1},
+	{0x1D528, 0, 0, 1010, 1},
+	{0x1D529, 0, 0, 610, 1},
+	{0x1D52A, 0, 0, 1011, 1},
+	{0x1D52B, 0, 0, 2315, 1},
+	{0x1D52C, 0, 0, 14, 1},
+	{0x1D52D, 0, 0, 1016, 1},
+	{0x1D52E, 0, 0, 2783, 1},
+	{0x1D52F, 0, 0, 591, 1},
+	{0x1D530, 0, 0, 356, 1},
+	{0x1D531, 0, 0, 1017, 1},
+	{0x1D532, 0, 0, 1018, 1},
+	{0x1D533, 0, 0, 1021, 1},
+	{0x1D534, 0, 0, 595, 1},
+	{0x1D535, 0, 0, 611, 1},
+	{0x1D536, 0, 0, 596, 1},
+	{0x1D537, 0, 0, 1059, 1},
+	{0x1D538, 0, 0, 979, 1},
+	{0x1D539, 0, 0, 981, 1},
+	{0x1D53B, 0, 0, 982, 1},
+	{0x1D53C, 0, 0, 983, 1},
+	{0x1D53D, 0, 0, 2349, 1},
+	{0x1D53E, 0, 0, 985, 1},
+	{0x1D540, 0, 0, 987, 1},
+	{0x1D541, 0, 0, 988, 1},
+	{0x1D542, 0, 0, 989, 1},
+	{0x1D543, 0, 0, 990, 1},
+	{0x1D544, 0, 0, 991, 1},
+	{0x1D546, 0, 0, 993, 1},
+	{0x1D54A, 0, 0, 2781, 1},
+	{0x1D54B, 0, 0, 997, 1},
+	{0x1D54C, 0, 0, 998, 1},
+	{0x1D54D, 0, 0, 2415, 1},
+	{0x1D54E, 0, 0, 999, 1},
+	{0x1D54F, 0, 0, 2427, 1},
+	{0x1D550, 0, 0, 2782, 1},
+	{0x1D552, 0, 0, 3, 1},
+	{0x1D553, 0, 0, 1003, 1},
+	{0x1D554, 0, 0, 1029, 1},
+	{0x1D555, 0, 0, 1004, 1},
+	{0x1D556, 0, 0, 1005, 1},
+	{0x1D557, 0, 0, 1032, 1},
+	{0x1D558, 0, 0, 1009, 1},
+	{0x1D559, 0, 0, 588, 1},
+	{0x1D55A, 0, 0, 1026, 1},
+	{0x1D55B, 0, 0, 590, 1},
+	{0x1D55C, 0, 0, 1010, 1},
+	{0x1D55D, 0, 0, 610, 1},
+	{0x1D55E, 0, 0, 1011, 1},
+	{0x1D55F, 0, 0, 2315, 1},
+	{0x1D560, 0, 0, 14, 1},
+	{0x1D561, 0, 0, 1016, 1},
+	{0x1D562, 0, 0, 2783, 1},
+	{0x1D563, 0, 0, 591, 1},
+	{0x1D564, 0, 0, 356, 1},
+	{0x1D565, 0, 0, 1017, 1},
+	{0x1D566, 0, 0, 1018, 1},
+	{0x1D567, 0, 0, 1021, 1},
+	{0x1D568, 0, 0, 595, 1},
+	{0x1D569, 0, 0, 611, 1},
+	{0x1D56A, 0, 0, 596, 1},
+	{0x1D56B, 0, 0, 1059, 1},
+	{0x1D56C, 0, 0, 979, 1},
+	{0x1D56D, 0, 0, 981, 1},
+	{0x1D56E, 0, 0, 2324, 1},
+	{0x1D56F, 0, 0, 982, 1},
+	{0x1D570, 0, 0, 983, 1},
+	{0x1D571, 0, 0, 2349, 1},
+	{0x1D572, 0, 0, 985, 1},
+	{0x1D573, 0, 0, 986, 1},
+	{0x1D574, 0, 0, 987, 1},
+	{0x1D575, 0, 0, 988, 1},
+	{0x1D576, 0, 0, 989, 1},
+	{0x1D577, 0, 0, 990, 1},
+	{0x1D578, 0, 0, 991, 1},
+	{0x1D579, 0, 0, 992, 1},
+	{0x1D57A, 0, 0, 993, 1},
+	{0x1D57B, 0, 0, 995, 1},
+	{0x1D57C, 0, 0, 2339, 1},
+	{0x1D57D, 0, 0, 996, 1},
+	{0x1D57E, 0, 0, 2781, 1},
+	{0x1D57F, 0, 0, 997, 1},
+	{0x1D580, 0, 0, 998, 1},
+	{0x1D581, 0, 0, 2415, 1},
+	{0x1D582, 0, 0, 999, 1},
+	{0x1D583, 0, 0, 2427, 1},
+	{0x1D584, 0, 0, 2782, 1},
+	{0x1D585, 0, 0, 2347, 1},
+	{0x1D586, 0, 0, 3, 1},
+	{0x1D587, 0, 0, 1003, 1},
+	{0x1D588, 0, 0, 1029, 1},
+	{0x1D589, 0, 0, 1004, 1},
+	{0x1D58A, 0, 0, 1005, 1},
+	{0x1D58B, 0, 0, 1032, 1},
+	{0x1D58C, 0, 0, 1009, 1},
+	{0x1D58D, 0, 0, 588, 1},
+	{0x1D58E, 0, 0, 1026, 1},
+	{0x1D58F, 0, 0, 590, 1},
+	{0x1D590, 0, 0, 1010, 1},
+	{0x1D591, 0, 0, 610, 1},
+	{0x1D592, 0, 0, 1011, 1},
+	{0x1D593, 0, 0, 2315, 1},
+	{0x1D594, 0, 0, 14, 1},
+	{0x1D595, 0, 0, 1016, 1},
+	{0x1D596, 0, 0, 2783, 1},
+	{0x1D597, 0, 0, 591, 1},
+	{0x1D598, 0, 0, 356, 1},
+	{0x1D599, 0, 0, 1017, 1},
+	{0x1D59A, 0, 0, 1018, 1},
+	{0x1D59B, 0, 0, 1021, 1},
+	{0x1D59C, 0, 0, 595, 1},
+	{0x1D59D, 0, 0, 611, 1},
+	{0x1D59E, 0, 0, 596, 1},
+	{0x1D59F, 0, 0, 1059, 1},
+	{0x1D5A0, 0, 0, 979, 1},
+	{0x1D5A1, 0, 0, 981, 1},
+	{0x1D5A2, 0, 0, 2324, 1},
+	{0x1D5A3, 0, 0, 982, 1},
+	{0x1D5A4, 0, 0, 983, 1},
+	{0x1D5A5, 0, 0, 2349, 1},
+	{0x1D5A6, 0, 0, 985, 1},
+	{0x1D5A7, 0, 0, 986, 1},
+	{0x1D5A8, 0, 0, 987, 1},
+	{0x1D5A9, 0, 0, 988, 1},
+	{0x1D5AA, 0, 0, 989, 1},
+	{0x1D5AB, 0, 0, 990, 1},
+	{0x1D5AC, 0, 0, 991, 1},
+	{0x1D5AD, 0, 0, 992, 1},
+	{0x1D5AE, 0, 0, 993, 1},
+	{0x1D5AF, 0, 0, 995, 1},
+	{0x1D5B0, 0, 0, 2339, 1},
+	{0x1D5B1, 0, 0, 996, 1},
+	{0x1D5B2, 0, 0, 2781, 1},
+	{0x1D5B3, 0, 0, 997, 1},
+	{0x1D5B4, 0, 0, 998, 1},
+	{0x1D5B5, 0, 0, 2415, 1},
+	{0x1D5B6, 0, 0, 999, 1},
+	{0x1D5B7, 0, 0, 2427, 1},
+	{0x1D5B8, 0, 0, 2782, 1},
+	{0x1D5B9, 0, 0, 2347, 1},
+	{0x1D5BA, 0, 0, 3, 1},
+	{0x1D5BB, 0, 0, 1003, 1},
+	{0x1D5BC, 0, 0, 1029, 1},
+	{0x1D5BD, 0, 0, 1004, 1},
+	{0x1D5BE, 0, 0, 1005, 1},
+	{0x1D5BF, 0, 0, 1032, 1},
+	{0x1D5C0, 0, 0, 1009, 1},
+	{0x1D5C1, 0, 0, 588, 1},
+	{0x1D5C2, 0, 0, 1026, 1},
+	{0x1D5C3, 0, 0, 590, 1},
+	{0x1D5C4, 0, 0, 1010, 1},
+	{0x1D5C5, 0, 0, 610, 1},
+	{0x1D5C6, 0, 0, 1011, 1},
+	{0x1D5C7, 0, 0, 2315, 1},
+	{0x1D5C8, 0, 0, 14, 1},
+	{0x1D5C9, 0, 0, 1016, 1},
+	{0x1D5CA, 0, 0, 2783, 1},
+	{0x1D5CB, 0, 0, 591, 1},
+	{0x1D5CC, 0, 0, 356, 1},
+	{0x1D5CD, 0, 0, 1017, 1},
+	{0x1D5CE, 0, 0, 1018, 1},
+	{0x1D5CF, 0, 0, 1021, 1},
+	{0x1D5D0, 0, 0, 595, 1},
+	{0x1D5D1, 0, 0, 611, 1},
+	{0x1D5D2, 0, 0, 596, 1},
+	{0x1D5D3, 0, 0, 1059, 1},
+	{0x1D5D4, 0, 0, 979, 1},
+	{0x1D5D5, 0, 0, 981, 1},
+	{0x1D5D6, 0, 0, 2324, 1},
+	{0x1D5D7, 0, 0, 982, 1},
+	{0x1D5D8, 0, 0, 983, 1},
+	{0x1D5D9, 0, 0, 2349, 1},
+	{0x1D5DA, 0, 0, 985, 1},
+	{0x1D5DB, 0, 0, 986, 1},
+	{0x1D5DC, 0, 0, 987, 1},
+	{0x1D5DD, 0, 0, 988, 1},
+	{0x1D5DE, 0, 0, 989, 1},
+	{0x1D5DF, 0, 0, 990, 1},
+	{0x1D5E0, 0, 0, 991, 1},
+	{0x1D5E1, 0, 0, 992, 1},
+	{0x1D5E2, 0, 0, 993, 1},
+	{0x1D5E3, 0, 0, 995, 1},
+	{0x1D5E4, 0, 0, 2339, 1},
+	{0x1D5E5, 0, 0, 996, 1},
+	{0x1D5E6, 0, 0, 2781, 1},
+	{0x1D5E7, 0, 0, 997, 1},
+	{0x1D5E8, 0, 0, 998, 1},
+	{0x1D5E9, 0, 0, 2415, 1},
+	{0x1D5EA, 0, 0, 999, 1},
+	{0x1D5EB, 0, 0, 2427, 1},
+	{0x1D5EC, 0, 0, 2782, 1},
+	{0x1D5ED, 0, 0, 2347, 1},
+	{0x1D5EE, 0, 0, 3, 1},
+	{0x1D5EF, 0, 0, 1003, 1},
+	{0x1D5F0, 0, 0, 1029, 1},
+	{0x1D5F1, 0, 0, 1004, 1},
+	{0x1D5F2, 0, 0, 1005, 1},
+	{0x1D5F3, 0, 0, 1032, 1},
+	{0x1D5F4, 0, 0, 1009, 1},
+	{0x1D5F5, 0, 0, 588, 1},
+	{0x1D5F6, 0, 0, 1026, 1},
+	{0x1D5F7, 0, 0, 590, 1},
+	{0x1D5F8, 0, 0, 1010, 1},
+	{0x1D5F9, 0, 0, 610, 1},
+	{0x1D5FA, 0, 0, 1011, 1},
+	{0x1D5FB, 0, 0, 2315, 1},
+	{0x1D5FC, 0, 0, 14, 1},
+	{0x1D5FD, 0, 0, 1016, 1},
+	{0x1D5FE, 0, 0, 2783, 1},
+	{0x1D5FF, 0, 0, 591, 1},
+	{0x1D600, 0, 0, 356, 1},
+	{0x1D601, 0, 0, 1017, 1},
+	{0x1D602, 0, 0, 1018, 1},
+	{0x1D603, 0, 0, 1021, 1},
+	{0x1D604, 0, 0, 595, 1},
+	{0x1D605, 0, 0, 611, 1},
+	{0x1D606, 0, 0, 596, 1},
+	{0x1D607, 0, 0, 1059, 1},
+	{0x1D608, 0, 0, 979, 1},
+	{0x1D609, 0, 0, 981, 1},
+	{0x1D60A, 0, 0, 2324, 1},
+	{0x1D60B, 0, 0, 982, 1},
+	{0x1D60C, 0, 0, 983, 1},
+	{0x1D60D, 0, 0, 2349, 1},
+	{0x1D60E, 0, 0, 985, 1},
+	{0x1D60F, 0, 0, 986, 1},
+	{0x1D610, 0, 0, 987, 1},
+	{0x1D611, 0, 0, 988, 1},
+	{0x1D612, 0, 0, 989, 1},
+	{0x1D613, 0, 0, 990, 1},
+	{0x1D614, 0, 0, 991, 1},
+	{0x1D615, 0, 0, 992, 1},
+	{0x1D616, 0, 0, 993, 1},
+	{0x1D617, 0, 0, 995, 1},
+	{0x1D618, 0, 0, 2339, 1},
+	{0x1D619, 0, 0, 996, 1},
+	{0x1D61A, 0, 0, 2781, 1},
+	{0x1D61B, 0, 0, 997, 1},
+	{0x1D61C, 0, 0, 998, 1},
+	{0x1D61D, 0, 0, 2415, 1},
+	{0x1D61E, 0, 0, 999, 1},
+	{0x1D61F, 0, 0, 2427, 1},
+	{0x1D620, 0, 0, 2782, 1},
+	{0x1D621, 0, 0, 2347, 1},
+	{0x1D622, 0, 0, 3, 1},
+	{0x1D623, 0, 0, 1003, 1},
+	{0x1D624, 0, 0, 1029, 1},
+	{0x1D625, 0, 0, 1004, 1},
+	{0x1D626, 0, 0, 1005, 1},
+	{0x1D627, 0, 0, 1032, 1},
+	{0x1D628, 0, 0, 1009, 1},
+	{0x1D629, 0, 0, 588, 1},
+	{0x1D62A, 0, 0, 1026, 1},
+	{0x1D62B, 0, 0, 590, 1},
+	{0x1D62C, 0, 0, 1010, 1},
+	{0x1D62D, 0, 0, 610, 1},
+	{0x1D62E, 0, 0, 1011, 1},
+	{0x1D62F, 0, 0, 2315, 1},
+	{0x1D630, 0, 0, 14, 1},
+	{0x1D631, 0, 0, 1016, 1},
+	{0x1D632, 0, 0, 2783, 1},
+	{0x1D633, 0, 0, 591, 1},
+	{0x1D634, 0, 0, 356, 1},
+	{0x1D635, 0, 0, 1017, 1},
+	{0x1D636, 0, 0, 1018, 1},
+	{0x1D637, 0, 0, 1021, 1},
+	{0x1D638, 0, 0, 595, 1},
+	{0x1D639, 0, 0, 611, 1},
+	{0x1D63A, 0, 0, 596, 1},
+	{0x1D63B, 0, 0, 1059, 1},
+	{0x1D63C, 0, 0, 979, 1},
+	{0x1D63D, 0, 0, 981, 1},
+	{0x1D63E, 0, 0, 2324, 1},
+	{0x1D63F, 0, 0, 982, 1},
+	{0x1D640, 0, 0, 983, 1},
+	{0x1D641, 0, 0, 2349, 1},
+	{0x1D642, 0, 0, 985, 1},
+	{0x1D643, 0, 0, 986, 1},
+	{0x1D644, 0, 0, 987, 1},
+	{0x1D645, 0, 0, 988, 1},
+	{0x1D646, 0, 0, 989, 1},
+	{0x1D647, 0, 0, 990, 1},
+	{0x1D648, 0, 0, 991, 1},
+	{0x1D649, 0, 0, 992, 1},
+	{0x1D64A, 0, 0, 993, 1},
+	{0x1D64B, 0, 0, 995, 1},
+	{0x1D64C, 0, 0, 2339, 1},
+	{0x1D64D, 0, 0, 996, 1},
+	{0x1D64E, 0, 0, 2781, 1},
+	{0x1D64F, 0, 0, 997, 1},
+	{0x1D650, 0, 0, 998, 1},
+	{0x1D651, 0, 0, 2415, 1},
+	{0x1D652, 0, 0, 999, 1},
+	{0x1D653, 0, 0, 2427, 1},
+	{0x1D654, 0, 0, 2782, 1},
+	{0x1D655, 0, 0, 2347, 1},
+	{0x1D656, 0, 0, 3, 1},
+	{0x1D657, 0, 0, 1003, 1},
+	{0x1D658, 0, 0, 1029, 1},
+	{0x1D659, 0, 0, 1004, 1},
+	{0x1D65A, 0, 0, 1005, 1},
+	{0x1D65B, 0, 0, 1032, 1},
+	{0x1D65C, 0, 0, 1009, 1},
+	{0x1D65D, 0, 0, 588, 1},
+	{0x1D65E, 0, 0, 1026, 1},
+	{0x1D65F, 0, 0, 590, 1},
+	{0x1D660, 0, 0, 1010, 1},
+	{0x1D661, 0, 0, 610, 1},
+	{0x1D662, 0, 0, 1011, 1},
+	{0x1D663, 0, 0, 2315, 1},
+	{0x1D664, 0, 0, 14, 1},
+	{0x1D665, 0, 0, 1016, 1},
+	{0x1D666, 0, 0, 2783, 1},
+	{0x1D667, 0, 0, 591, 1},
+	{0x1D668, 0, 0, 356, 1},
+	{0x1D669, 0, 0, 1017, 1},
+	{0x1D66A, 0, 0, 1018, 1},
+	{0x1D66B, 0, 0, 1021, 1},
+	{0x1D66C, 0, 0, 595, 1},
+	{0x1D66D, 0, 0, 611, 1},
+	{0x1D66E, 0, 0, 596, 1},
+	{0x1D66F, 0, 0, 1059, 1},
+	{0x1D670, 0, 0, 979, 1},
+	{0x1D671, 0, 0, 981, 1},
+	{0x1D672, 0, 0, 2324, 1},
+	{0x1D673, 0, 0, 982, 1},
+	{0x1D674, 0, 0, 983, 1},
+	{0x1D675, 0, 0, 2349, 1},
+	{0x1D676, 0, 0, 985, 1},
+	{0x1D677, 0, 0, 986, 1},
+	{0x1D678, 0, 0, 987, 1},
+	{0x1D679, 0, 0, 988, 1},
+	{0x1D67A, 0, 0, 989, 1},
+	{0x1D67B, 0, 0, 990, 1},
+	{0x1D67C, 0, 0, 991, 1},
+	{0x1D67D, 0, 0, 992, 1},
+	{0x1D67E, 0, 0, 993, 1},
+	{0x1D67F, 0, 0, 995, 1},
+	{0x1D680, 0, 0, 2339, 1},
+	{0x1D681, 0, 0, 996, 1},
+	{0x1D682, 0, 0, 2781, 1},
+	{0x1D683, 0, 0, 997, 1},
+	{0x1D684, 0, 0, 998, 1},
+	{0x1D685, 0, 0, 2415, 1},
+	{0x1D686, 0, 0, 999, 1},
+	{0x1D687, 0, 0, 2427, 1},
+	{0x1D688, 0, 0, 2782, 1},
+	{0x1D689, 0, 0, 2347, 1},
+	{0x1D68A, 0, 0, 3, 1},
+	{0x1D68B, 0, 0, 1003, 1},
+	{0x1D68C, 0, 0, 1029, 1},
+	{0x1D68D, 0, 0, 1004, 1},
+	{0x1D68E, 0, 0, 1005, 1},
+	{0x1D68F, 0, 0, 1032, 1},
+	{0x1D690, 0, 0, 1009, 1},
+	{0x1D691, 0, 0, 588, 1},
+	{0x1D692, 0, 0, 1026, 1},
+	{0x1D693, 0, 0, 590, 1},
+	{0x1D694, 0, 0, 1010, 1},
+	{0x1D695, 0, 0, 610, 1},
+	{0x1D696, 0, 0, 1011, 1},
+	{0x1D697, 0, 0, 2315, 1},
+	{0x1D698, 0, 0, 14, 1},
+	{0x1D699, 0, 0, 1016, 1},
+	{0x1D69A, 0, 0, 2783, 1},
+	{0x1D69B, 0, 0, 591, 1},
+	{0x1D69C, 0, 0, 356, 1},
+	{0x1D69D, 0, 0, 1017, 1},
+	{0x1D69E, 0, 0, 1018, 1},
+	{0x1D69F, 0, 0, 1021, 1},
+	{0x1D6A0, 0, 0, 595, 1},
+	{0x1D6A1, 0, 0, 611, 1},
+	{0x1D6A2, 0, 0, 596, 1},
+	{0x1D6A3, 0, 0, 1059, 1},
+	{0x1D6A4, 0, 0, 6030, 1},
+	{0x1D6A5, 0, 0, 6031, 1},
+	{0x1D6A8, 0, 0, 6032, 1},
+	{0x1D6A9, 0, 0, 6033, 1},
+	{0x1D6AA, 0, 0, 2357, 1},
+	{0x1D6AB, 0, 0, 6034, 1},
+	{0x1D6AC, 0, 0, 6035, 1},
+	{0x1D6AD, 0, 0, 6036, 1},
+	{0x1D6AE, 0, 0, 6037, 1},
+	{0x1D6AF, 0, 0, 682, 1},
+	{0x1D6B0, 0, 0, 6038, 1},
+	{0x1D6B1, 0, 0, 6039, 1},
+	{0x1D6B2, 0, 0, 6040, 1},
+	{0x1D6B3, 0, 0, 6041, 1},
+	{0x1D6B4, 0, 0, 6042, 1},
+	{0x1D6B5, 0, 0, 6043, 1},
+	{0x1D6B6, 0, 0, 6044, 1},
+	{0x1D6B7, 0, 0, 2358, 1},
+	{0x1D6B8, 0, 0, 6045, 1},
+	{0x1D6B9, 0, 0, 682, 1},
+	{0x1D6BA, 0, 0, 684, 1},
+	{0x1D6BB, 0, 0, 6046, 1},
+	{0x1D6BC, 0, 0, 672, 1},
+	{0x1D6BD, 0, 0, 6047, 1},
+	{0x1D6BE, 0, 0, 6048, 1},
+	{0x1D6BF, 0, 0, 6049, 1},
+	{0x1D6C0, 0, 0, 2348, 1},
+	{0x1D6C1, 0, 0, 6050, 1},
+	{0x1D6C2, 0, 0, 6051, 1},
+	{0x1D6C3, 0, 0, 670, 1},
+	{0x1D6C4, 0, 0, 1023, 1},
+	{0x1D6C5, 0, 0, 1024, 1},
+	{0x1D6C6, 0, 0, 683, 1},
+	{0x1D6C7, 0, 0, 6052, 1},
+	{0x1D6C8, 0, 0, 6053, 1},
+	{0x1D6C9, 0, 0, 671, 1},
+	{0x1D6CA, 0, 0, 2141, 1},
+	{0x1D6CB, 0, 0, 679, 1},
+	{0x1D6CC, 0, 0, 6054, 1},
+	{0x1D6CD, 0, 0, 10, 1},
+	{0x1D6CE, 0, 0, 6055, 1},
+	{0x1D6CF, 0, 0, 6056, 1},
+	{0x1D6D0, 0, 0, 6057, 1},
+	{0x1D6D1, 0, 0, 678, 1},
+	{0x1D6D2, 0, 0, 680, 1},
+	{0x1D6D3, 0, 0, 681, 1},
+	{0x1D6D4, 0, 0, 6058, 1},
+	{0x1D6D5, 0, 0, 6059, 1},
+	{0x1D6D6, 0, 0, 6060, 1},
+	{0x1D6D7, 0, 0, 677, 1},
+	{0x1D6D8, 0, 0, 1025, 1},
+	{0x1D6D9, 0, 0, 6061, 1},
+	{0x1D6DA, 0, 0, 6062, 1},
+	{0x1D6DB, 0, 0, 6063, 1},
+	{0x1D6DC, 0, 0, 683, 1},
+	{0x1D6DD, 0, 0, 671, 1},
+	{0x1D6DE, 0, 0, 679, 1},
+	{0x1D6DF, 0, 0, 677, 1},
+	{0x1D6E0, 0, 0, 680, 1},
+	{0x1D6E1, 0, 0, 678, 1},
+	{0x1D6E2, 0, 0, 6032, 1},
+	{0x1D6E3, 0, 0, 6033, 1},
+	{0x1D6E4, 0, 0, 2357, 1},
+	{0x1D6E5, 0, 0, 6034, 1},
+	{0x1D6E6, 0, 0, 6035, 1},
+	{0x1D6E7, 0, 0, 6036, 1},
+	{0x1D6E8, 0, 0, 6037, 1},
+	{0x1D6E9, 0, 0, 682, 1},
+	{0x1D6EA, 0, 0, 6038, 1},
+	{0x1D6EB, 0, 0, 6039, 1},
+	{0x1D6EC, 0, 0, 6040, 1},
+	{0x1D6ED, 0, 0, 6041, 1},
+	{0x1D6EE, 0, 0, 6042, 1},
+	{0x1D6EF, 0, 0, 6043, 1},
+	{0x1D6F0, 0, 0, 6044, 1},
+	{0x1D6F1, 0, 0, 2358, 1},
+	{0x1D6F2, 0, 0, 6045, 1},
+	{0x1D6F3, 0, 0, 682, 1},
+	{0x1D6F4, 0, 0, 684, 1},
+	{0x1D6F5, 0, 0, 6046, 1},
+	{0x1D6F6, 0, 0, 672, 1},
+	{0x1D6F7, 0, 0, 6047, 1},
+	{0x1D6F8, 0, 0, 6048, 1},
+	{0x1D6F9, 0, 0, 6049, 1},
+	{0x1D6FA, 0, 0, 2348, 1},
+	{0x1D6FB, 0, 0, 6050, 1},
+	{0x1D6FC, 0, 0, 6051, 1},
+	{0x1D6FD, 0, 0, 670, 1},
+	{0x1D6FE, 0, 0, 1023, 1},
+	{0x1D6FF, 0, 0, 1024, 1},
+	{0x1D700, 0, 0, 683, 1},
+	{0x1D701, 0, 0, 6052, 1},
+	{0x1D702, 0, 0, 6053, 1},
+	{0x1D703, 0, 0, 671, 1},
+	{0x1D704, 0, 0, 2141, 1},
+	{0x1D705, 0, 0, 679, 1},
+	{0x1D706, 0, 0, 6054, 1},
+	{0x1D707, 0, 0, 10, 1},
+	{0x1D708, 0, 0, 6055, 1},
+	{0x1D709, 0, 0, 6056, 1},
+	{0x1D70A, 0, 0, 6057, 1},
+	{0x1D70B, 0, 0, 678, 1},
+	{0x1D70C, 0, 0, 680, 1},
+	{0x1D70D, 0, 0, 681, 1},
+	{0x1D70E, 0, 0, 6058, 1},
+	{0x1D70F, 0, 0, 6059, 1},
+	{0x1D710, 0, 0, 6060, 1},
+	{0x1D711, 0, 0, 677, 1},
+	{0x1D712, 0, 0, 1025, 1},
+	{0x1D713, 0, 0, 6061, 1},
+	{0x1D714, 0, 0, 6062, 1},
+	{0x1D715, 0, 0, 6063, 1},
+	{0x1D716, 0, 0, 683, 1},
+	{0x1D717, 0, 0, 671, 1},
+	{0x1D718, 0, 0, 679, 1},
+	{0x1D719, 0, 0, 677, 1},
+	{0x1D71A, 0, 0, 680, 1},
+	{0x1D71B, 0, 0, 678, 1},
+	{0x1D71C, 0, 0, 6032, 1},
+	{0x1D71D, 0, 0, 6033, 1},
+	{0x1D71E, 0, 0, 2357, 1},
+	{0x1D71F, 0, 0, 6034, 1},
+	{0x1D720, 0, 0, 6035, 1},
+	{0x1D721, 0, 0, 6036, 1},
+	{0x1D722, 0, 0, 6037, 1},
+	{0x1D723, 0, 0, 682, 1},
+	{0x1D724, 0, 0, 6038, 1},
+	{0x1D725, 0, 0, 6039, 1},
+	{0x1D726, 0, 0, 6040, 1},
+	{0x1D727, 0, 0, 6041, 1},
+	{0x1D728, 0, 0, 6042, 1},
+	{0x1D729, 0, 0, 6043, 1},
+	{0x1D72A, 0, 0, 6044, 1},
+	{0x1D72B, 0, 0, 2358, 1},
+	{0x1D72C, 0, 0, 6045, 1},
+	{0x1D72D, 0, 0, 682, 1},
+	{0x1D72E, 0, 0, 684, 1},
+	{0x1D72F, 0, 0, 6046, 1},
+	{0x1D730, 0, 0, 672, 1},
+	{0x1D731, 0, 0, 6047, 1},
+	{0x1D732, 0, 0, 6048, 1},
+	{0x1D733, 0, 0, 6049, 1},
+	{0x1D734, 0, 0, 2348, 1},
+	{0x1D735, 0, 0, 6050, 1},
+	{0x1D736, 0, 0, 6051, 1},
+	{0x1D737, 0, 0, 670, 1},
+	{0x1D738, 0, 0, 1023, 1},
+	{0x1D739, 0, 0, 1024, 1},
+	{0x1D73A, 0, 0, 683, 1},
+	{0x1D73B, 0, 0, 6052, 1},
+	{0x1D73C, 0, 0, 6053, 1},
+	{0x1D73D, 0, 0, 671, 1},
+	{0x1D73E, 0, 0, 2141, 1},
+	{0x1D73F, 0, 0, 679, 1},
+	{0x1D740, 0, 0, 6054, 1},
+	{0x1D741, 0, 0, 10, 1},
+	{0x1D742, 0, 0, 6055, 1},
+	{0x1D743, 0, 0, 6056, 1},
+	{0x1D744, 0, 0, 6057, 1},
+	{0x1D745, 0, 0, 678, 1},
+	{0x1D746, 0, 0, 680, 1},
+	{0x1D747, 0, 0, 681, 1},
+	{0x1D748, 0, 0, 6058, 1},
+	{0x1D749, 0, 0, 6059, 1},
+	{0x1D74A, 0, 0, 6060, 1},
+	{0x1D74B, 0, 0, 677, 1},
+	{0x1D74C, 0, 0, 1025, 1},
+	{0x1D74D, 0, 0, 6061, 1},
+	{0x1D74E, 0, 0, 6062, 1},
+	{0x1D74F, 0, 0, 6063, 1},
+	{0x1D750, 0, 0, 683, 1},
+	{0x1D751, 0, 0, 671, 1},
+	{0x1D752, 0, 0, 679, 1},
+	{0x1D753, 0, 0, 677, 1},
+	{0x1D754, 0, 0, 680, 1},
+	{0x1D755, 0, 0, 678, 1},
+	{0x1D756, 0, 0, 6032, 1},
+	{0x1D757, 0, 0, 6033, 1},
+	{0x1D758, 0, 0, 2357, 1},
+	{0x1D759, 0, 0, 6034, 1},
+	{0x1D75A, 0, 0, 6035, 1},
+	{0x1D75B, 0, 0, 6036, 1},
+	{0x1D75C, 0, 0, 6037, 1},
+	{0x1D75D, 0, 0, 682, 1},
+	{0x1D75E, 0, 0, 6038, 1},
+	{0x1D75F, 0, 0, 6039, 1},
+	{0x1D760, 0, 0, 6040, 1},
+	{0x1D761, 0, 0, 6041, 1},
+	{0x1D762, 0, 0, 6042, 1},
+	{0x1D763, 0, 0, 6043, 1},
+	{0x1D764, 0, 0, 6044, 1},
+	{0x1D765, 0, 0, 2358, 1},
+	{0x1D766, 0, 0, 6045, 1},
+	{0x1D767, 0, 0, 682, 1},
+	{0x1D768, 0, 0, 684, 1},
+	{0x1D769, 0, 0, 6046, 1},
+	{0x1D76A, 0, 0, 672, 1},
+	{0x1D76B, 0, 0, 6047, 1},
+	{0x1D76C, 0, 0, 6048, 1},
+	{0x1D76D, 0, 0, 6049, 1},
+	{0x1D76E, 0, 0, 2348, 1},
+	{0x1D76F, 0, 0, 6050, 1},
+	{0x1D770, 0, 0, 6051, 1},
+	{0x1D771, 0, 0, 670, 1},
+	{0x1D772, 0, 0, 1023, 1},
+	{0x1D773, 0, 0, 1024, 1},
+	{0x1D774, 0, 0, 683, 1},
+	{0x1D775, 0, 0, 6052, 1},
+	{0x1D776, 0, 0, 6053, 1},
+	{0x1D777, 0, 0, 671, 1},
+	{0x1D778, 0, 0, 2141, 1},
+	{0x1D779, 0, 0, 679, 1},
+	{0x1D77A, 0, 0, 6054, 1},
+	{0x1D77B, 0, 0, 10, 1},
+	{0x1D77C, 0, 0, 6055, 1},
+	{0x1D77D, 0, 0, 6056, 1},
+	{0x1D77E, 0, 0, 6057, 1},
+	{0x1D77F, 0, 0, 678, 1},
+	{0x1D780, 0, 0, 680, 1},
+	{0x1D781, 0, 0, 681, 1},
+	{0x1D782, 0, 0, 6058, 1},
+	{0x1D783, 0, 0, 6059, 1},
+	{0x1D784, 0, 0, 6060, 1},
+	{0x1D785, 0, 0, 677, 1},
+	{0x1D786, 0, 0, 1025, 1},
+	{0x1D787, 0, 0, 6061, 1},
+	{0x1D788, 0, 0, 6062, 1},
+	{0x1D789, 0, 0, 6063, 1},
+	{0x1D78A, 0, 0, 683, 1},
+	{0x1D78B, 0, 0, 671, 1},
+	{0x1D78C, 0, 0, 679, 1},
+	{0x1D78D, 0, 0, 677, 1},
+	{0x1D78E, 0, 0, 680, 1},
+	{0x1D78F, 0, 0, 678, 1},
+	{0x1D790, 0, 0, 6032, 1},
+	{0x1D791, 0, 0, 6033, 1},
+	{0x1D792, 0, 0, 2357, 1},
+	{0x1D793, 0, 0, 6034, 1},
+	{0x1D794, 0, 0, 6035, 1},
+	{0x1D795, 0, 0, 6036, 1},
+	{0x1D796, 0, 0, 6037, 1},
+	{0x1D797, 0, 0, 682, 1},
+	{0x1D798, 0, 0, 6038, 1},
+	{0x1D799, 0, 0, 6039, 1},
+	{0x1D79A, 0, 0, 6040, 1},
+	{0x1D79B, 0, 0, 6041, 1},
+	{0x1D79C, 0, 0, 6042, 1},
+	{0x1D79D, 0, 0, 6043, 1},
+	{0x1D79E, 0, 0, 6044, 1},
+	{0x1D79F, 0, 0, 2358, 1},
+	{0x1D7A0, 0, 0, 6045, 1},
+	{0x1D7A1, 0, 0, 682, 1},
+	{0x1D7A2, 0, 0, 684, 1},
+	{0x1D7A3, 0, 0, 6046, 1},
+	{0x1D7A4, 0, 0, 672, 1},
+	{0x1D7A5, 0, 0, 6047, 1},
+	{0x1D7A6, 0, 0, 6048, 1},
+	{0x1D7A7, 0, 0, 6049, 1},
+	{0x1D7A8, 0, 0, 2348, 1},
+	{0x1D7A9, 0, 0, 6050, 1},
+	{0x1D7AA, 0, 0, 6051, 1},
+	{0x1D7AB, 0, 0, 670, 1},
+	{0x1D7AC, 0, 0, 1023, 1},
+	{0x1D7AD, 0, 0, 1024, 1},
+	{0x1D7AE, 0, 0, 683, 1},
+	{0x1D7AF, 0, 0, 6052, 1},
+	{0x1D7B0, 0, 0, 6053, 1},
+	{0x1D7B1, 0, 0, 671, 1},
+	{0x1D7B2, 0, 0, 2141, 1},
+	{0x1D7B3, 0, 0, 679, 1},
+	{0x1D7B4, 0, 0, 6054, 1},
+	{0x1D7B5, 0, 0, 10, 1},
+	{0x1D7B6, 0, 0, 6055, 1},
+	{0x1D7B7, 0, 0, 6056, 1},
+	{0x1D7B8, 0, 0, 6057, 1},
+	{0x1D7B9, 0, 0, 678, 1},
+	{0x1D7BA, 0, 0, 680, 1},
+	{0x1D7BB, 0, 0, 681, 1},
+	{0x1D7BC, 0, 0, 6058, 1},
+	{0x1D7BD, 0, 0, 6059, 1},
+	{0x1D7BE, 0, 0, 6060, 1},
+	{0x1D7BF, 0, 0, 677, 1},
+	{0x1D7C0, 0, 0, 1025, 1},
+	{0x1D7C1, 0, 0, 6061, 1},
+	{0x1D7C2, 0, 0, 6062, 1},
+	{0x1D7C3, 0, 0, 6063, 1},
+	{0x1D7C4, 0, 0, 683, 1},
+	{0x1D7C5, 0, 0, 671, 1},
+	{0x1D7C6, 0, 0, 679, 1},
+	{0x1D7C7, 0, 0, 677, 1},
+	{0x1D7C8, 0, 0, 680, 1},
+	{0x1D7C9, 0, 0, 678, 1},
+	{0x1D7CA, 0, 0, 6064, 1},
+	{0x1D7CB, 0, 0, 6065, 1},
+	{0x1D7CE, 0, 0, 2303, 1},
+	{0x1D7CF, 0, 0, 13, 1},
+	{0x1D7D0, 0, 0, 6, 1},
+	{0x1D7D1, 0, 0, 7, 1},
+	{0x1D7D2, 0, 0, 2304, 1},
+	{0x1D7D3, 0, 0, 2305, 1},
+	{0x1D7D4, 0, 0, 2306, 1},
+	{0x1D7D5, 0, 0, 2307, 1},
+	{0x1D7D6, 0, 0, 2308, 1},
+	{0x1D7D7, 0, 0, 2309, 1},
+	{0x1D7D8, 0, 0, 2303, 1},
+	{0x1D7D9, 0, 0, 13, 1},
+	{0x1D7DA, 0, 0, 6, 1},
+	{0x1D7DB, 0, 0, 7, 1},
+	{0x1D7DC, 0, 0, 2304, 1},
+	{0x1D7DD, 0, 0, 2305, 1},
+	{0x1D7DE, 0, 0, 2306, 1},
+	{0x1D7DF, 0, 0, 2307, 1},
+	{0x1D7E0, 0, 0, 2308, 1},
+	{0x1D7E1, 0, 0, 2309, 1},
+	{0x1D7E2, 0, 0, 2303, 1},
+	{0x1D7E3, 0, 0, 13, 1},
+	{0x1D7E4, 0, 0, 6, 1},
+	{0x1D7E5, 0, 0, 7, 1},
+	{0x1D7E6, 0, 0, 2304, 1},
+	{0x1D7E7, 0, 0, 2305, 1},
+	{0x1D7E8, 0, 0, 2306, 1},
+	{0x1D7E9, 0, 0, 2307, 1},
+	{0x1D7EA, 0, 0, 2308, 1},
+	{0x1D7EB, 0, 0, 2309, 1},
+	{0x1D7EC, 0, 0, 2303, 1},
+	{0x1D7ED, 0, 0, 13, 1},
+	{0x1D7EE, 0, 0, 6, 1},
+	{0x1D7EF, 0, 0, 7, 1},
+	{0x1D7F0, 0, 0, 2304, 1},
+	{0x1D7F1, 0, 0, 2305, 1},
+	{0x1D7F2, 0, 0, 2306, 1},
+	{0x1D7F3, 0, 0, 2307, 1},
+	{0x1D7F4, 0, 0, 2308, 1},
+	{0x1D7F5, 0, 0, 2309, 1},
+	{0x1D7F6, 0, 0, 2303, 1},
+	{0x1D7F7, 0, 0, 13, 1},
+	{0x1D7F8, 0, 0, 6, 1},
+	{0x1D7F9, 0, 0, 7, 1},
+	{0x1D7FA, 0, 0, 2304, 1},
+	{0x1D7FB, 0, 0, 2305, 1},
+	{0x1D7FC, 0, 0, 2306, 1},
+	{0x1D7FD, 0, 0, 2307, 1},
+	{0x1D7FE, 0, 0, 2308, 1},
+	{0x1D7FF, 0, 0, 2309, 1},
+	{0x1E030, 0, 0, 6066, 1},
+	{0x1E031, 0, 0, 6067, 1},
+	{0x1E032, 0, 0, 6068, 1},
+	{0x1E033, 0, 0, 6069, 1},
+	{0x1E034, 0, 0, 6070, 1},
+	{0x1E035, 0, 0, 6071, 1},
+	{0x1E036, 0, 0, 6072, 1},
+	{0x1E037, 0, 0, 6073, 1},
+	{0x1E038, 0, 0, 6074, 1},
+	{0x1E039, 0, 0, 6075, 1},
+	{0x1E03A, 0, 0, 6076, 1},
+	{0x1E03B, 0, 0, 6077, 1},
+	{0x1E03C, 0, 0, 6078, 1},
+	{0x1E03D, 0, 0, 6079, 1},
+	{0x1E03E, 0, 0, 6080, 1},
+	{0x1E03F, 0, 0, 6081, 1},
+	{0x1E040, 0, 0, 6082, 1},
+	{0x1E041, 0, 0, 6083, 1},
+	{0x1E042, 0, 0, 6084, 1},
+	{0x1E043, 0, 0, 6085, 1},
+	{0x1E044, 0, 0, 6086, 1},
+	{0x1E045, 0, 0, 6087, 1},
+	{0x1E046, 0, 0, 6088, 1},
+	{0x1E047, 0, 0, 6089, 1},
+	{0x1E048, 0, 0, 6090, 1},
+	{0x1E049, 0, 0, 6091, 1},
+	{0x1E04A, 0, 0, 6092, 1},
+	{0x1E04B, 0, 0, 6093, 1},
+	{0x1E04C, 0, 0, 6094, 1},
+	{0x1E04D, 0, 0, 6095, 1},
+	{0x1E04E, 0, 0, 6096, 1},
+	{0x1E04F, 0, 0, 6097, 1},
+	{0x1E050, 0, 0, 6098, 1},
+	{0x1E051, 0, 0, 6066, 1},
+	{0x1E052, 0, 0, 6067, 1},
+	{0x1E053, 0, 0, 6068, 1},
+	{0x1E054, 0, 0, 6069, 1},
+	{0x1E055, 0, 0, 6070, 1},
+	{0x1E056, 0, 0, 6071, 1},
+	{0x1E057, 0, 0, 6072, 1},
+	{0x1E058, 0, 0, 6073, 1},
+	{0x1E059, 0, 0, 6074, 1},
+	{0x1E05A, 0, 0, 6075, 1},
+	{0x1E05B, 0, 0, 6076, 1},
+	{0x1E05C, 0, 0, 6078, 1},
+	{0x1E05D, 0, 0, 6079, 1},
+	{0x1E05E, 0, 0, 6081, 1},
+	{0x1E05F, 0, 0, 6083, 1},
+	{0x1E060, 0, 0, 6084, 1},
+	{0x1E061, 0, 0, 6085, 1},
+	{0x1E062, 0, 0, 6086, 1},
+	{0x1E063, 0, 0, 6087, 1},
+	{0x1E064, 0, 0, 6088, 1},
+	{0x1E065, 0, 0, 4460, 1},
+	{0x1E066, 0, 0, 6089, 1},
+	{0x1E067, 0, 0, 6099, 1},
+	{0x1E068, 0, 0, 6094, 1},
+	{0x1E069, 0, 0, 6100, 1},
+	{0x1E06A, 0, 0, 6101, 1},
+	{0x1E06B, 0, 0, 6102, 1},
+	{0x1E06C, 0, 0, 6103, 1},
+	{0x1E06D, 0, 0, 6104, 1},
+	{0x1EE00, 0, 0, 5796, 1},
+	{0x1EE01, 0, 0, 5797, 1},
+	{0x1EE02, 0, 0, 5801, 1},
+	{0x1EE03, 0, 0, 5804, 1},
+	{0x1EE05, 0, 0, 5823, 1},
+	{0x1EE06, 0, 0, 5807, 1},
+	{0x1EE07, 0, 0, 5802, 1},
+	{0x1EE08, 0, 0, 5812, 1},
+	{0x1EE09, 0, 0, 5824, 1},
+	{0x1EE0A, 0, 0, 5818, 1},
+	{0x1EE0B, 0, 0, 5819, 1},
+	{0x1EE0C, 0, 0, 5820, 1},
+	{0x1EE0D, 0, 0, 5821, 1},
+	{0x1EE0E, 0, 0, 5808, 1},
+	{0x1EE0F, 0, 0, 5814, 1},
+	{0x1EE10, 0, 0, 5816, 1},
+	{0x1EE11, 0, 0, 5810, 1},
+	{0x1EE12, 0, 0, 5817, 1},
+	{0x1EE13, 0, 0, 5806, 1},
+	{0x1EE14, 0, 0, 5809, 1},
+	{0x1EE15, 0, 0, 5799, 1},
+	{0x1EE16, 0, 0, 5800, 1},
+	{0x1EE17, 0, 0, 5803, 1},
+	{0x1EE18, 0, 0, 5805, 1},
+	{0x1EE19, 0, 0, 5811, 1},
+	{0x1EE1A, 0, 0, 5813, 1},
+	{0x1EE1B, 0, 0, 5815, 1},
+	{0x1EE1C, 0, 0, 6105, 1},
+	{0x1EE1D, 0, 0, 5002, 1},
+	{0x1EE1E, 0, 0, 6106, 1},
+	{0x1EE1F, 0, 0, 6107, 1},
+	{0x1EE21, 0, 0, 5797, 1},
+	{0x1EE22, 0, 0, 5801, 1},
+	{0x1EE24, 0, 0, 5822, 1},
+	{0x1EE27, 0, 0, 5802, 1},
+	{0x1EE29, 0, 0, 5824, 1},
+	{0x1EE2A, 0, 0, 5818, 1},
+	{0x1EE2B, 0, 0, 5819, 1},
+	{0x1EE2C, 0, 0, 5820, 1},
+	{0x1EE2D, 0, 0, 5821, 1},
+	{0x1EE2E, 0, 0, 5808, 1},
+	{0x1EE2F, 0, 0, 5814, 1},
+	{0x1EE30, 0, 0, 5816, 1},
+	{0x1EE31, 0, 0, 5810, 1},
+	{0x1EE32, 0, 0, 5817, 1},
+	{0x1EE34, 0, 0, 5809, 1},
+	{0x1EE35, 0, 0, 5799, 1},
+	{0x1EE36, 0, 0, 5800, 1},
+	{0x1EE37, 0, 0, 5803, 1},
+	{0x1EE39, 0, 0, 5811, 1},
+	{0x1EE3B, 0, 0, 5815, 1},
+	{0x1EE42, 0, 0, 5801, 1},
+	{0x1EE47, 0, 0, 5802, 1},
+	{0x1EE49, 0, 0, 5824, 1},
+	{0x1EE4B, 0, 0, 5819, 1},
+	{0x1EE4D, 0, 0, 5821, 1},
+	{0x1EE4E, 0, 0, 5808, 1},
+	{0x1EE4F, 0, 0, 5814, 1},
+	{0x1EE51, 0, 0, 5810, 1},
+	{0x1EE52, 0, 0, 5817, 1},
+	{0x1EE54, 0, 0, 5809, 1},
+	{0x1EE57, 0, 0, 5803, 1},
+	{0x1EE59, 0, 0, 5811, 1},
+	{0x1EE5B, 0, 0, 5815, 1},
+	{0x1EE5D, 0, 0, 5002, 1},
+	{0x1EE5F, 0, 0, 6107, 1},
+	{0x1EE61, 0, 0, 5797, 1},
+	{0x1EE62, 0, 0, 5801, 1},
+	{0x1EE64, 0, 0, 5822, 1},
+	{0x1EE67, 0, 0, 5802, 1},
+	{0x1EE68, 0, 0, 5812, 1},
+	{0x1EE69, 0, 0, 5824, 1},
+	{0x1EE6A, 0, 0, 5818, 1},
+	{0x1EE6C, 0, 0, 5820, 1},
+	{0x1EE6D, 0, 0, 5821, 1},
+	{0x1EE6E, 0, 0, 5808, 1},
+	{0x1EE6F, 0, 0, 5814, 1},
+	{0x1EE70, 0, 0, 5816, 1},
+	{0x1EE71, 0, 0, 5810, 1},
+	{0x1EE72, 0, 0, 5817, 1},
+	{0x1EE74, 0, 0, 5809, 1},
+	{0x1EE75, 0, 0, 5799, 1},
+	{0x1EE76, 0, 0, 5800, 1},
+	{0x1EE77, 0, 0, 5803, 1},
+	{0x1EE79, 0, 0, 5811, 1},
+	{0x1EE7A, 0, 0, 5813, 1},
+	{0x1EE7B, 0, 0, 5815, 1},
+	{0x1EE7C, 0, 0, 6105, 1},
+	{0x1EE7E, 0, 0, 6106, 1},
+	{0x1EE80, 0, 0, 5796, 1},
+	{0x1EE81, 0, 0, 5797, 1},
+	{0x1EE82, 0, 0, 5801, 1},
+	{0x1EE83, 0, 0, 5804, 1},
+	{0x1EE84, 0, 0, 5822, 1},
+	{0x1EE85, 0, 0, 5823, 1},
+	{0x1EE86, 0, 0, 5807, 1},
+	{0x1EE87, 0, 0, 5802, 1},
+	{0x1EE88, 0, 0, 5812, 1},
+	{0x1EE89, 0, 0, 5824, 1},
+	{0x1EE8B, 0, 0, 5819, 1},
+	{0x1EE8C, 0, 0, 5820, 1},
+	{0x1EE8D, 0, 0, 5821, 1},
+	{0x1EE8E, 0, 0, 5808, 1},
+	{0x1EE8F, 0, 0, 5814, 1},
+	{0x1EE90, 0, 0, 5816, 1},
+	{0x1EE91, 0, 0, 5810, 1},
+	{0x1EE92, 0, 0, 5817, 1},
+	{0x1EE93, 0, 0, 5806, 1},
+	{0x1EE94, 0, 0, 5809, 1},
+	{0x1EE95, 0, 0, 5799, 1},
+	{0x1EE96, 0, 0, 5800, 1},
+	{0x1EE97, 0, 0, 5803, 1},
+	{0x1EE98, 0, 0, 5805, 1},
+	{0x1EE99, 0, 0, 5811, 1},
+	{0x1EE9A, 0, 0, 5813, 1},
+	{0x1EE9B, 0, 0, 5815, 1},
+	{0x1EEA1, 0, 0, 5797, 1},
+	{0x1EEA2, 0, 0, 5801, 1},
+	{0x1EEA3, 0, 0, 5804, 1},
+	{0x1EEA5, 0, 0, 5823, 1},
+	{0x1EEA6, 0, 0, 5807, 1},
+	{0x1EEA7, 0, 0, 5802, 1},
+	{0x1EEA8, 0, 0, 5812, 1},
+	{0x1EEA9, 0, 0, 5824, 1},
+	{0x1EEAB, 0, 0, 5819, 1},
+	{0x1EEAC, 0, 0, 5820, 1},
+	{0x1EEAD, 0, 0, 5821, 1},
+	{0x1EEAE, 0, 0, 5808, 1},
+	{0x1EEAF, 0, 0, 5814, 1},
+	{0x1EEB0, 0, 0, 5816, 1},
+	{0x1EEB1, 0, 0, 5810, 1},
+	{0x1EEB2, 0, 0, 5817, 1},
+	{0x1EEB3, 0, 0, 5806, 1},
+	{0x1EEB4, 0, 0, 5809, 1},
+	{0x1EEB5, 0, 0, 5799, 1},
+	{0x1EEB6, 0, 0, 5800, 1},
+	{0x1EEB7, 0, 0, 5803, 1},
+	{0x1EEB8, 0, 0, 5805, 1},
+	{0x1EEB9, 0, 0, 5811, 1},
+	{0x1EEBA, 0, 0, 5813, 1},
+	{0x1EEBB, 0, 0, 5815, 1},
+	{0x1F100, 0, 0, 6108, 2},
+	{0x1F101, 0, 0, 6110, 2},
+	{0x1F102, 0, 0, 6112, 2},
+	{0x1F103, 0, 0, 6114, 2},
+	{0x1F104, 0, 0, 6116, 2},
+	{0x1F105, 0, 0, 6118, 2},
+	{0x1F106, 0, 0, 6120, 2},
+	{0x1F107, 0, 0, 6122, 2},
+	{0x1F108, 0, 0, 6124, 2},
+	{0x1F109, 0, 0, 6126, 2},
+	{0x1F10A, 0, 0, 6128, 2},
+	{0x1F110, 0, 0, 6130, 3},
+	{0x1F111, 0, 0, 6133, 3},
+	{0x1F112, 0, 0, 6136, 3},
+	{0x1F113, 0, 0, 6139, 3},
+	{0x1F114, 0, 0, 6142, 3},
+	{0x1F115, 0, 0, 6145, 3},
+	{0x1F116, 0, 0, 6148, 3},
+	{0x1F117, 0, 0, 6151, 3},
+	{0x1F118, 0, 0, 6154, 3},
+	{0x1F119, 0, 0, 6157, 3},
+	{0x1F11A, 0, 0, 6160, 3},
+	{0x1F11B, 0, 0, 6163, 3},
+	{0x1F11C, 0, 0, 6166, 3},
+	{0x1F11D, 0, 0, 6169, 3},
+	{0x1F11E, 0, 0, 6172, 3},
+	{0x1F11F, 0, 0, 6175, 3},
+	{0x1F120, 0, 0, 6178, 3},
+	{0x1F121, 0, 0, 6181, 3},
+	{0x1F122, 0, 0, 6184, 3},
+	{0x1F123, 0, 0, 6187, 3},
+	{0x1F124, 0, 0, 6190, 3},
+	{0x1F125, 0, 0, 6193, 3},
+	{0x1F126, 0, 0, 6196, 3},
+	{0x1F127, 0, 0, 6199, 3},
+	{0x1F128, 0, 0, 6202, 3},
+	{0x1F129, 0, 0, 6205, 3},
+	{0x1F12A, 0, 0, 6208, 3},
+	{0x1F12B, 0, 0, 2324, 1},
+	{0x1F12C, 0, 0, 996, 1},
+	{0x1F12D, 0, 0, 6211, 2},
+	{0x1F12E, 0, 0, 6213, 2},
+	{0x1F130, 0, 0, 979, 1},
+	{0x1F131, 0, 0, 981, 1},
+	{0x1F132, 0, 0, 2324, 1},
+	{0x1F133, 0, 0, 982, 1},
+	{0x1F134, 0, 0, 983, 1},
+	{0x1F135, 0, 0, 2349, 1},
+	{0x1F136, 0, 0, 985, 1},
+	{0x1F137, 0, 0, 986, 1},
+	{0x1F138, 0, 0, 987, 1},
+	{0x1F139, 0, 0, 988, 1},
+	{0x1F13A, 0, 0, 989, 1},
+	{0x1F13B, 0, 0, 990, 1},
+	{0x1F13C, 0, 0, 991, 1},
+	{0x1F13D, 0, 0, 992, 1},
+	{0x1F13E, 0, 0, 993, 1},
+	{0x1F13F, 0, 0, 995, 1},
+	{0x1F140, 0, 0, 2339, 1},
+	{0x1F141, 0, 0, 996, 1},
+	{0x1F142, 0, 0, 2781, 1},
+	{0x1F143, 0, 0, 997, 1},
+	{0x1F144, 0, 0, 998, 1},
+	{0x1F145, 0, 0, 2415, 1},
+	{0x1F146, 0, 0, 999, 1},
+	{0x1F147, 0, 0, 2427, 1},
+	{0x1F148, 0, 0, 2782, 1},
+	{0x1F149, 0, 0, 2347, 1},
+	{0x1F14A, 0, 0, 6215, 2},
+	{0x1F14B, 0, 0, 4282, 2},
+	{0x1F14C, 0, 0, 6217, 2},
+	{0x1F14D, 0, 0, 6219, 2},
+	{0x1F14E, 0, 0, 6221, 3},
+	{0x1F14F, 0, 0, 6224, 2},
+	{0x1F16A, 0, 0, 6226, 2},
+	{0x1F16B, 0, 0, 6228, 2},
+	{0x1F16C, 0, 0, 6230, 2},
+	{0x1F190, 0, 0, 6232, 2},
+	{0x1F200, 0, 0, 6234, 2},
+	{0x1F201, 0, 0, 6236, 2},
+	{0x1F202, 0, 0, 3653, 1},
+	{0x1F210, 0, 0, 2864, 1},
+	{0x1F211, 0, 0, 6238, 1},
+	{0x1F212, 0, 0, 6239, 1},
+	{0x1F213, 0, 0, 3104, 2},
+	{0x1F214, 0, 0, 2807, 1},
+	{0x1F215, 0, 0, 6240, 1},
+	{0x1F216, 0, 0, 6241, 1},
+	{0x1F217, 0, 0, 3244, 1},
+	{0x1F218, 0, 0, 6242, 1},
+	{0x1F219, 0, 0, 6243, 1},
+	{0x1F21A, 0, 0, 6244, 1},
+	{0x1F21B, 0, 0, 4648, 1},
+	{0x1F21C, 0, 0, 6245, 1},
+	{0x1F21D, 0, 0, 6246, 1},
+	{0x1F21E, 0, 0, 6247, 1},
+	{0x1F21F, 0, 0, 6248, 1},
+	{0x1F220, 0, 0, 6249, 1},
+	{0x1F221, 0, 0, 6250, 1},
+	{0x1F222, 0, 0, 2900, 1},
+	{0x1F223, 0, 0, 6251, 1},
+	{0x1F224, 0, 0, 6252, 1},
+	{0x1F225, 0, 0, 6253, 1},
+	{0x1F226, 0, 0, 6254, 1},
+	{0x1F227, 0, 0, 6255, 1},
+	{0x1F228, 0, 0, 6256, 1},
+	{0x1F229, 0, 0, 2801, 1},
+	{0x1F22A, 0, 0, 3236, 1},
+	{0x1F22B, 0, 0, 6257, 1},
+	{0x1F22C, 0, 0, 3566, 1},
+	{0x1F22D, 0, 0, 3239, 1},
+	{0x1F22E, 0, 0, 3567, 1},
+	{0x1F22F, 0, 0, 6258, 1},
+	{0x1F230, 0, 0, 2956, 1},
+	{0x1F231, 0, 0, 6259, 1},
+	{0x1F232, 0, 0, 6260, 1},
+	{0x1F233, 0, 0, 6261, 1},
+	{0x1F234, 0, 0, 6262, 1},
+	{0x1F235, 0, 0, 6263, 1},
+	{0x1F236, 0, 0, 3549, 1},
+	{0x1F237, 0, 0, 2874, 1},
+	{0x1F238, 0, 0, 6264, 1},
+	{0x1F239, 0, 0, 6265, 1},
+	{0x1F23A, 0, 0, 6266, 1},
+	{0x1F23B, 0, 0, 6267, 1},
+	{0x1F240, 0, 0, 6268, 3},
+	{0x1F241, 0, 0, 6271, 3},
+	{0x1F242, 0, 0, 6274, 3},
+	{0x1F243, 0, 0, 6277, 3},
+	{0x1F244, 0, 0, 6280, 3},
+	{0x1F245, 0, 0, 6283, 3},
+	{0x1F246, 0, 0, 6286, 3},
+	{0x1F247, 0, 0, 6289, 3},
+	{0x1F248, 0, 0, 6292, 3},
+	{0x1F250, 0, 0, 6295, 1},
+	{0x1F251, 0, 0, 6296, 1},
+	{0x1FBF0, 0, 0, 2303, 1},
+	{0x1FBF1, 0, 0, 13, 1},
+	{0x1FBF2, 0, 0, 6, 1},
+	{0x1FBF3, 0, 0, 7, 1},
+	{0x1FBF4, 0, 0, 2304, 1},
+	{0x1FBF5, 0, 0, 2305, 1},
+	{0x1FBF6, 0, 0, 2306, 1},
+	{0x1FBF7, 0, 0, 2307, 1},
+	{0x1FBF8, 0, 0, 2308, 1},
+	{0x1FBF9, 0, 0, 2309, 1},
+	{0x2F800, 6297, 1, 6297, 1},
+	{0x2F801, 6298, 1, 6298, 1},
+	{0x2F802, 6299, 1, 6299, 1},
+	{0x2F803, 6300, 1, 6300, 1},
+	{0x2F804, 6301, 1, 6301, 1},
+	{0x2F805, 4741, 1, 4741, 1},
+	{0x2F806, 6302, 1, 6302, 1},
+	{0x2F807, 6303, 1, 6303, 1},
+	{0x2F808, 6304, 1, 6304, 1},
+	{0x2F809, 6305, 1, 6305, 1},
+	{0x2F80A, 4742, 1, 4742, 1},
+	{0x2F80B, 6306, 1, 6306, 1},
+	{0x2F80C, 6307, 1, 6307, 1},
+	{0x2F80D, 6308, 1, 6308, 1},
+	{0x2F80E, 4743, 1, 4743, 1},
+	{0x2F80F, 6309, 1, 6309, 1},
+	{0x2F810, 6310, 1, 6310, 1},
+	{0x2F811, 6311, 1, 6311, 1},
+	{0x2F812, 6312, 1, 6312, 1},
+	{0x2F813, 6313, 1, 6313, 1},
+	{0x2F814, 6314, 1, 6314, 1},
+	{0x2F815, 6247, 1, 6247, 1},
+	{0x2F816, 6315, 1, 6315, 1},
+	{0x2F817, 6316, 1, 6316, 1},
+	{0x2F818, 6317, 1, 6317, 1},
+	{0x2F819, 6318, 1, 6318, 1},
+	{0x2F81A, 6319, 1, 6319, 1},
+	{0x2F81B, 4798, 1, 4798, 1},
+	{0x2F81C, 6320, 1, 6320, 1},
+	{0x2F81D, 2817, 1, 2817, 1},
+	{0x2F81E, 6321, 1, 6321, 1},
+	{0x2F81F, 6322, 1, 6322, 1},
+	{0x2F820, 6323, 1, 6323, 1},
+	{0x2F821, 6324, 1, 6324, 1},
+	{0x2F822, 6265, 1, 6265, 1},
+	{0x2F823, 6325, 1, 6325, 1},
+	{0x2F824, 6326, 1, 6326, 1},
+	{0x2F825, 4803, 1, 4803, 1},
+	{0x2F826, 4744, 1, 4744, 1},
+	{0x2F827, 4745, 1, 4745, 1},
+	{0x2F828, 4804, 1, 4804, 1},
+	{0x2F829, 6327, 1, 6327, 1},
+	{0x2F82A, 6328, 1, 6328, 1},
+	{0x2F82B, 4562, 1, 4562, 1},
+	{0x2F82C, 6329, 1, 6329, 1},
+	{0x2F82D, 4746, 1, 4746, 1},
+	{0x2F82E, 6330, 1, 6330, 1},
+	{0x2F82F, 6331, 1, 6331, 1},
+	{0x2F830, 6332, 1, 6332, 1},
+	{0x2F831, 6333, 1, 6333, 1},
+	{0x2F832, 6333, 1, 6333, 1},
+	{0x2F833, 6333, 1, 6333, 1},
+	{0x2F834, 6334, 1, 6334, 1},
+	{0x2F835, 6335, 1, 6335, 1},
+	{0x2F836, 6336, 1, 6336, 1},
+	{0x2F837, 6337, 1, 6337, 1},
+	{0x2F838, 6338, 1, 6338, 1},
+	{0x2F839, 6339, 1, 6339, 1},
+	{0x2F83A, 6340, 1, 6340, 1},
+	{0x2F83B, 6341, 1, 6341, 1},
+	{0x2F83C, 6342, 1, 6342, 1},
+	{0x2F83D, 6343, 1, 6343, 1},
+	{0x2F83E, 6344, 1, 6344, 1},
+	{0x2F83F, 6345, 1, 6345, 1},
+	{0x2F840, 6346, 1, 6346, 1},
+	{0x2F841, 6347, 1, 6347, 1},
+	{0x2F842, 6348, 1, 6348, 1},
+	{0x2F843, 6349, 1, 6349, 1},
+	{0x2F844, 6350, 1, 6350, 1},
+	{0x2F845, 6351, 1, 6351, 1},
+	{0x2F846, 6351, 1, 6351, 1},
+	{0x2F847, 4806, 1, 4806, 1},
+	{0x2F848, 6352, 1, 6352, 1},
+	{0x2F849, 6353, 1, 6353, 1},
+	{0x2F84A, 6354, 1, 6354, 1},
+	{0x2F84B, 6355, 1, 6355, 1},
+	{0x2F84C, 4748, 1, 4748, 1},
+	{0x2F84D, 6356, 1, 6356, 1},
+	{0x2F84E, 6357, 1, 6357, 1},
+	{0x2F84F, 6358, 1, 6358, 1},
+	{0x2F850, 4708, 1, 4708, 1},
+	{0x2F851, 6359, 1, 6359, 1},
+	{0x2F852, 6360, 1, 6360, 1},
+	{0x2F853, 6361, 1, 6361, 1},
+	{0x2F854, 6362, 1, 6362, 1},
+	{0x2F855, 6363, 1, 6363, 1},
+	{0x2F856, 6364, 1, 6364, 1},
+	{0x2F857, 6365, 1, 6365, 1},
+	{0x2F858, 6366, 1, 6366, 1},
+	{0x2F859, 6367, 1, 6367, 1},
+	{0x2F85A, 6368, 1, 6368, 1},
+	{0x2F85B, 6369, 1, 6369, 1},
+	{0x2F85C, 6370, 1, 6370, 1},
+	{0x2F85D, 6240, 1, 6240, 1},
+	{0x2F85E, 6371, 1, 6371, 1},
+	{0x2F85F, 6372, 1, 6372, 1},
+	{0x2F860, 6373, 1, 6373, 1},
+	{0x2F861, 6374, 1, 6374, 1},
+	{0x2F862, 6375, 1, 6375, 1},
+	{0x2F863, 6376, 1, 6376, 1},
+	{0x2F864, 6377, 1, 6377, 1},
+	{0x2F865, 6378, 1, 6378, 1},
+	{0x2F866, 6379, 1, 6379, 1},
+	{0x2F867, 6380, 1, 6380, 1},
+	{0x2F868, 6381, 1, 6381, 1},
+	{0x2F869, 6382, 1, 6382, 1},
+	{0x2F86A, 6383, 1, 6383, 1},
+	{0x2F86B, 6383, 1, 6383, 1},
+	{0x2F86C, 6384, 1, 6384, 1},
+	{0x2F86D, 6385, 1, 6385, 1},
+	{0x2F86E, 6386, 1, 6386, 1},
+	{0x2F86F, 4558, 1, 4558, 1},
+	{0x2F870, 6387, 1, 6387, 1},
+	{0x2F871, 6388, 1, 6388, 1},
+	{0x2F872, 6389, 1, 6389, 1},
+	{0x2F873, 6390, 1, 6390, 1},
+	{0x2F874, 6391, 1, 6391, 1},
+	{0x2F875, 2843, 1, 2843, 1},
+	{0x2F876, 6392, 1, 6392, 1},
+	{0x2F877, 6393, 1, 6393, 1},
+	{0x2F878, 2845, 1, 2845, 1},
+	{0x2F879, 6394, 1, 6394, 1},
+	{0x2F87A, 6395, 1, 6395, 1},
+	{0x2F87B, 6396, 1, 6396, 1},
+	{0x2F87C, 6397, 1, 6397, 1},
+	{0x2F87D, 6398, 1, 6398, 1},
+	{0x2F87E, 6399, 1, 6399, 1},
+	{0x2F87F, 6400, 1, 6400, 1},
+	{0x2F880, 6401, 1, 6401, 1},
+	{0x2F881, 6402, 1, 6402, 1},
+	{0x2F882, 6403, 1, 6403, 1},
+	{0x2F883, 6404, 1, 6404, 1},
+	{0x2F884, 6405, 1, 6405, 1},
+	{0x2F885, 6406, 1, 6406, 1},
+	{0x2F886, 6407, 1, 6407, 1},
+	{0x2F887, 6408, 1, 6408, 1},
+	{0x2F888, 6409, 1, 6409, 1},
+	{0x2F889, 6410, 1, 6410, 1},
+	{0x2F88A, 6411, 1, 6411, 1},
+	{0x2F88B, 6412, 1, 6412, 1},
+	{0x2F88C, 6413, 1, 6413, 1},
+	{0x2F88D, 6414, 1, 6414, 1},
+	{0x2F88E, 4506, 1, 4506, 1},
+	{0x2F88F, 6415, 1, 6415, 1},
+	{0x2F890, 2855, 1, 2855, 1},
+	{0x2F891, 6416, 1, 6416, 1},
+	{0x2F892, 6416, 1, 6416, 1},
+	{0x2F893, 6417, 1, 6417, 1},
+	{0x2F894, 6418, 1, 6418, 1},
+	{0x2F895, 6418, 1, 6418, 1},
+	{0x2F896, 6419, 1, 6419, 1},
+	{0x2F897, 6420, 1, 6420, 1},
+	{0x2F898, 6421, 1, 6421, 1},
+	{0x2F899, 6422, 1, 6422, 1},
+	{0x2F89A, 6423, 1, 6423, 1},
+	{0x2F89B, 6424, 1, 6424, 1},
+	{0x2F89C, 6425, 1, 6425, 1},
+	{0x2F89D, 6426, 1, 6426, 1},
+	{0x2F89E, 6427, 1, 6427, 1},
+	{0x2F89F, 6428, 1, 6428, 1},
+	{0x2F8A0, 6429, 1, 6429, 1},
+	{0x2F8A1, 6430, 1, 6430, 1},
+	{0x2F8A2, 6431, 1, 6431, 1},
+	{0x2F8A3, 4753, 1, 4753, 1},
+	{0x2F8A4, 6432, 1, 6432, 1},
+	{0x2F8A5, 6433, 1, 6433, 1},
+	{0x2F8A6, 6434, 1, 6434, 1},
+	{0x2F8A7, 6435, 1, 6435, 1},
+	{0x2F8A8, 4818, 1, 4818, 1},
+	{0x2F8A9, 6435, 1, 6435, 1},
+	{0x2F8AA, 6436, 1, 6436, 1},
+	{0x2F8AB, 4755, 1, 4755, 1},
+	{0x2F8AC, 6437, 1, 6437, 1},
+	{0x2F8AD, 6438, 1, 6438, 1},
+	{0x2F8AE, 6439, 1, 6439, 1},
+	{0x2F8AF, 6440, 1, 6440, 1},
+	{0x2F8B0, 4756, 1, 4756, 1},
+	{0x2F8B1, 4479, 1, 4479, 1},
+	{0x2F8B2, 6441, 1, 6441, 1},
+	{0x2F8B3, 6442, 1, 6442, 1},
+	{0x2F8B4, 6443, 1, 6443, 1},
+	{0x2F8B5, 6444, 1, 6444, 1},
+	{0x2F8B6, 6445, 1, 6445, 1},
+	{0x2F8B7, 6446, 1, 6446, 1},
+	{0x2F8B8, 6447, 1, 6447, 1},
+	{0x2F8B9, 6448, 1, 6448, 1},
+	{0x2F8BA, 6449, 1, 6449, 1},
+	{0x2F8BB, 6450, 1, 6450, 1},
+	{0x2F8BC, 6451, 1, 6451, 1},
+	{0x2F8BD, 6452, 1, 6452, 1},
+	{0x2F8BE, 6453, 1, 6453, 1},
+	{0x2F8BF, 6454, 1, 6454, 1},
+	{0x2F8C0, 6455, 1, 6455, 1},
+	{0x2F8C1, 6456, 1, 6456, 1},
+	{0x2F8C2, 6457, 1, 6457, 1},
+	{0x2F8C3, 6458, 1, 6458, 1},
+	{0x2F8C4, 6459, 1, 6459, 1},
+	{0x2F8C5, 6460, 1, 6460, 1},
+	{0x2F8C6, 6461, 1, 6461, 1},
+	{0x2F8C7, 6462, 1, 6462, 1},
+	{0x2F8C8, 4757, 1, 4757, 1},
+	{0x2F8C9, 6463, 1, 6463, 1},
+	{0x2F8CA, 6464, 1, 6464, 1},
+	{0x2F8CB, 6465, 1, 6465, 1},
+	{0x2F8CC, 6466, 1, 6466, 1},
+	{0x2F8CD, 6467, 1, 6467, 1},
+	{0x2F8CE, 6468, 1, 6468, 1},
+	{0x2F8CF, 4759, 1, 4759, 1},
+	{0x2F8D0, 6469, 1, 6469, 1},
+	{0x2F8D1, 6470, 1, 6470, 1},
+	{0x2F8D2, 6471, 1, 6471, 1},
+	{0x2F8D3, 6472, 1, 6472, 1},
+	{0x2F8D4, 6473, 1, 6473, 1},
+	{0x2F8D5, 6474, 1, 6474, 1},
+	{0x2F8D6, 6475, 1, 6475, 1},
+	{0x2F8D7, 6476, 1, 6476, 1},
+	{0x2F8D8, 4507, 1, 4507, 1},
+	{0x2F8D9, 4826, 1, 4826, 1},
+	{0x2F8DA, 6477, 1, 6477, 1},
+	{0x2F8DB, 6478, 1, 6478, 1},
+	{0x2F8DC, 6479, 1, 6479, 1},
+	{0x2F8DD, 6480, 1, 6480, 1},
+	{0x2F8DE, 6481, 1, 6481, 1},
+	{0x2F8DF, 6482, 1, 6482, 1},
+	{0x2F8E0, 6483, 1, 6483, 1},
+	{0x2F8E1, 6484, 1, 6484, 1},
+	{0x2F8E2, 4760, 1, 4760, 1},
+	{0x2F8E3, 6485, 1, 6485, 1},
+	{0x2F8E4, 6486, 1, 6486, 1},
+	{0x2F8E5, 6487, 1, 6487, 1},
+	{0x2F8E6, 6488, 1, 6488, 1},
+	{0x2F8E7, 4868, 1, 4868, 1},
+	{0x2F8E8, 6489, 1, 6489, 1},
+	{0x2F8E9, 6490, 1, 6490, 1},
+	{0x2F8EA, 6491, 1, 6491, 1},
+	{0x2F8EB, 6492, 1, 6492, 1},
+	{0x2F8EC, 6493, 1, 6493, 1},
+	{0x2F8ED, 6494, 1, 6494, 1},
+	{0x2F8EE, 6495, 1, 6495, 1},
+	{0x2F8EF, 6496, 1, 6496, 1},
+	{0x2F8F0, 6497, 1, 6497, 1},
+	{0x2F8F1, 6498, 1, 6498, 1},
+	{0x2F8F2, 6499, 1, 6499, 1},
+	{0x2F8F3, 6500, 1, 6500, 1},
+	{0x2F8F4, 6501, 1, 6501, 1},
+	{0x2F8F5, 4575, 1, 4575, 1},
+	{0x2F8F6, 6502, 1, 6502, 1},
+	{0x2F8F7, 6503, 1, 6503, 1},
+	{0x2F8F8, 6504, 1, 6504, 1},
+	{0x2F8F9, 6505, 1, 6505, 1},
+	{0x2F8FA, 6506, 1, 6506, 1},
+	{0x2F8FB, 6507, 1, 6507, 1},
+	{0x2F8FC, 6508, 1, 6508, 1},
+	{0x2F8FD, 6509, 1, 6509, 1},
+	{0x2F8FE, 6510, 1, 6510, 1},
+	{0x2F8FF, 6511, 1, 6511, 1},
+	{0x2F900, 6512, 1, 6512, 1},
+	{0x2F901, 4761, 1, 4761, 1},
+	{0x2F902, 4658, 1, 4658, 1},
+	{0x2F903, 6513, 1, 6513, 1},
+	{0x2F904, 6514, 1, 6514, 1},
+	{0x2F905, 6515, 1, 6515, 1},
+	{0x2F906, 6516, 1, 6516, 1},
+	{0x2F907, 6517, 1, 6517, 1},
+	{0x2F908, 6518, 1, 6518, 1},
+	{0x2F909, 6519, 1, 6519, 1},
+	{0x2F90A, 6520, 1, 6520, 1},
+	{0x2F90B, 4829, 1, 4829, 1},
+	{0x2F90C, 6521, 1, 6521, 1},
+	{0x2F90D, 6522, 1, 6522, 1},
+	{0x2F90E, 6523, 1, 6523, 1},
+	{0x2F90F, 6524, 1, 6524, 1},
+	{0x2F910, 6525, 1, 6525, 1},
+	{0x2F911, 6526, 1, 6526, 1},
+	{0x2F912, 6527, 1, 6527, 1},
+	{0x2F913, 6528, 1, 6528, 1},
+	{0x2F914, 4830, 1, 4830, 1},
+	{0x2F915, 6529, 1, 6529, 1},
+	{0x2F916, 6530, 1, 6530, 1},
+	{0x2F917, 6531, 1, 6531, 1},
+	{0x2F918, 6532, 1, 6532, 1},
+	{0x2F919, 6533, 1, 6533, 1},
+	{0x2F91A, 6534, 1, 6534, 1},
+	{0x2F91B, 6535, 1, 6535, 1},
+	{0x2F91C, 6536, 1, 6536, 1},
+	{0x2F91D, 6537, 1, 6537, 1},
+	{0x2F91E, 6538, 1, 6538, 1},
+	{0x2F91F, 6539, 1, 6539, 1},
+	{0x2F920, 6540, 1, 6540, 1},
+	{0x2F921, 4832, 1, 4832, 1},
+	{0x2F922, 6541, 1, 6541, 1},
+	{0x2F923, 6542, 1, 6542, 1},
+	{0x2F924, 6543, 1, 6543, 1},
+	{0x2F925, 6544, 1, 6544, 1},
+	{0x2F926, 6545, 1, 6545, 1},
+	{0x2F927, 6546, 1, 6546, 1},
+	{0x2F928, 6547, 1, 6547, 1},
+	{0x2F929, 6548, 1, 6548, 1},
+	{0x2F92A, 6549, 1, 6549, 1},
+	{0x2F92B, 6550, 1, 6550, 1},
+	{0x2F92C, 6551, 1, 6551, 1},
+	{0x2F92D, 6551, 1, 6551, 1},
+	{0x2F92E, 6552, 1, 6552, 1},
+	{0x2F92F, 6553, 1, 6553, 1},
+	{0x2F930, 4834, 1, 4834, 1},
+	{0x2F931, 6554, 1, 6554, 1},
+	{0x2F932, 6555, 1, 6555, 1},
+	{0x2F933, 6556, 1, 6556, 1},
+	{0x2F934, 6557, 1, 6557, 1},
+	{0x2F935, 6558, 1, 6558, 1},
+	{0x2F936, 6559, 1, 6559, 1},
+	{0x2F937, 6560, 1, 6560, 1},
+	{0x2F938, 4561, 1, 4561, 1},
+	{0x2F939, 6561, 1, 6561, 1},
+	{0x2F93A, 6562, 1, 6562, 1},
+	{0x2F93B, 6563, 1, 6563, 1},
+	{0x2F93C, 6564, 1, 6564, 1},
+	{0x2F93D, 6565, 1, 6565, 1},
+	{0x2F93E, 6566, 1, 6566, 1},
+	{0x2F93F, 6567, 1, 6567, 1},
+	{0x2F940, 4840, 1, 4840, 1},
+	{0x2F941, 6568, 1, 6568, 1},
+	{0x2F942, 6569, 1, 6569, 1},
+	{0x2F943, 6570, 1, 6570, 1},
+	{0x2F944, 6571, 1, 6571, 1},
+	{0x2F945, 6572, 1, 6572, 1},
+	{0x2F946, 6573, 1, 6573, 1},
+	{0x2F947, 6573, 1, 6573, 1},
+	{0x2F948, 4841, 1, 4841, 1},
+	{0x2F949, 4870, 1, 4870, 1},
+	{0x2F94A, 6574, 1, 6574, 1},
+	{0x2F94B, 6575, 1, 6575, 1},
+	{0x2F94C, 6576, 1, 6576, 1},
+	{0x2F94D, 6577, 1, 6577, 1},
+	{0x2F94E, 6578, 1, 6578, 1},
+	{0x2F94F, 4524, 1, 4524, 1},
+	{0x2F950, 4843, 1, 4843, 1},
+	{0x2F951, 6579, 1, 6579, 1},
+	{0x2F952, 6580, 1, 6580, 1},
+	{0x2F953, 4771, 1, 4771, 1},
+	{0x2F954, 6581, 1, 6581, 1},
+	{0x2F955, 6582, 1, 6582, 1},
+	{0x2F956, 4728, 1, 4728, 1},
+	{0x2F957, 6583, 1, 6583, 1},
+	{0x2F958, 6584, 1, 6584, 1},
+	{0x2F959, 4774, 1, 4774, 1},
+	{0x2F95A, 6585, 1, 6585, 1},
+	{0x2F95B, 6586, 1, 6586, 1},
+	{0x2F95C, 6587, 1, 6587, 1},
+	{0x2F95D, 6588, 1, 6588, 1},
+	{0x2F95E, 6588, 1, 6588, 1},
+	{0x2F95F, 6589, 1, 6589, 1},
+	{0x2F960, 6590, 1, 6590, 1},
+	{0x2F961, 6591, 1, 6591, 1},
+	{0x2F962, 6592, 1, 6592, 1},
+	{0x2F963, 6593, 1, 6593, 1},
+	{0x2F964, 6594, 1, 6594, 1},
+	{0x2F965, 6595, 1, 6595, 1},
+	{0x2F966, 6596, 1, 6596, 1},
+	{0x2F967, 6597, 1, 6597, 1},
+	{0x2F968, 6598, 1, 6598, 1},
+	{0x2F969, 6599, 1, 6599, 1},
+	{0x2F96A, 6600, 1, 6600, 1},
+	{0x2F96B, 6601, 1, 6601, 1},
+	{0x2F96C, 6602, 1, 6602, 1},
+	{0x2F96D, 6603, 1, 6603, 1},
+	{0x2F96E, 6604, 1, 6604, 1},
+	{0x2F96F, 6605, 1, 6605, 1},
+	{0x2F970, 6606, 1, 6606, 1},
+	{0x2F971, 6607, 1, 6607, 1},
+	{0x2F972, 6608, 1, 6608, 1},
+	{0x2F973, 6609, 1, 6609, 1},
+	{0x2F974, 6610, 1, 6610, 1},
+	{0x2F975, 6611, 1, 6611, 1},
+	{0x2F976, 6612, 1, 6612, 1},
+	{0x2F977, 6613, 1, 6613, 1},
+	{0x2F978, 6614, 1, 6614, 1},
+	{0x2F979, 6615, 1, 6615, 1},
+	{0x2F97A, 4780, 1, 4780, 1},
+	{0x2F97B, 6616, 1, 6616, 1},
+	{0x2F97C, 6617, 1, 6617, 1},
+	{0x2F97D, 6618, 1, 6618, 1},
+	{0x2F97E, 6619, 1, 6619, 1},
+	{0x2F97F, 6620, 1, 6620, 1},
+	{0x2F980, 6621, 1, 6621, 1},
+	{0x2F981, 6622, 1, 6622, 1},
+	{0x2F982, 6623, 1, 6623, 1},
+	{0x2F983, 6624, 1, 6624, 1},
+	{0x2F984, 6625, 1, 6625, 1},
+	{0x2F985, 6626, 1, 6626, 1},
+	{0x2F986, 6627, 1, 6627, 1},
+	{0x2F987, 6628, 1, 6628, 1},
+	{0x2F988, 6629, 1, 6629, 1},
+	{0x2F989, 6630, 1, 6630, 1},
+	{0x2F98A, 6631, 1, 6631, 1},
+	{0x2F98B, 6417, 1, 6417, 1},
+	{0x2F98C, 6632, 1, 6632, 1},
+	{0x2F98D, 6633, 1, 6633, 1},
+	{0x2F98E, 6634, 1, 6634, 1},
+	{0x2F98F, 6635, 1, 6635, 1},
+	{0x2F990, 6636, 1, 6636, 1},
+	{0x2F991, 6637, 1, 6637, 1},
+	{0x2F992, 6638, 1, 6638, 1},
+	{0x2F993, 6639, 1, 6639, 1},
+	{0x2F994, 6640, 1, 6640, 1},
+	{0x2F995, 6641, 1, 6641, 1},
+	{0x2F996, 6642, 1, 6642, 1},
+	{0x2F997, 6643, 1, 6643, 1},
+	{0x2F998, 4578, 1, 4578, 1},
+	{0x2F999, 6644, 1, 6644, 1},
+	{0x2F99A, 6645, 1, 6645, 1},
+	{0x2F99B, 6646, 1, 6646, 1},
+	{0x2F99C, 6647, 1, 6647, 1},
+	{0x2F99D, 6648, 1, 6648, 1},
+	{0x2F99E, 6649, 1, 6649, 1},
+	{0x2F99F, 4783, 1, 4783, 1},
+	{0x2F9A0, 6650, 1, 6650, 1},
+	{0x2F9A1, 6651, 1, 6651, 1},
+	{0x2F9A2, 6652, 1, 6652, 1},
+	{0x2F9A3, 6653, 1, 6653, 1},
+	{0x2F9A4, 6654, 1, 6654, 1},
+	{0x2F9A5, 6655, 1, 6655, 1},
+	{0x2F9A6, 6656, 1, 6656, 1},
+	{0x2F9A7, 6657, 1, 6657, 1},
+	{0x2F9A8, 6658, 1, 6658, 1},
+	{0x2F9A9, 6659, 1, 6659, 1},
+	{0x2F9AA, 6660, 1, 6660, 1},
+	{0x2F9AB, 6661, 1, 6661, 1},
+	{0x2F9AC, 6662, 1, 6662, 1},
+	{0x2F9AD, 6663, 1, 6663, 1},
+	{0x2F9AE, 6664, 1, 6664, 1},
+	{0x2F9AF, 6665, 1, 6665, 1},
+	{0x2F9B0, 6666, 1, 6666, 1},
+	{0x2F9B1, 6667, 1, 6667, 1},
+	{0x2F9B2, 6668, 1, 6668, 1},
+	{0x2F9B3, 6669, 1, 6669, 1},
+	{0x2F9B4, 4519, 1, 4519, 1},
+	{0x2F9B5, 6670, 1, 6670, 1},
+	{0x2F9B6, 6671, 1, 6671, 1},
+	{0x2F9B7, 6672, 1, 6672, 1},
+	{0x2F9B8, 6673, 1, 6673, 1},
+	{0x2F9B9, 6674, 1, 6674, 1},
+	{0x2F9BA, 6675, 1, 6675, 1},
+	{0x2F9BB, 4850, 1, 4850, 1},
+	{0x2F9BC, 6676, 1, 6676, 1},
+	{0x2F9BD, 6677, 1, 6677, 1},
+	{0x2F9BE, 6678, 1, 6678, 1},
+	{0x2F9BF, 6679, 1, 6679, 1},
+	{0x2F9C0, 6680, 1, 6680, 1},
+	{0x2F9C1, 6681, 1, 6681, 1},
+	{0x2F9C2, 6682, 1, 6682, 1},
+	{0x2F9C3, 6683, 1, 6683, 1},
+	{0x2F9C4, 2945, 1, 2945, 1},
+	{0x2F9C5, 6684, 1, 6684, 1},
+	{0x2F9C6, 6685, 1, 6685, 1},
+	{0x2F9C7, 6686, 1, 6686, 1},
+	{0x2F9C8, 6687, 1, 6687, 1},
+	{0x2F9C9, 6688, 1, 6688, 1},
+	{0x2F9CA, 6689, 1, 6689, 1},
+	{0x2F9CB, 6690, 1, 6690, 1},
+	{0x2F9CC, 6691, 1, 6691, 1},
+	{0x2F9CD, 6692, 1, 6692, 1},
+	{0x2F9CE, 6693, 1, 6693, 1},
+	{0x2F9CF, 6694, 1, 6694, 1},
+	{0x2F9D0, 4855, 1, 4855, 1},
+	{0x2F9D1, 4856, 1, 4856, 1},
+	{0x2F9D2, 2952, 1, 2952, 1},
+	{0x2F9D3, 6695, 1, 6695, 1},
+	{0x2F9D4, 6696, 1, 6696, 1},
+	{0x2F9D5, 6697, 1, 6697, 1},
+	{0x2F9D6, 6698, 1, 6698, 1},
+	{0x2F9D7, 6699, 1, 6699, 1},
+	{0x2F9D8, 6700, 1, 6700, 1},
+	{0x2F9D9, 6701, 1, 6701, 1},
+	{0x2F9DA, 6702, 1, 6702, 1},
+	{0x2F9DB, 6703, 1, 6703, 1},
+	{0x2F9DC, 6704, 1, 6704, 1},
+	{0x2F9DD, 6705, 1, 6705, 1},
+	{0x2F9DE, 6706, 1, 6706, 1},
+	{0x2F9DF, 4857, 1, 4857, 1},
+	{0x2F9E0, 6707, 1, 6707, 1},
+	{0x2F9E1, 6708, 1, 6708, 1},
+	{0x2F9E2, 6709, 1, 6709, 1},
+	{0x2F9E3, 6710, 1, 6710, 1},
+	{0x2F9E4, 6711, 1, 6711, 1},
+	{0x2F9E5, 6712, 1, 6712, 1},
+	{0x2F9E6, 6713, 1, 6713, 1},
+	{0x2F9E7, 6714, 1, 6714, 1},
+	{0x2F9E8, 6715, 1, 6715, 1},
+	{0x2F9E9, 6716, 1, 6716, 1},
+	{0x2F9EA, 6717, 1, 6717, 1},
+	{0x2F9EB, 6718, 1, 6718, 1},
+	{0x2F9EC, 6719, 1, 6719, 1},
+	{0x2F9ED, 6720, 1, 6720, 1},
+	{0x2F9EE, 6721, 1, 6721, 1},
+	{0x2F9EF, 6722, 1, 6722, 1},
+	{0x2F9F0, 6723, 1, 6723, 1},
+	{0x2F9F1, 6724, 1, 6724, 1},
+	{0x2F9F2, 6725, 1, 6725, 1},
+	{0x2F9F3, 6726, 1, 6726, 1},
+	{0x2F9F4, 6727, 1, 6727, 1},
+	{0x2F9F5, 6728, 1, 6728, 1},
+	{0x2F9F6, 6729, 1, 6729, 1},
+	{0x2F9F7, 6730, 1, 6730, 1},
+	{0x2F9F8, 6731, 1, 6731, 1},
+	{0x2F9F9, 6732, 1, 6732, 1},
+	{0x2F9FA, 6733, 1, 6733, 1},
+	{0x2F9FB, 6734, 1, 6734, 1},
+	{0x2F9FC, 6735, 1, 6735, 1},
+	{0x2F9FD, 6736, 1, 6736, 1},
+	{0x2F9FE, 4863, 1, 4863, 1},
+	{0x2F9FF, 4863, 1, 4863, 1},
+	{0x2FA00, 6737, 1, 6737, 1},
+	{0x2FA01, 6738, 1, 6738, 1},
+	{0x2FA02, 6739, 1, 6739, 1},
+	{0x2FA03, 6740, 1, 6740, 1},
+	{0x2FA04, 6741, 1, 6741, 1},
+	{0x2FA05, 6742, 1, 6742, 1},
+	{0x2FA06, 6743, 1, 6743, 1},
+	{0x2FA07, 6744, 1, 6744, 1},
+	{0x2FA08, 6745, 1, 6745, 1},
+	{0x2FA09, 6746, 1, 6746, 1},
+	{0x2FA0A, 4864, 1, 4864, 1},
+	{0x2FA0B, 6747, 1, 6747, 1},
+	{0x2FA0C, 6748, 1, 6748, 1},
+	{0x2FA0D, 6749, 1, 6749, 1},
+	{0x2FA0E, 6750, 1, 6750, 1},
+	{0x2FA0F, 6751, 1, 6751, 1},
+	{0x2FA10, 6752, 1, 6752, 1},
+	{0x2FA11, 6753, 1, 6753, 1},
+	{0x2FA12, 6754, 1, 6754, 1},
+	{0x2FA13, 6755, 1, 6755, 1},
+	{0x2FA14, 6756, 1, 6756, 1},
+	{0x2FA15, 3000, 1, 3000, 1},
+	{0x2FA16, 6757, 1, 6757, 1},
+	{0x2FA17, 3004, 1, 3004, 1},
+	{0x2FA18, 6758, 1, 6758, 1},
+	{0x2FA19, 6759, 1, 6759, 1},
+	{0x2FA1A, 6760, 1, 6760, 1},
+	{0x2FA1B, 6761, 1, 6761, 1},
+	{0x2FA1C, 3009, 1, 3009, 1},
+	{0x2FA1D, 6762, 1, 6762, 1},
+}
+
+var decompRunes = [...]rune{
+	0x0020, 0x0020, 0x0308, 0x0061, 0x0020, 0x0304, 0x0032, 0x0033,
+	0x0020, 0x0301, 0x03BC, 0x0020, 0x0327, 0x0031, 0x006F, 0x0031,
+	0x2044, 0x0034, 0x0031, 0x2044, 0x0032, 0x0033, 0x2044, 0x0034,
+	0x0041, 0x0300, 0x0041, 0x0301, 0x0041, 0x0302, 0x0041, 0x0303,
+	0x0041, 0x0308, 0x0041, 0x030A, 0x0043, 0x0327, 0x0045, 0x0300,
+	0x0045, 0x0301, 0x0045, 0x0302, 0x0045, 0x0308, 0x0049, 0x0300,
+	0x0049, 0x0301, 0x0049, 0x0302, 0x0049, 0x0308, 0x004E, 0x0303,
+	0x004F, 0x0300, 0x004F, 0x0301, 0x004F, 0x0302, 0x004F, 0x0303,
+	0x004F, 0x0308, 0x0055, 0x0300, 0x0055, 0x0301, 0x0055, 0x0302,
+	0x0055, 0x0308, 0x0059, 0x0301, 0x0061, 0x0300, 0x0061, 0x0301,
+	0x0061, 0x0302, 0x0061, 0x0303, 0x0061, 0x0308, 0x0061, 0x030A,
+	0x0063, 0x0327, 0x0065, 0x0300, 0x0065, 0x0301, 0x0065, 0x0302,
+	0x0065, 0x0308, 0x0069, 0x0300, 0x0069, 0x0301, 0x0069, 0x0302,
+	0x0069, 0x0308, 0x006E, 0x0303, 0x006F, 0x0300, 0x006F, 0x0301,
+	0x006F, 0x0302, 0x006F, 0x0303, 0x006F, 0x0308, 0x0075, 0x0300,
+	0x0075, 0x0301, 0x0075, 0x0302, 0x0075, 0x0308, 0x0079, 0x0301,
+	0x0079, 0x0308, 0x0041, 0x0304, 0x0061, 0x0304, 0x0041, 0x0306,
+	0x0061, 0x0306, 0x0041, 0x0328, 0x0061, 0x0328, 0x0043, 0x0301,
+	0x0063, 0x0301, 0x0043, 0x0302, 0x0063, 0x0302, 0x0043, 0x0307,
+	0x0063, 0x0307, 0x0043, 0x030C, 0x0063, 0x030C, 0x0044, 0x030C,
+	0x0064, 0x030C, 0x0045, 0x0304, 0x0065, 0x0304, 0x0045, 0x0306,
+	0x0065, 0x0306, 0x0045, 0x0307, 0x0065, 0x0307, 0x0045, 0x0328,
+	0x0065, 0x0328, 0x0045, 0x030C, 0x0065, 0x030C, 0x0047, 0x0302,
+	0x0067, 0x0302, 0x0047, 0x0306, 0x0067, 0x0306, 0x0047, 0x0307,
+	0x0067, 0x0307, 0x0047, 0x0327, 0x0067, 0x0327, 0x0048, 0x0302,
+	0x0068, 0x0302, 0x0049, 0x0303, 0x0069, 0x0303, 0x0049, 0x0304,
+	0x0069, 0x0304, 0x0049, 0x0306, 0x0069, 0x0306, 0x0049, 0x0328,
+	0x0069, 0x0328, 0x0049, 0x0307, 0x0049, 0x004A, 0x0069, 0x006A,
+	0x004A, 0x0302, 0x006A, 0x0302, 0x004B, 0x0327, 0x006B, 0x0327,
+	0x004C, 0x0301, 0x006C, 0x0301, 0x004C, 0x0327, 0x006C, 0x0327,
+	0x004C, 0x030C, 0x006C, 0x030C, 0x004C, 0x00B7, 0x006C, 0x00B7,
+	0x004E, 0x0301, 0x006E, 0x0301, 0x004E, 0x0327, 0x006E, 0x0327,
+	0x004E, 0x030C, 0x006E, 0x030C, 0x02BC, 0x006E, 0x004F, 0x0304,
+	0x006F, 0x0304, 0x004F, 0x0306, 0x006F, 0x0306, 0x004F, 0x030B,
+	0x006F, 0x030B, 0x0052, 0x0301, 0x0072, 0x0301, 0x0052, 0x0327,
+	0x0072, 0x0327, 0x0052, 0x030C, 0x0072, 0x030C, 0x0053, 0x0301,
+	0x0073, 0x0301, 0x0053, 0x0302, 0x0073, 0x0302, 0x0053, 0x0327,
+	0x0073, 0x0327, 0x0053, 0x030C, 0x0073, 0x030C, 0x0054, 0x0327,
+	0x0074, 0x0327, 0x0054, 0x030C, 0x0074, 0x030C, 0x0055, 0x0303,
+	0x0075, 0x0303, 0x0055, 0x0304, 0x0075, 0x0304, 0x0055, 0x0306,
+	0x0075, 0x0306, 0x0055, 0x030A, 0x0075, 0x030A, 0x0055, 0x030B,
+	0x0075, 0x030B, 0x0055, 0x0328, 0x0075, 0x0328, 0x0057, 0x0302,
+	0x0077, 0x0302, 0x0059, 0x0302, 0x0079, 0x0302, 0x0059, 0x0308,
+	0x005A, 0x0301, 0x007A, 0x0301, 0x005A, 0x0307, 0x007A, 0x0307,
+	0x005A, 0x030C, 0x007A, 0x030C, 0x0073, 0x004F, 0x031B, 0x006F,
+	0x031B, 0x0055, 0x031B, 0x0075, 0x031B, 0x0044, 0x005A, 0x030C,
+	0x0044, 0x007A, 0x030C, 0x0064, 0x007A, 0x030C, 0x004C, 0x004A,
+	0x004C, 0x006A, 0x006C, 0x006A, 0x004E, 0x004A, 0x004E, 0x006A,
+	0x006E, 0x006A, 0x0041, 0x030C, 0x0061, 0x030C, 0x0049, 0x030C,
+	0x0069, 0x030C, 0x004F, 0x030C, 0x006F, 0x030C, 0x0055, 0x030C,
+	0x0075, 0x030C, 0x0055, 0x0308, 0x0304, 0x0075, 0x0308, 0x0304,
+	0x0055, 0x0308, 0x0301, 0x0075, 0x0308, 0x0301, 0x0055, 0x0308,
+	0x030C, 0x0075, 0x0308, 0x030C, 0x0055, 0x0308, 0x0300, 0x0075,
+	0x0308, 0x0300, 0x0041, 0x0308, 0x0304, 0x0061, 0x0308, 0x0304,
+	0x0041, 0x0307, 0x0304, 0x0061, 0x0307, 0x0304, 0x00C6, 0x0304,
+	0x00E6, 0x0304, 0x0047, 0x030C, 0x0067, 0x030C, 0x004B, 0x030C,
+	0x006B, 0x030C, 0x004F, 0x0328, 0x006F, 0x0328, 0x004F, 0x0328,
+	0x0304, 0x006F, 0x0328, 0x0304, 0x01B7, 0x030C, 0x0292, 0x030C,
+	0x006A, 0x030C, 0x0044, 0x005A, 0x0044, 0x007A, 0x0064, 0x007A,
+	0x0047, 0x0301, 0x0067, 0x0301, 0x004E, 0x0300, 0x006E, 0x0300,
+	0x0041, 0x030A, 0x0301, 0x0061, 0x030A, 0x0301, 0x00C6, 0x0301,
+	0x00E6, 0x0301, 0x00D8, 0x0301, 0x00F8, 0x0301, 0x0041, 0x030F,
+	0x0061, 0x030F, 0x0041, 0x0311, 0x0061, 0x0311, 0x0045, 0x030F,
+	0x0065, 0x030F, 0x0045, 0x0311, 0x0065, 0x0311, 0x0049, 0x030F,
+	0x0069, 0x030F, 0x0049, 0x0311, 0x0069, 0x0311, 0x004F, 0x030F,
+	0x006F, 0x030F, 0x004F, 0x0311, 0x006F, 0x0311, 0x0052, 0x030F,
+	0x0072, 0x030F, 0x0052, 0x0311, 0x0072, 0x0311, 0x0055, 0x030F,
+	0x0075, 0x030F, 0x0055, 0x0311, 0x0075, 0x0311, 0x0053, 0x0326,
+	0x0073, 0x0326, 0x0054, 0x0326, 0x0074, 0x0326, 0x0048, 0x030C,
+	0x0068, 0x030C, 0x0041, 0x0307, 0x0061, 0x0307, 0x0045, 0x0327,
+	0x0065, 0x0327, 0x004F, 0x0308, 0x0304, 0x006F, 0x0308, 0x0304,
+	0x004F, 0x0303, 0x0304, 0x006F, 0x0303, 0x0304, 0x004F, 0x0307,
+	0x006F, 0x0307, 0x004F, 0x0307, 0x0304, 0x006F, 0x0307, 0x0304,
+	0x0059, 0x0304, 0x0079, 0x0304, 0x0068, 0x0266, 0x006A, 0x0072,
+	0x0279, 0x027B, 0x0281, 0x0077, 0x0079, 0x0020, 0x0306, 0x0020,
+	0x0307, 0x0020, 0x030A, 0x0020, 0x0328, 0x0020, 0x0303, 0x0020,
+	0x030B, 0x0263, 0x006C, 0x0078, 0x0295, 0x0300, 0x0301, 0x0313,
+	0x0308, 0x0301, 0x02B9, 0x0020, 0x0345, 0x003B, 0x00A8, 0x0301,
+	0x0020, 0x0308, 0x0301, 0x0391, 0x0301, 0x00B7, 0x0395, 0x0301,
+	0x0397, 0x0301, 0x0399, 0x0301, 0x039F, 0x0301, 0x03A5, 0x0301,
+	0x03A9, 0x0301, 0x03B9, 0x0308, 0x0301, 0x0399, 0x0308, 0x03A5,
+	0x0308, 0x03B1, 0x0301, 0x03B5, 0x0301, 0x03B7, 0x0301, 0x03B9,
+	0x0301, 0x03C5, 0x0308, 0x0301, 0x03B9, 0x0308, 0x03C5, 0x0308,
+	0x03BF, 0x0301, 0x03C5, 0x0301, 0x03C9, 0x0301, 0x03B2, 0x03B8,
+	0x03A5, 0x03D2, 0x0301, 0x03D2, 0x0308, 0x03C6, 0x03C0, 0x03BA,
+	0x03C1, 0x03C2, 0x0398, 0x03B5, 0x03A3, 0x0415, 0x0300, 0x0415,
+	0x0308, 0x0413, 0x0301, 0x0406, 0x0308, 0x041A, 0x0301, 0x0418,
+	0x0300, 0x0423, 0x0306, 0x0418, 0x0306, 0x0438, 0x0306, 0x0435,
+	0x0300, 0x0435, 0x0308, 0x0433, 0x0301, 0x0456, 0x0308, 0x043A,
+	0x0301, 0x0438, 0x0300, 0x0443, 0x0306, 0x0474, 0x030F, 0x0475,
+	0x030F, 0x0416, 0x0306, 0x0436, 0x0306, 0x0410, 0x0306, 0x0430,
+	0x0306, 0x0410, 0x0308, 0x0430, 0x0308, 0x0415, 0x0306, 0x0435,
+	0x0306, 0x04D8, 0x0308, 0x04D9, 0x0308, 0x0416, 0x0308, 0x0436,
+	0x0308, 0x0417, 0x0308, 0x0437, 0x0308, 0x0418, 0x0304, 0x0438,
+	0x0304, 0x0418, 0x0308, 0x0438, 0x0308, 0x041E, 0x0308, 0x043E,
+	0x0308, 0x04E8, 0x0308, 0x04E9, 0x0308, 0x042D, 0x0308, 0x044D,
+	0x0308, 0x0423, 0x0304, 0x0443, 0x0304, 0x0423, 0x0308, 0x0443,
+	0x0308, 0x0423, 0x030B, 0x0443, 0x030B, 0x0427, 0x0308, 0x0447,
+	0x0308, 0x042B, 0x0308, 0x044B, 0x0308, 0x0565, 0x0582, 0x0627,
+	0x0653, 0x0627, 0x0654, 0x0648, 0x0654, 0x0627, 0x0655, 0x064A,
+	0x0654, 0x0627, 0x0674, 0x0648, 0x0674, 0x06C7, 0x0674, 0x064A,
+	0x0674, 0x06D5, 0x0654, 0x06C1, 0x0654, 0x06D2, 0x0654, 0x0928,
+	0x093C, 0x0930, 0x093C, 0x0933, 0x093C, 0x0915, 0x093C, 0x0916,
+	0x093C, 0x0917, 0x093C, 0x091C, 0x093C, 0x0921, 0x093C, 0x0922,
+	0x093C, 0x092B, 0x093C, 0x092F, 0x093C, 0x09C7, 0x09BE, 0x09C7,
+	0x09D7, 0x09A1, 0x09BC, 0x09A2, 0x09BC, 0x09AF, 0x09BC, 0x0A32,
+	0x0A3C, 0x0A38, 0x0A3C, 0x0A16, 0x0A3C, 0x0A17, 0x0A3C, 0x0A1C,
+	0x0A3C, 0x0A2B, 0x0A3C, 0x0B47, 0x0B56, 0x0B47, 0x0B3E, 0x0B47,
+	0x0B57, 0x0B21, 0x0B3C, 0x0B22, 0x0B3C, 0x0B92, 0x0BD7, 0x0BC6,
+	0x0BBE, 0x0BC7, 0x0BBE, 0x0BC6, 0x0BD7, 0x0C46, 0x0C56, 0x0CBF,
+	0x0CD5, 0x0CC6, 0x0CD5, 0x0CC6, 0x0CD6, 0x0CC6, 0x0CC2, 0x0CC6,
+	0x0CC2, 0x0CD5, 0x0D46, 0x0D3E, 0x0D47, 0x0D3E, 0x0D46, 0x0D57,
+	0x0DD9, 0x0DCA, 0x0DD9, 0x0DCF, 0x0DD9, 0x0DCF, 0x0DCA, 0x0DD9,
+	0x0DDF, 0x0E4D, 0x0E32, 0x0ECD, 0x0EB2, 0x0EAB, 0x0E99, 0x0EAB,
+	0x0EA1, 0x0F0B, 0x0F42, 0x0FB7, 0x0F4C, 0x0FB7, 0x0F51, 0x0FB7,
+	0x0F56, 0x0FB7, 0x0F5B, 0x0FB7, 0x0F40, 0x0FB5, 0x0F71, 0x0F72,
+	0x0F71, 0x0F74, 0x0FB2, 0x0F80, 0x0FB2, 0x0F71, 0x0F80, 0x0FB3,
+	0x0F80, 0x0FB3, 0x0F71, 0x0F80, 0x0F71, 0x0F80, 0x0F92, 0x0FB7,
+	0x0F9C, 0x0FB7, 0x0FA1, 0x0FB7, 0x0FA6, 0x0FB7, 0x0FAB, 0x0FB7,
+	0x0F90, 0x0FB5, 0x1025, 0x102E, 0x10DC, 0x1B05, 0x1B35, 0x1B07,
+	0x1B35, 0x1B09, 0x1B35, 0x1B0B, 0x1B35, 0x1B0D, 0x1B35, 0x1B11,
+	0x1B35, 0x1B3A, 0x1B35, 0x1B3C, 0x1B35, 0x1B3E, 0x1B35, 0x1B3F,
+	0x1B35, 0x1B42, 0x1B35, 0x0041, 0x00C6, 0x0042, 0x0044, 0x0045,
+	0x018E, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D,
+	0x004E, 0x004F, 0x0222, 0x0050, 0x0052, 0x0054, 0x0055, 0x0057,
+	0x0250, 0x0251, 0x1D02, 0x0062, 0x0064, 0x0065, 0x0259, 0x025B,
+	0x025C, 0x0067, 0x006B, 0x006D, 0x014B, 0x0254, 0x1D16, 0x1D17,
+	0x0070, 0x0074, 0x0075, 0x1D1D, 0x026F, 0x0076, 0x1D25, 0x03B3,
+	0x03B4, 0x03C7, 0x0069, 0x043D, 0x0252, 0x0063, 0x0255, 0x00F0,
+	0x0066, 0x025F, 0x0261, 0x0265, 0x0268, 0x0269, 0x026A, 0x1D7B,
+	0x029D, 0x026D, 0x1D85, 0x029F, 0x0271, 0x0270, 0x0272, 0x0273,
+	0x0274, 0x0275, 0x0278, 0x0282, 0x0283, 0x01AB, 0x0289, 0x028A,
+	0x1D1C, 0x028B, 0x028C, 0x007A, 0x0290, 0x0291, 0x0292, 0x0041,
+	0x0325, 0x0061, 0x0325, 0x0042, 0x0307, 0x0062, 0x0307, 0x0042,
+	0x0323, 0x0062, 0x0323, 0x0042, 0x0331, 0x0062, 0x0331, 0x0043,
+	0x0327, 0x0301, 0x0063, 0x0327, 0x0301, 0x0044, 0x0307, 0x0064,
+	0x0307, 0x0044, 0x0323, 0x0064, 0x0323, 0x0044, 0x0331, 0x0064,
+	0x0331, 0x0044, 0x0327, 0x0064, 0x0327, 0x0044, 0x032D, 0x0064,
+	0x032D, 0x0045, 0x0304, 0x0300, 0x0065, 0x0304, 0x0300, 0x0045,
+	0x0304, 0x0301, 0x0065, 0x0304, 0x0301, 0x0045, 0x032D, 0x0065,
+	0x032D, 0x0045, 0x0330, 0x0065, 0x0330, 0x0045, 0x0327, 0x0306,
+	0x0065, 0x0327, 0x0306, 0x0046, 0x0307, 0x0066, 0x0307, 0x0047,
+	0x0304, 0x0067, 0x0304, 0x0048, 0x0307, 0x0068, 0x0307, 0x0048,
+	0x0323, 0x0068, 0x0323, 0x0048, 0x0308, 0x0068, 0x0308, 0x0048,
+	0x0327, 0x0068, 0x0327, 0x0048, 0x032E, 0x0068, 0x032E, 0x0049,
+	0x0330, 0x0069, 0x0330, 0x0049, 0x0308, 0x0301, 0x0069, 0x0308,
+	0x0301, 0x004B, 0x0301, 0x006B, 0x0301, 0x004B, 0x0323, 0x006B,
+	0x0323, 0x004B, 0x0331, 0x006B, 0x0331, 0x004C, 0x0323, 0x006C,
+	0x0323, 0x004C, 0x0323, 0x0304, 0x006C, 0x0323, 0x0304, 0x004C,
+	0x0331, 0x006C, 0x0331, 0x004C, 0x032D, 0x006C, 0x032D, 0x004D,
+	0x0301, 0x006D, 0x0301, 0x004D, 0x0307, 0x006D, 0x0307, 0x004D,
+	0x0323, 0x006D, 0x0323, 0x004E, 0x0307, 0x006E, 0x0307, 0x004E,
+	0x0323, 0x006E, 0x0323, 0x004E, 0x0331, 0x006E, 0x0331, 0x004E,
+	0x032D, 0x006E, 0x032D, 0x004F, 0x0303, 0x0301, 0x006F, 0x0303,
+	0x0301, 0x004F, 0x0303, 0x0308, 0x006F, 0x0303, 0x0308, 0x004F,
+	0x0304, 0x0300, 0x006F, 0x0304, 0x0300, 0x004F, 0x0304, 0x0301,
+	0x006F, 0x0304, 0x0301, 0x0050, 0x0301, 0x0070, 0x0301, 0x0050,
+	0x0307, 0x0070, 0x0307, 0x0052, 0x0307, 0x0072, 0x0307, 0x0052,
+	0x0323, 0x0072, 0x0323, 0x0052, 0x0323, 0x0304, 0x0072, 0x0323,
+	0x0304, 0x0052, 0x0331, 0x0072, 0x0331, 0x0053, 0x0307, 0x0073,
+	0x0307, 0x0053, 0x0323, 0x0073, 0x0323, 0x0053, 0x0301, 0x0307,
+	0x0073, 0x0301, 0x0307, 0x0053, 0x030C, 0x0307, 0x0073, 0x030C,
+	0x0307, 0x0053, 0x0323, 0x0307, 0x0073, 0x0323, 0x0307, 0x0054,
+	0x0307, 0x0074, 0x0307, 0x0054, 0x0323, 0x0074, 0x0323, 0x0054,
+	0x0331, 0x0074, 0x0331, 0x0054, 0x032D, 0x0074, 0x032D, 0x0055,
+	0x0324, 0x0075, 0x0324, 0x0055, 0x0330, 0x0075, 0x0330, 0x0055,
+	0x032D, 0x0075, 0x032D, 0x0055, 0x0303, 0x0301, 0x0075, 0x0303,
+	0x0301, 0x0055, 0x0304, 0x0308, 0x0075, 0x0304, 0x0308, 0x0056,
+	0x0303, 0x0076, 0x0303, 0x0056, 0x0323, 0x0076, 0x0323, 0x0057,
+	0x0300, 0x0077, 0x0300, 0x0057, 0x0301, 0x0077, 0x0301, 0x0057,
+	0x0308, 0x0077, 0x0308, 0x0057, 0x0307, 0x0077, 0x0307, 0x0057,
+	0x0323, 0x0077, 0x0323, 0x0058, 0x0307, 0x0078, 0x0307, 0x0058,
+	0x0308, 0x0078, 0x0308, 0x0059, 0x0307, 0x0079, 0x0307, 0x005A,
+	0x0302, 0x007A, 0x0302, 0x005A, 0x0323, 0x007A, 0x0323, 0x005A,
+	0x0331, 0x007A, 0x0331, 0x0068, 0x0331, 0x0074, 0x0308, 0x0077,
+	0x030A, 0x0079, 0x030A, 0x0061, 0x02BE, 0x017F, 0x0307, 0x0041,
+	0x0323, 0x0061, 0x0323, 0x0041, 0x0309, 0x0061, 0x0309, 0x0041,
+	0x0302, 0x0301, 0x0061, 0x0302, 0x0301, 0x0041, 0x0302, 0x0300,
+	0x0061, 0x0302, 0x0300, 0x0041, 0x0302, 0x0309, 0x0061, 0x0302,
+	0x0309, 0x0041, 0x0302, 0x0303, 0x0061, 0x0302, 0x0303, 0x0041,
+	0x0323, 0x0302, 0x0061, 0x0323, 0x0302, 0x0041, 0x0306, 0x0301,
+	0x0061, 0x0306, 0x0301, 0x0041, 0x0306, 0x0300, 0x0061, 0x0306,
+	0x0300, 0x0041, 0x0306, 0x0309, 0x0061, 0x0306, 0x0309, 0x0041,
+	0x0306, 0x0303, 0x0061, 0x0306, 0x0303, 0x0041, 0x0323, 0x0306,
+	0x0061, 0x0323, 0x0306, 0x0045, 0x0323, 0x0065, 0x0323, 0x0045,
+	0x0309, 0x0065, 0x0309, 0x0045, 0x0303, 0x0065, 0x0303, 0x0045,
+	0x0302, 0x0301, 0x0065, 0x0302, 0x0301, 0x0045, 0x0302, 0x0300,
+	0x0065, 0x0302, 0x0300, 0x0045, 0x0302, 0x0309, 0x0065, 0x0302,
+	0x0309, 0x0045, 0x0302, 0x0303, 0x0065, 0x0302, 0x0303, 0x0045,
+	0x0323, 0x0302, 0x0065, 0x0323, 0x0302, 0x0049, 0x0309, 0x0069,
+	0x0309, 0x0049, 0x0323, 0x0069, 0x0323, 0x004F, 0x0323, 0x006F,
+	0x0323, 0x004F, 0x0309, 0x006F, 0x0309, 0x004F, 0x0302, 0x0301,
+	0x006F, 0x0302, 0x0301, 0x004F, 0x0302, 0x0300, 0x006F, 0x0302,
+	0x0300, 0x004F, 0x0302, 0x0309, 0x006F, 0x0302, 0x0309, 0x004F,
+	0x0302, 0x0303, 0x006F, 0x0302, 0x0303, 0x004F, 0x0323, 0x0302,
+	0x006F, 0x0323, 0x0302, 0x004F, 0x031B, 0x0301, 0x006F, 0x031B,
+	0x0301, 0x004F, 0x031B, 0x0300, 0x006F, 0x031B, 0x0300, 0x004F,
+	0x031B, 0x0309, 0x006F, 0x031B, 0x0309, 0x004F, 0x031B, 0x0303,
+	0x006F, 0x031B, 0x0303, 0x004F, 0x031B, 0x0323, 0x006F, 0x031B,
+	0x0323, 0x0055, 0x0323, 0x0075, 0x0323, 0x0055, 0x0309, 0x0075,
+	0x0309, 0x0055, 0x031B, 0x0301, 0x0075, 0x031B, 0x0301, 0x0055,
+	0x031B, 0x0300, 0x0075, 0x031B, 0x0300, 0x0055, 0x031B, 0x0309,
+	0x0075, 0x031B, 0x0309, 0x0055, 0x031B, 0x0303, 0x0075, 0x031B,
+	0x0303, 0x0055, 0x031B, 0x0323, 0x0075, 0x031B, 0x0323, 0x0059,
+	0x0300, 0x0079, 0x0300, 0x0059, 0x0323, 0x0079, 0x0323, 0x0059,
+	0x0309, 0x0079, 0x0309, 0x0059, 0x0303, 0x0079, 0x0303, 0x03B1,
+	0x0313, 0x03B1, 0x0314, 0x03B1, 0x0313, 0x0300, 0x03B1, 0x0314,
+	0x0300, 0x03B1, 0x0313, 0x0301, 0x03B1, 0x0314, 0x0301, 0x03B1,
+	0x0313, 0x0342, 0x03B1, 0x0314, 0x0342, 0x0391, 0x0313, 0x0391,
+	0x0314, 0x0391, 0x0313, 0x0300, 0x0391, 0x0314, 0x0300, 0x0391,
+	0x0313, 0x0301, 0x0391, 0x0314, 0x0301, 0x0391, 0x0313, 0x0342,
+	0x0391, 0x0314, 0x0342, 0x03B5, 0x0313, 0x03B5, 0x0314, 0x03B5,
+	0x0313, 0x0300, 0x03B5, 0x0314, 0x0300, 0x03B5, 0x0313, 0x0301,
+	0x03B5, 0x0314, 0x0301, 0x0395, 0x0313, 0x0395, 0x0314, 0x0395,
+	0x0313, 0x0300, 0x0395, 0x0314, 0x0300, 0x0395, 0x0313, 0x0301,
+	0x0395, 0x0314, 0x0301, 0x03B7, 0x0313, 0x03B7, 0x0314, 0x03B7,
+	0x0313, 0x0300, 0x03B7, 0x0314, 0x0300, 0x03B7, 0x0313, 0x0301,
+	0x03B7, 0x0314, 0x0301, 0x03B7, 0x0313, 0x0342, 0x03B7, 0x0314,
+	0x0342, 0x0397, 0x0313, 0x0397, 0x0314, 0x0397, 0x0313, 0x0300,
+	0x0397, 0x0314, 0x0300, 0x0397, 0x0313, 0x0301, 0x0397, 0x0314,
+	0x0301, 0x0397, 0x0313, 0x0342, 0x0397, 0x0314, 0x0342, 0x03B9,
+	0x0313, 0x03B9, 0x0314, 0x03B9, 0x0313, 0x0300, 0x03B9, 0x0314,
+	0x0300, 0x03B9, 0x0313, 0x0301, 0x03B9, 0x0314, 0x0301, 0x03B9,
+	0x0313, 0x0342, 0x03B9, 0x0314, 0x0342, 0x0399, 0x0313, 0x0399,
+	0x0314, 0x0399, 0x0313, 0x0300, 0x0399, 0x0314, 0x0300, 0x0399,
+	0x0313, 0x0301, 0x0399, 0x0314, 0x0301, 0x0399, 0x0313, 0x0342,
+	0x0399, 0x0314, 0x0342, 0x03BF, 0x0313, 0x03BF, 0x0314, 0x03BF,
+	0x0313, 0x0300, 0x03BF, 0x0314, 0x0300, 0x03BF, 0x0313, 0x0301,
+	0x03BF, 0x0314, 0x0301, 0x039F, 0x0313, 0x039F, 0x0314, 0x039F,
+	0x0313, 0x0300, 0x039F, 0x0314, 0x0300, 0x039F, 0x0313, 0x0301,
+	0x039F, 0x0314, 0x0301, 0x03C5, 0x0313, 0x03C5, 0x0314, 0x03C5,
+	0x0313, 0x0300, 0x03C5, 0x0314, 0x0300, 0x03C5, 0x0313, 0x0301,
+	0x03C5, 0x0314, 0x0301, 0x03C5, 0x0313, 0x0342, 0x03C5, 0x0314,
+	0x0342, 0x03A5, 0x0314, 0x03A5, 0x0314, 0x0300, 0x03A5, 0x0314,
+	0x0301, 0x03A5, 0x0314, 0x0342, 0x03C9, 0x0313, 0x03C9, 0x0314,
+	0x03C9, 0x0313, 0x0300, 0x03C9, 0x0314, 0x0300, 0x03C9, 0x0313,
+	0x0301, 0x03C9, 0x0314, 0x0301, 0x03C9, 0x0313, 0x0342, 0x03C9,
+	0x0314, 0x0342, 0x03A9, 0x0313, 0x03A9, 0x0314, 0x03A9, 0x0313,
+	0x0300, 0x03A9, 0x0314, 0x0300, 0x03A9, 0x0313, 0x0301, 0x03A9,
+	0x0314, 0x0301, 0x03A9, 0x0313, 0x0342, 0x03A9, 0x0314, 0x0342,
+	0x03B1, 0x0300, 0x03B5, 0x0300, 0x03B7, 0x0300, 0x03B9, 0x0300,
+	0x03BF, 0x0300, 0x03C5, 0x0300, 0x03C9, 0x0300, 0x03B1, 0x0313,
+	0x0345, 0x03B1, 0x0314, 0x0345, 0x03B1, 0x0313, 0x0300, 0x0345,
+	0x03B1, 0x0314, 0x0300, 0x0345, 0x03B1, 0x0313, 0x0301, 0x0345,
+	0x03B1, 0x0314, 0x0301, 0x0345, 0x03B1, 0x0313, 0x0342, 0x0345,
+	0x03B1, 0x0314, 0x0342, 0x0345, 0x0391, 0x0313, 0x0345, 0x0391,
+	0x0314, 0x0345, 0x0391, 0x0313, 0x0300, 0x0345, 0x0391, 0x0314,
+	0x0300, 0x0345, 0x0391, 0x0313, 0x0301, 0x0345, 0x0391, 0x0314,
+	0x0301, 0x0345, 0x0391, 0x0313, 0x0342, 0x0345, 0x0391, 0x0314,
+	0x0342, 0x0345, 0x03B7, 0x0313, 0x0345, 0x03B7, 0x0314, 0x0345,
+	0x03B7, 0x0313, 0x0300, 0x0345, 0x03B7, 0x0314, 0x0300, 0x0345,
+	0x03B7, 0x0313, 0x0301, 0x0345, 0x03B7, 0x0314, 0x0301, 0x0345,
+	0x03B7, 0x0313, 0x0342, 0x0345, 0x03B7, 0x0314, 0x0342, 0x0345,
+	0x0397, 0x0313, 0x0345, 0x0397, 0x0314, 0x0345, 0x0397, 0x0313,
+	0x0300, 0x0345, 0x0397, 0x0314, 0x0300, 0x0345, 0x0397, 0x0313,
+	0x0301, 0x0345, 0x0397, 0x0314, 0x0301, 0x0345, 0x0397, 0x0313,
+	0x0342, 0x0345, 0x0397, 0x0314, 0x0342, 0x0345, 0x03C9, 0x0313,
+	0x0345, 0x03C9, 0x0314, 0x0345, 0x03C9, 0x0313, 0x0300, 0x0345,
+	0x03C9, 0x0314, 0x0300, 0x0345, 0x03C9, 0x0313, 0x0301, 0x0345,
+	0x03C9, 0x0314, 0x0301, 0x0345, 0x03C9, 0x0313, 0x0342, 0x0345,
+	0x03C9, 0x0314, 0x0342, 0x0345, 0x03A9, 0x0313, 0x0345, 0x03A9,
+	0x0314, 0x0345, 0x03A9, 0x0313, 0x0300, 0x0345, 0x03A9, 0x0314,
+	0x0300, 0x0345, 0x03A9, 0x0313, 0x0301, 0x0345, 0x03A9, 0x0314,
+	0x0301, 0x0345, 0x03A9, 0x0313, 0x0342, 0x0345, 0x03A9, 0x0314,
+	0x0342, 0x0345, 0x03B1, 0x0306, 0x03B1, 0x0304, 0x03B1, 0x0300,
+	0x0345, 0x03B1, 0x0345, 0x03B1, 0x0301, 0x0345, 0x03B1, 0x0342,
+	0x03B1, 0x0342, 0x0345, 0x0391, 0x0306, 0x0391, 0x0304, 0x0391,
+	0x0300, 0x0391, 0x0345, 0x0020, 0x0313, 0x03B9, 0x0020, 0x0342,
+	0x00A8, 0x0342, 0x0020, 0x0308, 0x0342, 0x03B7, 0x0300, 0x0345,
+	0x03B7, 0x0345, 0x03B7, 0x0301, 0x0345, 0x03B7, 0x0342, 0x03B7,
+	0x0342, 0x0345, 0x0395, 0x0300, 0x0397, 0x0300, 0x0397, 0x0345,
+	0x1FBF, 0x0300, 0x0020, 0x0313, 0x0300, 0x1FBF, 0x0301, 0x0020,
+	0x0313, 0x0301, 0x1FBF, 0x0342, 0x0020, 0x0313, 0x0342, 0x03B9,
+	0x0306, 0x03B9, 0x0304, 0x03B9, 0x0308, 0x0300, 0x03B9, 0x0342,
+	0x03B9, 0x0308, 0x0342, 0x0399, 0x0306, 0x0399, 0x0304, 0x0399,
+	0x0300, 0x1FFE, 0x0300, 0x0020, 0x0314, 0x0300, 0x1FFE, 0x0301,
+	0x0020, 0x0314, 0x0301, 0x1FFE, 0x0342, 0x0020, 0x0314, 0x0342,
+	0x03C5, 0x0306, 0x03C5, 0x0304, 0x03C5, 0x0308, 0x0300, 0x03C1,
+	0x0313, 0x03C1, 0x0314, 0x03C5, 0x0342, 0x03C5, 0x0308, 0x0342,
+	0x03A5, 0x0306, 0x03A5, 0x0304, 0x03A5, 0x0300, 0x03A1, 0x0314,
+	0x00A8, 0x0300, 0x0020, 0x0308, 0x0300, 0x0060, 0x03C9, 0x0300,
+	0x0345, 0x03C9, 0x0345, 0x03C9, 0x0301, 0x0345, 0x03C9, 0x0342,
+	0x03C9, 0x0342, 0x0345, 0x039F, 0x0300, 0x03A9, 0x0300, 0x03A9,
+	0x0345, 0x00B4, 0x0020, 0x0314, 0x2002, 0x2003, 0x2010, 0x0020,
+	0x0333, 0x002E, 0x002E, 0x002E, 0x002E, 0x002E, 0x002E, 0x2032,
+	0x2032, 0x2032, 0x2032, 0x2032, 0x2035, 0x2035, 0x2035, 0x2035,
+	0x2035, 0x0021, 0x0021, 0x0020, 0x0305, 0x003F, 0x003F, 0x003F,
+	0x0021, 0x0021, 0x003F, 0x2032, 0x2032, 0x2032, 0x2032, 0x0030,
+	0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x002B, 0x2212,
+	0x003D, 0x0028, 0x0029, 0x006E, 0x0052, 0x0073, 0x0061, 0x002F,
+	0x0063, 0x0061, 0x002F, 0x0073, 0x0043, 0x00B0, 0x0043, 0x0063,
+	0x002F, 0x006F, 0x0063, 0x002F, 0x0075, 0x0190, 0x00B0, 0x0046,
+	0x0127, 0x004E, 0x006F, 0x0051, 0x0053, 0x004D, 0x0054, 0x0045,
+	0x004C, 0x0054, 0x004D, 0x005A, 0x03A9, 0x0046, 0x05D0, 0x05D1,
+	0x05D2, 0x05D3, 0x0046, 0x0041, 0x0058, 0x0393, 0x03A0, 0x2211,
+	0x0031, 0x2044, 0x0037, 0x0031, 0x2044, 0x0039, 0x0031, 0x2044,
+	0x0031, 0x0030, 0x0031, 0x2044, 0x0033, 0x0032, 0x2044, 0x0033,
+	0x0031, 0x2044, 0x0035, 0x0032, 0x2044, 0x0035, 0x0033, 0x2044,
+	0x0035, 0x0034, 0x2044, 0x0035, 0x0031, 0x2044, 0x0036, 0x0035,
+	0x2044, 0x0036, 0x0031, 0x2044, 0x0038, 0x0033, 0x2044, 0x0038,
+	0x0035, 0x2044, 0x0038, 0x0037, 0x2044, 0x0038, 0x0031, 0x2044,
+	0x0049, 0x0049, 0x0049, 0x0049, 0x0049, 0x0049, 0x0056, 0x0056,
+	0x0056, 0x0049, 0x0056, 0x0049, 0x0049, 0x0056, 0x0049, 0x0049,
+	0x0049, 0x0049, 0x0058, 0x0058, 0x0058, 0x0049, 0x0058, 0x0049,
+	0x0049, 0x0069, 0x0069, 0x0069, 0x0069, 0x0069, 0x0069, 0x0076,
+	0x0076, 0x0069, 0x0076, 0x0069, 0x0069, 0x0076, 0x0069, 0x0069,
+	0x0069, 0x0069, 0x0078, 0x0078, 0x0069, 0x0078, 0x0069, 0x0069,
+	0x0030, 0x2044, 0x0033, 0x2190, 0x0338, 0x2192, 0x0338, 0x2194,
+	0x0338, 0x21D0, 0x0338, 0x21D4, 0x0338, 0x21D2, 0x0338, 0x2203,
+	0x0338, 0x2208, 0x0338, 0x220B, 0x0338, 0x2223, 0x0338, 0x2225,
+	0x0338, 0x222B, 0x222B, 0x222B, 0x222B, 0x222B, 0x222E, 0x222E,
+	0x222E, 0x222E, 0x222E, 0x223C, 0x0338, 0x2243, 0x0338, 0x2245,
+	0x0338, 0x2248, 0x0338, 0x003D, 0x0338, 0x2261, 0x0338, 0x224D,
+	0x0338, 0x003C, 0x0338, 0x003E, 0x0338, 0x2264, 0x0338, 0x2265,
+	0x0338, 0x2272, 0x0338, 0x2273, 0x0338, 0x2276, 0x0338, 0x2277,
+	0x0338, 0x227A, 0x0338, 0x227B, 0x0338, 0x2282, 0x0338, 0x2283,
+	0x0338, 0x2286, 0x0338, 0x2287, 0x0338, 0x22A2, 0x0338, 0x22A8,
+	0x0338, 0x22A9, 0x0338, 0x22AB, 0x0338, 0x227C, 0x0338, 0x227D,
+	0x0338, 0x2291, 0x0338, 0x2292, 0x0338, 0x22B2, 0x0338, 0x22B3,
+	0x0338, 0x22B4, 0x0338, 0x22B5, 0x0338, 0x3008, 0x3009, 0x0031,
+	0x0030, 0x0031, 0x0031, 0x0031, 0x0032, 0x0031, 0x0033, 0x0031,
+	0x0034, 0x0031, 0x0035, 0x0031, 0x0036, 0x0031, 0x0037, 0x0031,
+	0x0038, 0x0031, 0x0039, 0x0032, 0x0030, 0x0028, 0x0031, 0x0029,
+	0x0028, 0x0032, 0x0029, 0x0028, 0x0033, 0x0029, 0x0028, 0x0034,
+	0x0029, 0x0028, 0x0035, 0x0029, 0x0028, 0x0036, 0x0029, 0x0028,
+	0x0037, 0x0029, 0x0028, 0x0038, 0x0029, 0x0028, 0x0039, 0x0029,
+	0x0028, 0x0031, 0x0030, 0x0029, 0x0028, 0x0031, 0x0031, 0x0029,
+	0x0028, 0x0031, 0x0032, 0x0029, 0x0028, 0x0031, 0x0033, 0x0029,
+	0x0028, 0x0031, 0x0034, 0x0029, 0x0028, 0x0031, 0x0035, 0x0029,
+	0x0028, 0x0031, 0x0036, 0x0029, 0x0028, 0x0031, 0x0037, 0x0029,
+	0x0028, 0x0031, 0x0038, 0x0029, 0x0028, 0x0031, 0x0039, 0x0029,
+	0x0028, 0x0032, 0x0030, 0x0029, 0x0031, 0x002E, 0x0032, 0x002E,
+	0x0033, 0x002E, 0x0034, 0x002E, 0x0035, 0x002E, 0x0036, 0x002E,
+	0x0037, 0x002E, 0x0038, 0x002E, 0x0039, 0x002E, 0x0031, 0x0030,
+	0x002E, 0x0031, 0x0031, 0x002E, 0x0031, 0x0032, 0x002E, 0x0031,
+	0x0033, 0x002E, 0x0031, 0x0034, 0x002E, 0x0031, 0x0035, 0x002E,
+	0x0031, 0x0036, 0x002E, 0x0031, 0x0037, 0x002E, 0x0031, 0x0038,
+	0x002E, 0x0031, 0x0039, 0x002E, 0x0032, 0x0030, 0x002E, 0x0028,
+	0x0061, 0x0029, 0x0028, 0x0062, 0x0029, 0x0028, 0x0063, 0x0029,
+	0x0028, 0x0064, 0x0029, 0x0028, 0x0065, 0x0029, 0x0028, 0x0066,
+	0x0029, 0x0028, 0x0067, 0x0029, 0x0028, 0x0068, 0x0029, 0x0028,
+	0x0069, 0x0029, 0x0028, 0x006A, 0x0029, 0x0028, 0x006B, 0x0029,
+	0x0028, 0x006C, 0x0029, 0x0028, 0x006D, 0x0029, 0x0028, 0x006E,
+	0x0029, 0x0028, 0x006F, 0x0029, 0x0028, 0x0070, 0x0029, 0x0028,
+	0x0071, 0x0029, 0x0028, 0x0072, 0x0029, 0x0028, 0x0073, 0x0029,
+	0x0028, 0x0074, 0x0029, 0x0028, 0x0075, 0x0029, 0x0028, 0x0076,
+	0x0029, 0x0028, 0x0077, 0x0029, 0x0028, 0x0078, 0x0029, 0x0028,
+	0x0079, 0x0029, 0x0028, 0x007A, 0x0029, 0x0053, 0x0059, 0x0071,
+	0x222B, 0x222B, 0x222B, 0x222B, 0x003A, 0x003A, 0x003D, 0x003D,
+	0x003D, 0x003D, 0x003D, 0x003D, 0x2ADD, 0x0338, 0x2D61, 0x6BCD,
+	0x9F9F, 0x4E00, 0x4E28, 0x4E36, 0x4E3F, 0x4E59, 0x4E85, 0x4E8C,
+	0x4EA0, 0x4EBA, 0x513F, 0x5165, 0x516B, 0x5182, 0x5196, 0x51AB,
+	0x51E0, 0x51F5, 0x5200, 0x529B, 0x52F9, 0x5315, 0x531A, 0x5338,
+	0x5341, 0x535C, 0x5369, 0x5382, 0x53B6, 0x53C8, 0x53E3, 0x56D7,
+	0x571F, 0x58EB, 0x5902, 0x590A, 0x5915, 0x5927, 0x5973, 0x5B50,
+	0x5B80, 0x5BF8, 0x5C0F, 0x5C22, 0x5C38, 0x5C6E, 0x5C71, 0x5DDB,
+	0x5DE5, 0x5DF1, 0x5DFE, 0x5E72, 0x5E7A, 0x5E7F, 0x5EF4, 0x5EFE,
+	0x5F0B, 0x5F13, 0x5F50, 0x5F61, 0x5F73, 0x5FC3, 0x6208, 0x6236,
+	0x624B, 0x652F, 0x6534, 0x6587, 0x6597, 0x65A4, 0x65B9, 0x65E0,
+	0x65E5, 0x66F0, 0x6708, 0x6728, 0x6B20, 0x6B62, 0x6B79, 0x6BB3,
+	0x6BCB, 0x6BD4, 0x6BDB, 0x6C0F, 0x6C14, 0x6C34, 0x706B, 0x722A,
+	0x7236, 0x723B, 0x723F, 0x7247, 0x7259, 0x725B, 0x72AC, 0x7384,
+	0x7389, 0x74DC, 0x74E6, 0x7518, 0x751F, 0x7528, 0x7530, 0x758B,
+	0x7592, 0x7676, 0x767D, 0x76AE, 0x76BF, 0x76EE, 0x77DB, 0x77E2,
+	0x77F3, 0x793A, 0x79B8, 0x79BE, 0x7A74, 0x7ACB, 0x7AF9, 0x7C73,
+	0x7CF8, 0x7F36, 0x7F51, 0x7F8A, 0x7FBD, 0x8001, 0x800C, 0x8012,
+	0x8033, 0x807F, 0x8089, 0x81E3, 0x81EA, 0x81F3, 0x81FC, 0x820C,
+	0x821B, 0x821F, 0x826E, 0x8272, 0x8278, 0x864D, 0x866B, 0x8840,
+	0x884C, 0x8863, 0x897E, 0x898B, 0x89D2, 0x8A00, 0x8C37, 0x8C46,
+	0x8C55, 0x8C78, 0x8C9D, 0x8D64, 0x8D70, 0x8DB3, 0x8EAB, 0x8ECA,
+	0x8F9B, 0x8FB0, 0x8FB5, 0x9091, 0x9149, 0x91C6, 0x91CC, 0x91D1,
+	0x9577, 0x9580, 0x961C, 0x96B6, 0x96B9, 0x96E8, 0x9751, 0x975E,
+	0x9762, 0x9769, 0x97CB, 0x97ED, 0x97F3, 0x9801, 0x98A8, 0x98DB,
+	0x98DF, 0x9996, 0x9999, 0x99AC, 0x9AA8, 0x9AD8, 0x9ADF, 0x9B25,
+	0x9B2F, 0x9B32, 0x9B3C, 0x9B5A, 0x9CE5, 0x9E75, 0x9E7F, 0x9EA5,
+	0x9EBB, 0x9EC3, 0x9ECD, 0x9ED1, 0x9EF9, 0x9EFD, 0x9F0E, 0x9F13,
+	0x9F20, 0x9F3B, 0x9F4A, 0x9F52, 0x9F8D, 0x9F9C, 0x9FA0, 0x3012,
+	0x5344, 0x5345, 0x304B, 0x3099, 0x304D, 0x3099, 0x304F, 0x3099,
+	0x3051, 0x3099, 0x3053, 0x3099, 0x3055, 0x3099, 0x3057, 0x3099,
+	0x3059, 0x3099, 0x305B, 0x3099, 0x305D, 0x3099, 0x305F, 0x3099,
+	0x3061, 0x3099, 0x3064, 0x3099, 0x3066, 0x3099, 0x3068, 0x3099,
+	0x306F, 0x3099, 0x306F, 0x309A, 0x3072, 0x3099, 0x3072, 0x309A,
+	0x3075, 0x3099, 0x3075, 0x309A, 0x3078, 0x3099, 0x3078, 0x309A,
+	0x307B, 0x3099, 0x307B, 0x309A, 0x3046, 0x3099, 0x0020, 0x3099,
+	0x0020, 0x309A, 0x309D, 0x3099, 0x3088, 0x308A, 0x30AB, 0x3099,
+	0x30AD, 0x3099, 0x30AF, 0x3099, 0x30B1, 0x3099, 0x30B3, 0x3099,
+	0x30B5, 0x3099, 0x30B7, 0x3099, 0x30B9, 0x3099, 0x30BB, 0x3099,
+	0x30BD, 0x3099, 0x30BF, 0x3099, 0x30C1, 0x3099, 0x30C4, 0x3099,
+	0x30C6, 0x3099, 0x30C8, 0x3099, 0x30CF, 0x3099, 0x30CF, 0x309A,
+	0x30D2, 0x3099, 0x30D2, 0x309A, 0x30D5, 0x3099, 0x30D5, 0x309A,
+	0x30D8, 0x3099, 0x30D8, 0x309A, 0x30DB, 0x3099, 0x30DB, 0x309A,
+	0x30A6, 0x3099, 0x30EF, 0x3099, 0x30F0, 0x3099, 0x30F1, 0x3099,
+	0x30F2, 0x3099, 0x30FD, 0x3099, 0x30B3, 0x30C8, 0x1100, 0x1101,
+	0x11AA, 0x1102, 0x11AC, 0x11AD, 0x1103, 0x1104, 0x1105, 0x11B0,
+	0x11B1, 0x11B2, 0x11B3, 0x11B4, 0x11B5, 0x111A, 0x1106, 0x1107,
+	0x1108, 0x1121, 0x1109, 0x110A, 0x110B, 0x110C, 0x110D, 0x110E,
+	0x110F, 0x1110, 0x1111, 0x1112, 0x1161, 0x1162, 0x1163, 0x1164,
+	0x1165, 0x1166, 0x1167, 0x1168, 0x1169, 0x116A, 0x116B, 0x116C,
+	0x116D, 0x116E, 0x116F, 0x1170, 0x1171, 0x1172, 0x1173, 0x1174,
+	0x1175, 0x1160, 0x1114, 0x1115, 0x11C7, 0x11C8, 0x11CC, 0x11CE,
+	0x11D3, 0x11D7, 0x11D9, 0x111C, 0x11DD, 0x11DF, 0x111D, 0x111E,
+	0x1120, 0x1122, 0x1123, 0x1127, 0x1129, 0x112B, 0x112C, 0x112D,
+	0x112E, 0x112F, 0x1132, 0x1136, 0x1140, 0x1147, 0x114C, 0x11F1,
+	0x11F2, 0x1157, 0x1158, 0x1159, 0x1184, 0x1185, 0x1188, 0x1191,
+	0x1192, 0x1194, 0x119E, 0x11A1, 0x4E09, 0x56DB, 0x4E0A, 0x4E2D,
+	0x4E0B, 0x7532, 0x4E19, 0x4E01, 0x5929, 0x5730, 0x0028, 0x1100,
+	0x0029, 0x0028, 0x1102, 0x0029, 0x0028, 0x1103, 0x0029, 0x0028,
+	0x1105, 0x0029, 0x0028, 0x1106, 0x0029, 0x0028, 0x1107, 0x0029,
+	0x0028, 0x1109, 0x0029, 0x0028, 0x110B, 0x0029, 0x0028, 0x110C,
+	0x0029, 0x0028, 0x110E, 0x0029, 0x0028, 0x110F, 0x0029, 0x0028,
+	0x1110, 0x0029, 0x0028, 0x1111, 0x0029, 0x0028, 0x1112, 0x0029,
+	0x0028, 0x1100, 0x1161, 0x0029, 0x0028, 0x1102, 0x1161, 0x0029,
+	0x0028, 0x1103, 0x1161, 0x0029, 0x0028, 0x1105, 0x1161, 0x0029,
+	0x0028, 0x1106, 0x1161, 0x0029, 0x0028, 0x1107, 0x1161, 0x0029,
+	0x0028, 0x1109, 0x1161, 0x0029, 0x0028, 0x110B, 0x1161, 0x0029,
+	0x0028, 0x110C, 0x1161, 0x0029, 0x0028, 0x110E, 0x1161, 0x0029,
+	0x0028, 0x110F, 0x1161, 0x0029, 0x0028, 0x1110, 0x1161, 0x0029,
+	0x0028, 0x1111, 0x1161, 0x0029, 0x0028, 0x1112, 0x1161, 0x0029,
+	0x0028, 0x110C, 0x116E, 0x0029, 0x0028, 0x110B, 0x1169, 0x110C,
+	0x1165, 0x11AB, 0x0029, 0x0028, 0x110B, 0x1169, 0x1112, 0x116E,
+	0x0029, 0x0028, 0x4E00, 0x0029, 0x0028, 0x4E8C, 0x0029, 0x0028,
+	0x4E09, 0x0029, 0x0028, 0x56DB, 0x0029, 0x0028, 0x4E94, 0x0029,
+	0x0028, 0x516D, 0x0029, 0x0028, 0x4E03, 0x0029, 0x0028, 0x516B,
+	0x0029, 0x0028, 0x4E5D, 0x0029, 0x0028, 0x5341, 0x0029, 0x0028,
+	0x6708, 0x0029, 0x0028, 0x706B, 0x0029, 0x0028, 0x6C34, 0x0029,
+	0x0028, 0x6728, 0x0029, 0x0028, 0x91D1, 0x0029, 0x0028, 0x571F,
+	0x0029, 0x0028, 0x65E5, 0x0029, 0x0028, 0x682A, 0x0029, 0x0028,
+	0x6709, 0x0029, 0x0028, 0x793E, 0x0029, 0x0028, 0x540D, 0x0029,
+	0x0028, 0x7279, 0x0029, 0x0028, 0x8CA1, 0x0029, 0x0028, 0x795D,
+	0x0029, 0x0028, 0x52B4, 0x0029, 0x0028, 0x4EE3, 0x0029, 0x0028,
+	0x547C, 0x0029, 0x0028, 0x5B66, 0x0029, 0x0028, 0x76E3, 0x0029,
+	0x0028, 0x4F01, 0x0029, 0x0028, 0x8CC7, 0x0029, 0x0028, 0x5354,
+	0x0029, 0x0028, 0x796D, 0x0029, 0x0028, 0x4F11, 0x0029, 0x0028,
+	0x81EA, 0x0029, 0x0028, 0x81F3, 0x0029, 0x554F, 0x5E7C, 0x7B8F,
+	0x0050, 0x0054, 0x0045, 0x0032, 0x0031, 0x0032, 0x0032, 0x0032,
+	0x0033, 0x0032, 0x0034, 0x0032, 0x0035, 0x0032, 0x0036, 0x0032,
+	0x0037, 0x0032, 0x0038, 0x0032, 0x0039, 0x0033, 0x0030, 0x0033,
+	0x0031, 0x0033, 0x0032, 0x0033, 0x0033, 0x0033, 0x0034, 0x0033,
+	0x0035, 0x1100, 0x1161, 0x1102, 0x1161, 0x1103, 0x1161, 0x1105,
+	0x1161, 0x1106, 0x1161, 0x1107, 0x1161, 0x1109, 0x1161, 0x110B,
+	0x1161, 0x110C, 0x1161, 0x110E, 0x1161, 0x110F, 0x1161, 0x1110,
+	0x1161, 0x1111, 0x1161, 0x1112, 0x1161, 0x110E, 0x1161, 0x11B7,
+	0x1100, 0x1169, 0x110C, 0x116E, 0x110B, 0x1174, 0x110B, 0x116E,
+	0x4E94, 0x516D, 0x4E03, 0x4E5D, 0x682A, 0x6709, 0x793E, 0x540D,
+	0x7279, 0x8CA1, 0x795D, 0x52B4, 0x79D8, 0x7537, 0x9069, 0x512A,
+	0x5370, 0x6CE8, 0x9805, 0x4F11, 0x5199, 0x6B63, 0x5DE6, 0x53F3,
+	0x533B, 0x5B97, 0x5B66, 0x76E3, 0x4F01, 0x8CC7, 0x5354, 0x591C,
+	0x0033, 0x0036, 0x0033, 0x0037, 0x0033, 0x0038, 0x0033, 0x0039,
+	0x0034, 0x0030, 0x0034, 0x0031, 0x0034, 0x0032, 0x0034, 0x0033,
+	0x0034, 0x0034, 0x0034, 0x0035, 0x0034, 0x0036, 0x0034, 0x0037,
+	0x0034, 0x0038, 0x0034, 0x0039, 0x0035, 0x0030, 0x0031, 0x6708,
+	0x0032, 0x6708, 0x0033, 0x6708, 0x0034, 0x6708, 0x0035, 0x6708,
+	0x0036, 0x6708, 0x0037, 0x6708, 0x0038, 0x6708, 0x0039, 0x6708,
+	0x0031, 0x0030, 0x6708, 0x0031, 0x0031, 0x6708, 0x0031, 0x0032,
+	0x6708, 0x0048, 0x0067, 0x0065, 0x0072, 0x0067, 0x0065, 0x0056,
+	0x004C, 0x0054, 0x0044, 0x30A2, 0x30A4, 0x30A6, 0x30A8, 0x30AA,
+	0x30AB, 0x30AD, 0x30AF, 0x30B1, 0x30B3, 0x30B5, 0x30B7, 0x30B9,
+	0x30BB, 0x30BD, 0x30BF, 0x30C1, 0x30C4, 0x30C6, 0x30C8, 0x30CA,
+	0x30CB, 0x30CC, 0x30CD, 0x30CE, 0x30CF, 0x30D2, 0x30D5, 0x30D8,
+	0x30DB, 0x30DE, 0x30DF, 0x30E0, 0x30E1, 0x30E2, 0x30E4, 0x30E6,
+	0x30E8, 0x30E9, 0x30EA, 0x30EB, 0x30EC, 0x30ED, 0x30EF, 0x30F0,
+	0x30F1, 0x30F2, 0x4EE4, 0x548C, 0x30A2, 0x30CF, 0x309A, 0x30FC,
+	0x30C8, 0x30A2, 0x30EB, 0x30D5, 0x30A1, 0x30A2, 0x30F3, 0x30D8,
+	0x309A, 0x30A2, 0x30A2, 0x30FC, 0x30EB, 0x30A4, 0x30CB, 0x30F3,
+	0x30AF, 0x3099, 0x30A4, 0x30F3, 0x30C1, 0x30A6, 0x30A9, 0x30F3,
+	0x30A8, 0x30B9, 0x30AF, 0x30FC, 0x30C8, 0x3099, 0x30A8, 0x30FC,
+	0x30AB, 0x30FC, 0x30AA, 0x30F3, 0x30B9, 0x30AA, 0x30FC, 0x30E0,
+	0x30AB, 0x30A4, 0x30EA, 0x30AB, 0x30E9, 0x30C3, 0x30C8, 0x30AB,
+	0x30ED, 0x30EA, 0x30FC, 0x30AB, 0x3099, 0x30ED, 0x30F3, 0x30AB,
+	0x3099, 0x30F3, 0x30DE, 0x30AD, 0x3099, 0x30AB, 0x3099, 0x30AD,
+	0x3099, 0x30CB, 0x30FC, 0x30AD, 0x30E5, 0x30EA, 0x30FC, 0x30AD,
+	0x3099, 0x30EB, 0x30BF, 0x3099, 0x30FC, 0x30AD, 0x30ED, 0x30AD,
+	0x30ED, 0x30AF, 0x3099, 0x30E9, 0x30E0, 0x30AD, 0x30ED, 0x30E1,
+	0x30FC, 0x30C8, 0x30EB, 0x30AD, 0x30ED, 0x30EF, 0x30C3, 0x30C8,
+	0x30AF, 0x3099, 0x30E9, 0x30E0, 0x30AF, 0x3099, 0x30E9, 0x30E0,
+	0x30C8, 0x30F3, 0x30AF, 0x30EB, 0x30BB, 0x3099, 0x30A4, 0x30ED,
+	0x30AF, 0x30ED, 0x30FC, 0x30CD, 0x30B1, 0x30FC, 0x30B9, 0x30B3,
+	0x30EB, 0x30CA, 0x30B3, 0x30FC, 0x30DB, 0x309A, 0x30B5, 0x30A4,
+	0x30AF, 0x30EB, 0x30B5, 0x30F3, 0x30C1, 0x30FC, 0x30E0, 0x30B7,
+	0x30EA, 0x30F3, 0x30AF, 0x3099, 0x30BB, 0x30F3, 0x30C1, 0x30BB,
+	0x30F3, 0x30C8, 0x30BF, 0x3099, 0x30FC, 0x30B9, 0x30C6, 0x3099,
+	0x30B7, 0x30C8, 0x3099, 0x30EB, 0x30C8, 0x30F3, 0x30CA, 0x30CE,
+	0x30CE, 0x30C3, 0x30C8, 0x30CF, 0x30A4, 0x30C4, 0x30CF, 0x309A,
+	0x30FC, 0x30BB, 0x30F3, 0x30C8, 0x30CF, 0x309A, 0x30FC, 0x30C4,
+	0x30CF, 0x3099, 0x30FC, 0x30EC, 0x30EB, 0x30D2, 0x309A, 0x30A2,
+	0x30B9, 0x30C8, 0x30EB, 0x30D2, 0x309A, 0x30AF, 0x30EB, 0x30D2,
+	0x309A, 0x30B3, 0x30D2, 0x3099, 0x30EB, 0x30D5, 0x30A1, 0x30E9,
+	0x30C3, 0x30C8, 0x3099, 0x30D5, 0x30A3, 0x30FC, 0x30C8, 0x30D5,
+	0x3099, 0x30C3, 0x30B7, 0x30A7, 0x30EB, 0x30D5, 0x30E9, 0x30F3,
+	0x30D8, 0x30AF, 0x30BF, 0x30FC, 0x30EB, 0x30D8, 0x309A, 0x30BD,
+	0x30D8, 0x309A, 0x30CB, 0x30D2, 0x30D8, 0x30EB, 0x30C4, 0x30D8,
+	0x309A, 0x30F3, 0x30B9, 0x30D8, 0x309A, 0x30FC, 0x30B7, 0x3099,
+	0x30D8, 0x3099, 0x30FC, 0x30BF, 0x30DB, 0x309A, 0x30A4, 0x30F3,
+	0x30C8, 0x30DB, 0x3099, 0x30EB, 0x30C8, 0x30DB, 0x30F3, 0x30DB,
+	0x309A, 0x30F3, 0x30C8, 0x3099, 0x30DB, 0x30FC, 0x30EB, 0x30DB,
+	0x30FC, 0x30F3, 0x30DE, 0x30A4, 0x30AF, 0x30ED, 0x30DE, 0x30A4,
+	0x30EB, 0x30DE, 0x30C3, 0x30CF, 0x30DE, 0x30EB, 0x30AF, 0x30DE,
+	0x30F3, 0x30B7, 0x30E7, 0x30F3, 0x30DF, 0x30AF, 0x30ED, 0x30F3,
+	0x30DF, 0x30EA, 0x30DF, 0x30EA, 0x30CF, 0x3099, 0x30FC, 0x30EB,
+	0x30E1, 0x30AB, 0x3099, 0x30E1, 0x30AB, 0x3099, 0x30C8, 0x30F3,
+	0x30E1, 0x30FC, 0x30C8, 0x30EB, 0x30E4, 0x30FC, 0x30C8, 0x3099,
+	0x30E4, 0x30FC, 0x30EB, 0x30E6, 0x30A2, 0x30F3, 0x30EA, 0x30C3,
+	0x30C8, 0x30EB, 0x30EA, 0x30E9, 0x30EB, 0x30D2, 0x309A, 0x30FC,
+	0x30EB, 0x30FC, 0x30D5, 0x3099, 0x30EB, 0x30EC, 0x30E0, 0x30EC,
+	0x30F3, 0x30C8, 0x30B1, 0x3099, 0x30F3, 0x30EF, 0x30C3, 0x30C8,
+	0x0030, 0x70B9, 0x0031, 0x70B9, 0x0032, 0x70B9, 0x0033, 0x70B9,
+	0x0034, 0x70B9, 0x0035, 0x70B9, 0x0036, 0x70B9, 0x0037, 0x70B9,
+	0x0038, 0x70B9, 0x0039, 0x70B9, 0x0031, 0x0030, 0x70B9, 0x0031,
+	0x0031, 0x70B9, 0x0031, 0x0032, 0x70B9, 0x0031, 0x0033, 0x70B9,
+	0x0031, 0x0034, 0x70B9, 0x0031, 0x0035, 0x70B9, 0x0031, 0x0036,
+	0x70B9, 0x0031, 0x0037, 0x70B9, 0x0031, 0x0038, 0x70B9, 0x0031,
+	0x0039, 0x70B9, 0x0032, 0x0030, 0x70B9, 0x0032, 0x0031, 0x70B9,
+	0x0032, 0x0032, 0x70B9, 0x0032, 0x0033, 0x70B9, 0x0032, 0x0034,
+	0x70B9, 0x0068, 0x0050, 0x0061, 0x0064, 0x0061, 0x0041, 0x0055,
+	0x0062, 0x0061, 0x0072, 0x006F, 0x0056, 0x0070, 0x0063, 0x0064,
+	0x006D, 0x0064, 0x006D, 0x0032, 0x0064, 0x006D, 0x0033, 0x0049,
+	0x0055, 0x5E73, 0x6210, 0x662D, 0x548C, 0x5927, 0x6B63, 0x660E,
+	0x6CBB, 0x682A, 0x5F0F, 0x4F1A, 0x793E, 0x0070, 0x0041, 0x006E,
+	0x0041, 0x03BC, 0x0041, 0x006D, 0x0041, 0x006B, 0x0041, 0x004B,
+	0x0042, 0x004D, 0x0042, 0x0047, 0x0042, 0x0063, 0x0061, 0x006C,
+	0x006B, 0x0063, 0x0061, 0x006C, 0x0070, 0x0046, 0x006E, 0x0046,
+	0x03BC, 0x0046, 0x03BC, 0x0067, 0x006D, 0x0067, 0x006B, 0x0067,
+	0x0048, 0x007A, 0x006B, 0x0048, 0x007A, 0x004D, 0x0048, 0x007A,
+	0x0047, 0x0048, 0x007A, 0x0054, 0x0048, 0x007A, 0x03BC, 0x006C,
+	0x006D, 0x006C, 0x0064, 0x006C, 0x006B, 0x006C, 0x0066, 0x006D,
+	0x006E, 0x006D, 0x03BC, 0x006D, 0x006D, 0x006D, 0x0063, 0x006D,
+	0x006B, 0x006D, 0x006D, 0x006D, 0x0032, 0x0063, 0x006D, 0x0032,
+	0x006D, 0x0032, 0x006B, 0x006D, 0x0032, 0x006D, 0x006D, 0x0033,
+	0x0063, 0x006D, 0x0033, 0x006D, 0x0033, 0x006B, 0x006D, 0x0033,
+	0x006D, 0x2215, 0x0073, 0x006D, 0x2215, 0x0073, 0x0032, 0x0050,
+	0x0061, 0x006B, 0x0050, 0x0061, 0x004D, 0x0050, 0x0061, 0x0047,
+	0x0050, 0x0061, 0x0072, 0x0061, 0x0064, 0x0072, 0x0061, 0x0064,
+	0x2215, 0x0073, 0x0072, 0x0061, 0x0064, 0x2215, 0x0073, 0x0032,
+	0x0070, 0x0073, 0x006E, 0x0073, 0x03BC, 0x0073, 0x006D, 0x0073,
+	0x0070, 0x0056, 0x006E, 0x0056, 0x03BC, 0x0056, 0x006D, 0x0056,
+	0x006B, 0x0056, 0x004D, 0x0056, 0x0070, 0x0057, 0x006E, 0x0057,
+	0x03BC, 0x0057, 0x006D, 0x0057, 0x006B, 0x0057, 0x004D, 0x0057,
+	0x006B, 0x03A9, 0x004D, 0x03A9, 0x0061, 0x002E, 0x006D, 0x002E,
+	0x0042, 0x0071, 0x0063, 0x0063, 0x0063, 0x0064, 0x0043, 0x2215,
+	0x006B, 0x0067, 0x0043, 0x006F, 0x002E, 0x0064, 0x0042, 0x0047,
+	0x0079, 0x0068, 0x0061, 0x0048, 0x0050, 0x0069, 0x006E, 0x004B,
+	0x004B, 0x004B, 0x004D, 0x006B, 0x0074, 0x006C, 0x006D, 0x006C,
+	0x006E, 0x006C, 0x006F, 0x0067, 0x006C, 0x0078, 0x006D, 0x0062,
+	0x006D, 0x0069, 0x006C, 0x006D, 0x006F, 0x006C, 0x0050, 0x0048,
+	0x0070, 0x002E, 0x006D, 0x002E, 0x0050, 0x0050, 0x004D, 0x0050,
+	0x0052, 0x0073, 0x0072, 0x0053, 0x0076, 0x0057, 0x0062, 0x0056,
+	0x2215, 0x006D, 0x0041, 0x2215, 0x006D, 0x0031, 0x65E5, 0x0032,
+	0x65E5, 0x0033, 0x65E5, 0x0034, 0x65E5, 0x0035, 0x65E5, 0x0036,
+	0x65E5, 0x0037, 0x65E5, 0x0038, 0x65E5, 0x0039, 0x65E5, 0x0031,
+	0x0030, 0x65E5, 0x0031, 0x0031, 0x65E5, 0x0031, 0x0032, 0x65E5,
+	0x0031, 0x0033, 0x65E5, 0x0031, 0x0034, 0x65E5, 0x0031, 0x0035,
+	0x65E5, 0x0031, 0x0036, 0x65E5, 0x0031, 0x0037, 0x65E5, 0x0031,
+	0x0038, 0x65E5, 0x0031, 0x0039, 0x65E5, 0x0032, 0x0030, 0x65E5,
+	0x0032, 0x0031, 0x65E5, 0x0032, 0x0032, 0x65E5, 0x0032, 0x0033,
+	0x65E5, 0x0032, 0x0034, 0x65E5, 0x0032, 0x0035, 0x65E5, 0x0032,
+	0x0036, 0x65E5, 0x0032, 0x0037, 0x65E5, 0x0032, 0x0038, 0x65E5,
+	0x0032, 0x0039, 0x65E5, 0x0033, 0x0030, 0x65E5, 0x0033, 0x0031,
+	0x65E5, 0x0067, 0x0061, 0x006C, 0x044A, 0x044C, 0xA76F, 0x0126,
+	0x0153, 0xA727, 0xAB37, 0x026B, 0xAB52, 0x028D, 0x8C48, 0x66F4,
+	0x8CC8, 0x6ED1, 0x4E32, 0x53E5, 0x5951, 0x5587, 0x5948, 0x61F6,
+	0x7669, 0x7F85, 0x863F, 0x87BA, 0x88F8, 0x908F, 0x6A02, 0x6D1B,
+	0x70D9, 0x73DE, 0x843D, 0x916A, 0x99F1, 0x4E82, 0x5375, 0x6B04,
+	0x721B, 0x862D, 0x9E1E, 0x5D50, 0x6FEB, 0x85CD, 0x8964, 0x62C9,
+	0x81D8, 0x881F, 0x5ECA, 0x6717, 0x6D6A, 0x72FC, 0x90CE, 0x4F86,
+	0x51B7, 0x52DE, 0x64C4, 0x6AD3, 0x7210, 0x76E7, 0x8606, 0x865C,
+	0x8DEF, 0x9732, 0x9B6F, 0x9DFA, 0x788C, 0x797F, 0x7DA0, 0x83C9,
+	0x9304, 0x8AD6, 0x58DF, 0x5F04, 0x7C60, 0x807E, 0x7262, 0x78CA,
+	0x8CC2, 0x96F7, 0x58D8, 0x5C62, 0x6A13, 0x6DDA, 0x6F0F, 0x7D2F,
+	0x7E37, 0x964B, 0x52D2, 0x808B, 0x51DC, 0x51CC, 0x7A1C, 0x7DBE,
+	0x83F1, 0x9675, 0x8B80, 0x62CF, 0x8AFE, 0x4E39, 0x5BE7, 0x6012,
+	0x7387, 0x7570, 0x5317, 0x78FB, 0x4FBF, 0x5FA9, 0x4E0D, 0x6CCC,
+	0x6578, 0x7D22, 0x53C3, 0x585E, 0x7701, 0x8449, 0x8AAA, 0x6BBA,
+	0x6C88, 0x62FE, 0x82E5, 0x63A0, 0x7565, 0x4EAE, 0x5169, 0x51C9,
+	0x6881, 0x7CE7, 0x826F, 0x8AD2, 0x91CF, 0x52F5, 0x5442, 0x5EEC,
+	0x65C5, 0x6FFE, 0x792A, 0x95AD, 0x9A6A, 0x9E97, 0x9ECE, 0x66C6,
+	0x6B77, 0x8F62, 0x5E74, 0x6190, 0x6200, 0x649A, 0x6F23, 0x7149,
+	0x7489, 0x79CA, 0x7DF4, 0x806F, 0x8F26, 0x84EE, 0x9023, 0x934A,
+	0x5217, 0x52A3, 0x54BD, 0x70C8, 0x88C2, 0x5EC9, 0x5FF5, 0x637B,
+	0x6BAE, 0x7C3E, 0x7375, 0x4EE4, 0x56F9, 0x5DBA, 0x601C, 0x73B2,
+	0x7469, 0x7F9A, 0x8046, 0x9234, 0x96F6, 0x9748, 0x9818, 0x4F8B,
+	0x79AE, 0x91B4, 0x96B8, 0x60E1, 0x4E86, 0x50DA, 0x5BEE, 0x5C3F,
+	0x6599, 0x71CE, 0x7642, 0x84FC, 0x907C, 0x6688, 0x962E, 0x5289,
+	0x677B, 0x67F3, 0x6D41, 0x6E9C, 0x7409, 0x7559, 0x786B, 0x7D10,
+	0x985E, 0x622E, 0x9678, 0x502B, 0x5D19, 0x6DEA, 0x8F2A, 0x5F8B,
+	0x6144, 0x6817, 0x9686, 0x5229, 0x540F, 0x5C65, 0x6613, 0x674E,
+	0x68A8, 0x6CE5, 0x7406, 0x75E2, 0x7F79, 0x88CF, 0x88E1, 0x96E2,
+	0x533F, 0x6EBA, 0x541D, 0x71D0, 0x7498, 0x85FA, 0x96A3, 0x9C57,
+	0x9E9F, 0x6797, 0x6DCB, 0x81E8, 0x7B20, 0x7C92, 0x72C0, 0x7099,
+	0x8B58, 0x4EC0, 0x8336, 0x523A, 0x5207, 0x5EA6, 0x62D3, 0x7CD6,
+	0x5B85, 0x6D1E, 0x66B4, 0x8F3B, 0x964D, 0x5ED3, 0x5140, 0x55C0,
+	0x585A, 0x6674, 0x51DE, 0x732A, 0x76CA, 0x793C, 0x795E, 0x7965,
+	0x798F, 0x9756, 0x7CBE, 0x8612, 0x8AF8, 0x9038, 0x90FD, 0x98EF,
+	0x98FC, 0x9928, 0x9DB4, 0x90DE, 0x96B7, 0x4FAE, 0x50E7, 0x514D,
+	0x52C9, 0x52E4, 0x5351, 0x559D, 0x5606, 0x5668, 0x5840, 0x58A8,
+	0x5C64, 0x6094, 0x6168, 0x618E, 0x61F2, 0x654F, 0x65E2, 0x6691,
+	0x6885, 0x6D77, 0x6E1A, 0x6F22, 0x716E, 0x722B, 0x7422, 0x7891,
+	0x7949, 0x7948, 0x7950, 0x7956, 0x798D, 0x798E, 0x7A40, 0x7A81,
+	0x7BC0, 0x7E09, 0x7E41, 0x7F72, 0x8005, 0x81ED, 0x8279, 0x8457,
+	0x8910, 0x8996, 0x8B01, 0x8B39, 0x8CD3, 0x8D08, 0x8FB6, 0x96E3,
+	0x97FF, 0x983B, 0x6075, 0x242EE, 0x8218, 0x4E26, 0x51B5, 0x5168,
+	0x4F80, 0x5145, 0x5180, 0x52C7, 0x52FA, 0x5555, 0x5599, 0x55E2,
+	0x58B3, 0x5944, 0x5954, 0x5A62, 0x5B28, 0x5ED2, 0x5ED9, 0x5F69,
+	0x5FAD, 0x60D8, 0x614E, 0x6108, 0x6160, 0x6234, 0x63C4, 0x641C,
+	0x6452, 0x6556, 0x671B, 0x6756, 0x6EDB, 0x6ECB, 0x701E, 0x77A7,
+	0x7235, 0x72AF, 0x7471, 0x7506, 0x753B, 0x761D, 0x761F, 0x76DB,
+	0x76F4, 0x774A, 0x7740, 0x78CC, 0x7AB1, 0x7C7B, 0x7D5B, 0x7F3E,
+	0x8352, 0x83EF, 0x8779, 0x8941, 0x8986, 0x8ABF, 0x8ACB, 0x8AED,
+	0x8B8A, 0x8F38, 0x9072, 0x9199, 0x9276, 0x967C, 0x97DB, 0x980B,
+	0x9B12, 0x2284A, 0x22844, 0x233D5, 0x3B9D, 0x4018, 0x4039, 0x25249,
+	0x25CD0, 0x27ED3, 0x9F43, 0x9F8E, 0x0066, 0x0066, 0x0066, 0x0069,
+	0x0066, 0x006C, 0x0066, 0x0066, 0x0069, 0x0066, 0x0066, 0x006C,
+	0x0073, 0x0074, 0x0574, 0x0576, 0x0574, 0x0565, 0x0574, 0x056B,
+	0x057E, 0x0576, 0x0574, 0x056D, 0x05D9, 0x05B4, 0x05F2, 0x05B7,
+	0x05E2, 0x05D4, 0x05DB, 0x05DC, 0x05DD, 0x05E8, 0x05EA, 0x05E9,
+	0x05C1, 0x05E9, 0x05C2, 0x05E9, 0x05BC, 0x05C1, 0x05E9, 0x05BC,
+	0x05C2, 0x05D0, 0x05B7, 0x05D0, 0x05B8, 0x05D0, 0x05BC, 0x05D1,
+	0x05BC, 0x05D2, 0x05BC, 0x05D3, 0x05BC, 0x05D4, 0x05BC, 0x05D5,
+	0x05BC, 0x05D6, 0x05BC, 0x05D8, 0x05BC, 0x05D9, 0x05BC, 0x05DA,
+	0x05BC, 0x05DB, 0x05BC, 0x05DC, 0x05BC, 0x05DE, 0x05BC, 0x05E0,
+	0x05BC, 0x05E1, 0x05BC, 0x05E3, 0x05BC, 0x05E4, 0x05BC, 0x05E6,
+	0x05BC, 0x05E7, 0x05BC, 0x05E8, 0x05BC, 0x05E9, 0x05BC, 0x05EA,
+	0x05BC, 0x05D5, 0x05B9, 0x05D1, 0x05BF, 0x05DB, 0x05BF, 0x05E4,
+	0x05BF, 0x05D0, 0x05DC, 0x0671, 0x067B, 0x067E, 0x0680, 0x067A,
+	0x067F, 0x0679, 0x06A4, 0x06A6, 0x0684, 0x0683, 0x0686, 0x0687,
+	0x068D, 0x068C, 0x068E, 0x0688, 0x0698, 0x0691, 0x06A9, 0x06AF,
+	0x06B3, 0x06B1, 0x06BA, 0x06BB, 0x06C1, 0x06BE, 0x06D2, 0x06AD,
+	0x06C7, 0x06C6, 0x06C8, 0x06CB, 0x06C5, 0x06C9, 0x06D0, 0x0649,
+	0x064A, 0x0654, 0x0627, 0x064A, 0x0654, 0x06D5, 0x064A, 0x0654,
+	0x0648, 0x064A, 0x0654, 0x06C7, 0x064A, 0x0654, 0x06C6, 0x064A,
+	0x0654, 0x06C8, 0x064A, 0x0654, 0x06D0, 0x064A, 0x0654, 0x0649,
+	0x06CC, 0x064A, 0x0654, 0x062C, 0x064A, 0x0654, 0x062D, 0x064A,
+	0x0654, 0x0645, 0x064A, 0x0654, 0x064A, 0x0628, 0x062C, 0x0628,
+	0x062D, 0x0628, 0x062E, 0x0628, 0x0645, 0x0628, 0x0649, 0x0628,
+	0x064A, 0x062A, 0x062C, 0x062A, 0x062D, 0x062A, 0x062E, 0x062A,
+	0x0645, 0x062A, 0x0649, 0x062A, 0x064A, 0x062B, 0x062C, 0x062B,
+	0x0645, 0x062B, 0x0649, 0x062B, 0x064A, 0x062C, 0x062D, 0x062C,
+	0x0645, 0x062D, 0x062C, 0x062D, 0x0645, 0x062E, 0x062C, 0x062E,
+	0x062D, 0x062E, 0x0645, 0x0633, 0x062C, 0x0633, 0x062D, 0x0633,
+	0x062E, 0x0633, 0x0645, 0x0635, 0x062D, 0x0635, 0x0645, 0x0636,
+	0x062C, 0x0636, 0x062D, 0x0636, 0x062E, 0x0636, 0x0645, 0x0637,
+	0x062D, 0x0637, 0x0645, 0x0638, 0x0645, 0x0639, 0x062C, 0x0639,
+	0x0645, 0x063A, 0x062C, 0x063A, 0x0645, 0x0641, 0x062C, 0x0641,
+	0x062D, 0x0641, 0x062E, 0x0641, 0x0645, 0x0641, 0x0649, 0x0641,
+	0x064A, 0x0642, 0x062D, 0x0642, 0x0645, 0x0642, 0x0649, 0x0642,
+	0x064A, 0x0643, 0x0627, 0x0643, 0x062C, 0x0643, 0x062D, 0x0643,
+	0x062E, 0x0643, 0x0644, 0x0643, 0x0645, 0x0643, 0x0649, 0x0643,
+	0x064A, 0x0644, 0x062C, 0x0644, 0x062D, 0x0644, 0x062E, 0x0644,
+	0x0645, 0x0644, 0x0649, 0x0644, 0x064A, 0x0645, 0x062C, 0x0645,
+	0x062D, 0x0645, 0x062E, 0x0645, 0x0645, 0x0645, 0x0649, 0x0645,
+	0x064A, 0x0646, 0x062C, 0x0646, 0x062D, 0x0646, 0x062E, 0x0646,
+	0x0645, 0x0646, 0x0649, 0x0646, 0x064A, 0x0647, 0x062C, 0x0647,
+	0x0645, 0x0647, 0x0649, 0x0647, 0x064A, 0x064A, 0x062C, 0x064A,
+	0x062D, 0x064A, 0x062E, 0x064A, 0x0645, 0x064A, 0x0649, 0x064A,
+	0x064A, 0x0630, 0x0670, 0x0631, 0x0670, 0x0649, 0x0670, 0x0020,
+	0x064C, 0x0651, 0x0020, 0x064D, 0x0651, 0x0020, 0x064E, 0x0651,
+	0x0020, 0x064F, 0x0651, 0x0020, 0x0650, 0x0651, 0x0020, 0x0651,
+	0x0670, 0x064A, 0x0654, 0x0631, 0x064A, 0x0654, 0x0632, 0x064A,
+	0x0654, 0x0646, 0x0628, 0x0631, 0x0628, 0x0632, 0x0628, 0x0646,
+	0x062A, 0x0631, 0x062A, 0x0632, 0x062A, 0x0646, 0x062B, 0x0631,
+	0x062B, 0x0632, 0x062B, 0x0646, 0x0645, 0x0627, 0x0646, 0x0631,
+	0x0646, 0x0632, 0x0646, 0x0646, 0x064A, 0x0631, 0x064A, 0x0632,
+	0x064A, 0x0646, 0x064A, 0x0654, 0x062E, 0x064A, 0x0654, 0x0647,
+	0x0628, 0x0647, 0x062A, 0x0647, 0x0635, 0x062E, 0x0644, 0x0647,
+	0x0646, 0x0647, 0x0647, 0x0670, 0x064A, 0x0647, 0x062B, 0x0647,
+	0x0633, 0x0647, 0x0634, 0x0645, 0x0634, 0x0647, 0x0640, 0x064E,
+	0x0651, 0x0640, 0x064F, 0x0651, 0x0640, 0x0650, 0x0651, 0x0637,
+	0x0649, 0x0637, 0x064A, 0x0639, 0x0649, 0x0639, 0x064A, 0x063A,
+	0x0649, 0x063A, 0x064A, 0x0633, 0x0649, 0x0633, 0x064A, 0x0634,
+	0x0649, 0x0634, 0x064A, 0x062D, 0x0649, 0x062D, 0x064A, 0x062C,
+	0x0649, 0x062C, 0x064A, 0x062E, 0x0649, 0x062E, 0x064A, 0x0635,
+	0x0649, 0x0635, 0x064A, 0x0636, 0x0649, 0x0636, 0x064A, 0x0634,
+	0x062C, 0x0634, 0x062D, 0x0634, 0x062E, 0x0634, 0x0631, 0x0633,
+	0x0631, 0x0635, 0x0631, 0x0636, 0x0631, 0x0627, 0x064B, 0x062A,
+	0x062C, 0x0645, 0x062A, 0x062D, 0x062C, 0x062A, 0x062D, 0x0645,
+	0x062A, 0x062E, 0x0645, 0x062A, 0x0645, 0x062C, 0x062A, 0x0645,
+	0x062D, 0x062A, 0x0645, 0x062E, 0x062C, 0x0645, 0x062D, 0x062D,
+	0x0645, 0x064A, 0x062D, 0x0645, 0x0649, 0x0633, 0x062D, 0x062C,
+	0x0633, 0x062C, 0x062D, 0x0633, 0x062C, 0x0649, 0x0633, 0x0645,
+	0x062D, 0x0633, 0x0645, 0x062C, 0x0633, 0x0645, 0x0645, 0x0635,
+	0x062D, 0x062D, 0x0635, 0x0645, 0x0645, 0x0634, 0x062D, 0x0645,
+	0x0634, 0x062C, 0x064A, 0x0634, 0x0645, 0x062E, 0x0634, 0x0645,
+	0x0645, 0x0636, 0x062D, 0x0649, 0x0636, 0x062E, 0x0645, 0x0637,
+	0x0645, 0x062D, 0x0637, 0x0645, 0x0645, 0x0637, 0x0645, 0x064A,
+	0x0639, 0x062C, 0x0645, 0x0639, 0x0645, 0x0645, 0x0639, 0x0645,
+	0x0649, 0x063A, 0x0645, 0x0645, 0x063A, 0x0645, 0x064A, 0x063A,
+	0x0645, 0x0649, 0x0641, 0x062E, 0x0645, 0x0642, 0x0645, 0x062D,
+	0x0642, 0x0645, 0x0645, 0x0644, 0x062D, 0x0645, 0x0644, 0x062D,
+	0x064A, 0x0644, 0x062D, 0x0649, 0x0644, 0x062C, 0x062C, 0x0644,
+	0x062E, 0x0645, 0x0644, 0x0645, 0x062D, 0x0645, 0x062D, 0x062C,
+	0x0645, 0x062D, 0x0645, 0x0645, 0x062D, 0x064A, 0x0645, 0x062C,
+	0x062D, 0x0645, 0x062C, 0x0645, 0x0645, 0x062E, 0x062C, 0x0645,
+	0x062E, 0x0645, 0x0645, 0x062C, 0x062E, 0x0647, 0x0645, 0x062C,
+	0x0647, 0x0645, 0x0645, 0x0646, 0x062D, 0x0645, 0x0646, 0x062D,
+	0x0649, 0x0646, 0x062C, 0x0645, 0x0646, 0x062C, 0x0649, 0x0646,
+	0x0645, 0x064A, 0x0646, 0x0645, 0x0649, 0x064A, 0x0645, 0x0645,
+	0x0628, 0x062E, 0x064A, 0x062A, 0x062C, 0x064A, 0x062A, 0x062C,
+	0x0649, 0x062A, 0x062E, 0x064A, 0x062A, 0x062E, 0x0649, 0x062A,
+	0x0645, 0x064A, 0x062A, 0x0645, 0x0649, 0x062C, 0x0645, 0x064A,
+	0x062C, 0x062D, 0x0649, 0x062C, 0x0645, 0x0649, 0x0633, 0x062E,
+	0x0649, 0x0635, 0x062D, 0x064A, 0x0634, 0x062D, 0x064A, 0x0636,
+	0x062D, 0x064A, 0x0644, 0x062C, 0x064A, 0x0644, 0x0645, 0x064A,
+	0x064A, 0x062D, 0x064A, 0x064A, 0x062C, 0x064A, 0x064A, 0x0645,
+	0x064A, 0x0645, 0x0645, 0x064A, 0x0642, 0x0645, 0x064A, 0x0646,
+	0x062D, 0x064A, 0x0639, 0x0645, 0x064A, 0x0643, 0x0645, 0x064A,
+	0x0646, 0x062C, 0x062D, 0x0645, 0x062E, 0x064A, 0x0644, 0x062C,
+	0x0645, 0x0643, 0x0645, 0x0645, 0x062C, 0x062D, 0x064A, 0x062D,
+	0x062C, 0x064A, 0x0645, 0x062C, 0x064A, 0x0641, 0x0645, 0x064A,
+	0x0628, 0x062D, 0x064A, 0x0633, 0x062E, 0x064A, 0x0646, 0x062C,
+	0x064A, 0x0635, 0x0644, 0x06D2, 0x0642, 0x0644, 0x06D2, 0x0627,
+	0x0644, 0x0644, 0x0647, 0x0627, 0x0643, 0x0628, 0x0631, 0x0645,
+	0x062D, 0x0645, 0x062F, 0x0635, 0x0644, 0x0639, 0x0645, 0x0631,
+	0x0633, 0x0648, 0x0644, 0x0639, 0x0644, 0x064A, 0x0647, 0x0648,
+	0x0633, 0x0644, 0x0645, 0x0635, 0x0644, 0x0649, 0x0635, 0x0644,
+	0x0649, 0x0020, 0x0627, 0x0644, 0x0644, 0x0647, 0x0020, 0x0639,
+	0x0644, 0x064A, 0x0647, 0x0020, 0x0648, 0x0633, 0x0644, 0x0645,
+	0x062C, 0x0644, 0x0020, 0x062C, 0x0644, 0x0627, 0x0644, 0x0647,
+	0x0631, 0x06CC, 0x0627, 0x0644, 0x002C, 0x3001, 0x3002, 0x003A,
+	0x0021, 0x003F, 0x3016, 0x3017, 0x2014, 0x2013, 0x005F, 0x007B,
+	0x007D, 0x3014, 0x3015, 0x3010, 0x3011, 0x300A, 0x300B, 0x300C,
+	0x300D, 0x300E, 0x300F, 0x005B, 0x005D, 0x0023, 0x0026, 0x002A,
+	0x002D, 0x003C, 0x003E, 0x005C, 0x0024, 0x0025, 0x0040, 0x0020,
+	0x064B, 0x0640, 0x064B, 0x0020, 0x064C, 0x0020, 0x064D, 0x0020,
+	0x064E, 0x0640, 0x064E, 0x0020, 0x064F, 0x0640, 0x064F, 0x0020,
+	0x0650, 0x0640, 0x0650, 0x0020, 0x0651, 0x0640, 0x0651, 0x0020,
+	0x0652, 0x0640, 0x0652, 0x0621, 0x0627, 0x0628, 0x0629, 0x062A,
+	0x062B, 0x062C, 0x062D, 0x062E, 0x062F, 0x0630, 0x0631, 0x0632,
+	0x0633, 0x0634, 0x0635, 0x0636, 0x0637, 0x0638, 0x0639, 0x063A,
+	0x0641, 0x0642, 0x0643, 0x0644, 0x0645, 0x0646, 0x0647, 0x0648,
+	0x064A, 0x0644, 0x0627, 0x0653, 0x0644, 0x0627, 0x0654, 0x0644,
+	0x0627, 0x0655, 0x0644, 0x0627, 0x0022, 0x0027, 0x002F, 0x005E,
+	0x007C, 0x007E, 0x2985, 0x2986, 0x30FB, 0x30A1, 0x30A3, 0x30A5,
+	0x30A7, 0x30A9, 0x30E3, 0x30E5, 0x30E7, 0x30C3, 0x30FC, 0x30F3,
+	0x3099, 0x309A, 0x00A2, 0x00A3, 0x00AC, 0x00A6, 0x00A5, 0x20A9,
+	0x2502, 0x2190, 0x2191, 0x2192, 0x2193, 0x25A0, 0x25CB, 0x105D2,
+	0x0307, 0x105DA, 0x0307, 0x02D0, 0x02D1, 0x00E6, 0x0299, 0x0253,
+	0x02A3, 0xAB66, 0x02A5, 0x02A4, 0x0256, 0x0257, 0x1D91, 0x0258,
+	0x025E, 0x02A9, 0x0264, 0x0262, 0x0260, 0x029B, 0x029C, 0x0267,
+	0x0284, 0x02AA, 0x02AB, 0x026C, 0x1DF04, 0xA78E, 0x026E, 0x1DF05,
+	0x028E, 0x1DF06, 0x00F8, 0x0276, 0x0277, 0x027A, 0x1DF08, 0x027D,
+	0x027E, 0x0280, 0x02A8, 0x02A6, 0xAB67, 0x02A7, 0x0288, 0x2C71,
+	0x028F, 0x02A1, 0x02A2, 0x0298, 0x01C0, 0x01C1, 0x01C2, 0x1DF0A,
+	0x1DF1E, 0x11099, 0x110BA, 0x1109B, 0x110BA, 0x110A5, 0x110BA, 0x11131,
+	0x11127, 0x11132, 0x11127, 0x11347, 0x1133E, 0x11347, 0x11357, 0x11382,
+	0x113C9, 0x11384, 0x113BB, 0x1138B, 0x113C2, 0x11390, 0x113C9, 0x113C2,
+	0x113C2, 0x113C2, 0x113B8, 0x113C2, 0x113C9, 0x114B9, 0x114BA, 0x114B9,
+	0x114B0, 0x114B9, 0x114BD, 0x115B8, 0x115AF, 0x115B9, 0x115AF, 0x11935,
+	0x11930, 0x1611E, 0x1611E, 0x1611E, 0x16129, 0x1611E, 0x1611F, 0x16129,
+	0x1611F, 0x1611E, 0x16120, 0x1611E, 0x1611E, 0x1611F, 0x1611E, 0x16129,
+	0x1611F, 0x1611E, 0x1611E, 0x16120, 0x16D67, 0x16D67, 0x16D63, 0x16D67,
+	0x16D63, 0x16D67, 0x16D67, 0x1D157, 0x1D165, 0x1D158, 0x1D165, 0x1D158,
+	0x1D165, 0x1D16E, 0x1D158, 0x1D165, 0x1D16F, 0x1D158, 0x1D165, 0x1D170,
+	0x1D158, 0x1D165, 0x1D171, 0x1D158, 0x1D165, 0x1D172, 0x1D1B9, 0x1D165,
+	0x1D1BA, 0x1D165, 0x1D1B9, 0x1D165, 0x1D16E, 0x1D1BA, 0x1D165, 0x1D16E,
+	0x1D1B9, 0x1D165, 0x1D16F, 0x1D1BA, 0x1D165, 0x1D16F, 0x0131, 0x0237,
+	0x0391, 0x0392, 0x0394, 0x0395, 0x0396, 0x0397, 0x0399, 0x039A,
+	0x039B, 0x039C, 0x039D, 0x039E, 0x039F, 0x03A1, 0x03A4, 0x03A6,
+	0x03A7, 0x03A8, 0x2207, 0x03B1, 0x03B6, 0x03B7, 0x03BB, 0x03BD,
+	0x03BE, 0x03BF, 0x03C3, 0x03C4, 0x03C5, 0x03C8, 0x03C9, 0x2202,
+	0x03DC, 0x03DD, 0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435,
+	0x0436, 0x0437, 0x0438, 0x043A, 0x043B, 0x043C, 0x043E, 0x043F,
+	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447,
+	0x0448, 0x044B, 0x044D, 0x044E, 0xA689, 0x04D9, 0x0456, 0x0458,
+	0x04E9, 0x04AF, 0x04CF, 0x0491, 0x0455, 0x045F, 0x04AB, 0xA651,
+	0x04B1, 0x066E, 0x06A1, 0x066F, 0x0030, 0x002E, 0x0030, 0x002C,
+	0x0031, 0x002C, 0x0032, 0x002C, 0x0033, 0x002C, 0x0034, 0x002C,
+	0x0035, 0x002C, 0x0036, 0x002C, 0x0037, 0x002C, 0x0038, 0x002C,
+	0x0039, 0x002C, 0x0028, 0x0041, 0x0029, 0x0028, 0x0042, 0x0029,
+	0x0028, 0x0043, 0x0029, 0x0028, 0x0044, 0x0029, 0x0028, 0x0045,
+	0x0029, 0x0028, 0x0046, 0x0029, 0x0028, 0x0047, 0x0029, 0x0028,
+	0x0048, 0x0029, 0x0028, 0x0049, 0x0029, 0x0028, 0x004A, 0x0029,
+	0x0028, 0x004B, 0x0029, 0x0028, 0x004C, 0x0029, 0x0028, 0x004D,
+	0x0029, 0x0028, 0x004E, 0x0029, 0x0028, 0x004F, 0x0029, 0x0028,
+	0x0050, 0x0029, 0x0028, 0x0051, 0x0029, 0x0028, 0x0052, 0x0029,
+	0x0028, 0x0053, 0x0029, 0x0028, 0x0054, 0x0029, 0x0028, 0x0055,
+	0x0029, 0x0028, 0x0056, 0x0029, 0x0028, 0x0057, 0x0029, 0x0028,
+	0x0058, 0x0029, 0x0028, 0x0059, 0x0029, 0x0028, 0x005A, 0x0029,
+	0x3014, 0x0053, 0x3015, 0x0043, 0x0044, 0x0057, 0x005A, 0x0048,
+	0x0056, 0x0053, 0x0044, 0x0053, 0x0053, 0x0050, 0x0050, 0x0056,
+	0x0057, 0x0043, 0x004D, 0x0043, 0x004D, 0x0044, 0x004D, 0x0052,
+	0x0044, 0x004A, 0x307B, 0x304B, 0x30B3, 0x30B3, 0x5B57, 0x53CC,
+	0x591A, 0x89E3, 0x4EA4, 0x6620, 0x7121, 0x524D, 0x5F8C, 0x518D,
+	0x65B0, 0x521D, 0x7D42, 0x8CA9, 0x58F0, 0x5439, 0x6F14, 0x6295,
+	0x6355, 0x904A, 0x6307, 0x6253, 0x7981, 0x7A7A, 0x5408, 0x6E80,
+	0x7533, 0x5272, 0x55B6, 0x914D, 0x3014, 0x672C, 0x3015, 0x3014,
+	0x4E09, 0x3015, 0x3014, 0x4E8C, 0x3015, 0x3014, 0x5B89, 0x3015,
+	0x3014, 0x70B9, 0x3015, 0x3014, 0x6253, 0x3015, 0x3014, 0x76D7,
+	0x3015, 0x3014, 0x52DD, 0x3015, 0x3014, 0x6557, 0x3015, 0x5F97,
+	0x53EF, 0x4E3D, 0x4E38, 0x4E41, 0x20122, 0x4F60, 0x4FBB, 0x5002,
+	0x507A, 0x5099, 0x50CF, 0x349E, 0x2063A, 0x5154, 0x5164, 0x5177,
+	0x2051C, 0x34B9, 0x5167, 0x2054B, 0x5197, 0x51A4, 0x4ECC, 0x51AC,
+	0x291DF, 0x5203, 0x34DF, 0x523B, 0x5246, 0x5277, 0x3515, 0x5305,
+	0x5306, 0x5349, 0x535A, 0x5373, 0x537D, 0x537F, 0x20A2C, 0x7070,
+	0x53CA, 0x53DF, 0x20B63, 0x53EB, 0x53F1, 0x5406, 0x549E, 0x5438,
+	0x5448, 0x5468, 0x54A2, 0x54F6, 0x5510, 0x5553, 0x5563, 0x5584,
+	0x55AB, 0x55B3, 0x55C2, 0x5716, 0x5717, 0x5651, 0x5674, 0x58EE,
+	0x57CE, 0x57F4, 0x580D, 0x578B, 0x5832, 0x5831, 0x58AC, 0x214E4,
+	0x58F2, 0x58F7, 0x5906, 0x5922, 0x5962, 0x216A8, 0x216EA, 0x59EC,
+	0x5A1B, 0x5A27, 0x59D8, 0x5A66, 0x36EE, 0x36FC, 0x5B08, 0x5B3E,
+	0x219C8, 0x5BC3, 0x5BD8, 0x5BF3, 0x21B18, 0x5BFF, 0x5C06, 0x5F53,
+	0x3781, 0x5C60, 0x5CC0, 0x5C8D, 0x21DE4, 0x5D43, 0x21DE6, 0x5D6E,
+	0x5D6B, 0x5D7C, 0x5DE1, 0x5DE2, 0x382F, 0x5DFD, 0x5E28, 0x5E3D,
+	0x5E69, 0x3862, 0x22183, 0x387C, 0x5EB0, 0x5EB3, 0x5EB6, 0x2A392,
+	0x22331, 0x8201, 0x5F22, 0x38C7, 0x232B8, 0x261DA, 0x5F62, 0x5F6B,
+	0x38E3, 0x5F9A, 0x5FCD, 0x5FD7, 0x5FF9, 0x6081, 0x393A, 0x391C,
+	0x226D4, 0x60C7, 0x6148, 0x614C, 0x617A, 0x61B2, 0x61A4, 0x61AF,
+	0x61DE, 0x6210, 0x621B, 0x625D, 0x62B1, 0x62D4, 0x6350, 0x22B0C,
+	0x633D, 0x62FC, 0x6368, 0x6383, 0x63E4, 0x22BF1, 0x6422, 0x63C5,
+	0x63A9, 0x3A2E, 0x6469, 0x647E, 0x649D, 0x6477, 0x3A6C, 0x656C,
+	0x2300A, 0x65E3, 0x66F8, 0x6649, 0x3B19, 0x3B08, 0x3AE4, 0x5192,
+	0x5195, 0x6700, 0x669C, 0x80AD, 0x43D9, 0x6721, 0x675E, 0x6753,
+	0x233C3, 0x3B49, 0x67FA, 0x6785, 0x6852, 0x2346D, 0x688E, 0x681F,
+	0x6914, 0x6942, 0x69A3, 0x69EA, 0x6AA8, 0x236A3, 0x6ADB, 0x3C18,
+	0x6B21, 0x238A7, 0x6B54, 0x3C4E, 0x6B72, 0x6B9F, 0x6BBB, 0x23A8D,
+	0x21D0B, 0x23AFA, 0x6C4E, 0x23CBC, 0x6CBF, 0x6CCD, 0x6C67, 0x6D16,
+	0x6D3E, 0x6D69, 0x6D78, 0x6D85, 0x23D1E, 0x6D34, 0x6E2F, 0x6E6E,
+	0x3D33, 0x6EC7, 0x23ED1, 0x6DF9, 0x6F6E, 0x23F5E, 0x23F8E, 0x6FC6,
+	0x7039, 0x701B, 0x3D96, 0x704A, 0x707D, 0x7077, 0x70AD, 0x20525,
+	0x7145, 0x24263, 0x719C, 0x243AB, 0x7228, 0x7250, 0x24608, 0x7280,
+	0x7295, 0x24735, 0x24814, 0x737A, 0x738B, 0x3EAC, 0x73A5, 0x3EB8,
+	0x7447, 0x745C, 0x7485, 0x74CA, 0x3F1B, 0x7524, 0x24C36, 0x753E,
+	0x24C92, 0x2219F, 0x7610, 0x24FA1, 0x24FB8, 0x25044, 0x3FFC, 0x4008,
+	0x250F3, 0x250F2, 0x25119, 0x25133, 0x771E, 0x771F, 0x778B, 0x4046,
+	0x4096, 0x2541D, 0x784E, 0x40E3, 0x25626, 0x2569A, 0x256C5, 0x79EB,
+	0x412F, 0x7A4A, 0x7A4F, 0x2597C, 0x25AA7, 0x7AEE, 0x4202, 0x25BAB,
+	0x7BC6, 0x7BC9, 0x4227, 0x25C80, 0x7CD2, 0x42A0, 0x7CE8, 0x7CE3,
+	0x7D00, 0x25F86, 0x7D63, 0x4301, 0x7DC7, 0x7E02, 0x7E45, 0x4334,
+	0x26228, 0x26247, 0x4359, 0x262D9, 0x7F7A, 0x2633E, 0x7F95, 0x7FFA,
+	0x264DA, 0x26523, 0x8060, 0x265A8, 0x8070, 0x2335F, 0x43D5, 0x80B2,
+	0x8103, 0x440B, 0x813E, 0x5AB5, 0x267A7, 0x267B5, 0x23393, 0x2339C,
+	0x8204, 0x8F9E, 0x446B, 0x8291, 0x828B, 0x829D, 0x52B3, 0x82B1,
+	0x82B3, 0x82BD, 0x82E6, 0x26B3C, 0x831D, 0x8363, 0x83AD, 0x8323,
+	0x83BD, 0x83E7, 0x8353, 0x83CA, 0x83CC, 0x83DC, 0x26C36, 0x26D6B,
+	0x26CD5, 0x452B, 0x84F1, 0x84F3, 0x8516, 0x273CA, 0x8564, 0x26F2C,
+	0x455D, 0x4561, 0x26FB1, 0x270D2, 0x456B, 0x8650, 0x8667, 0x8669,
+	0x86A9, 0x8688, 0x870E, 0x86E2, 0x8728, 0x876B, 0x8786, 0x45D7,
+	0x87E1, 0x8801, 0x45F9, 0x8860, 0x27667, 0x88D7, 0x88DE, 0x4635,
+	0x88FA, 0x34BB, 0x278AE, 0x27966, 0x46BE, 0x46C7, 0x8AA0, 0x27CA8,
+	0x8CAB, 0x8CC1, 0x8D1B, 0x8D77, 0x27F2F, 0x20804, 0x8DCB, 0x8DBC,
+	0x8DF0, 0x208DE, 0x8ED4, 0x285D2, 0x285ED, 0x9094, 0x90F1, 0x9111,
+	0x2872E, 0x911B, 0x9238, 0x92D7, 0x92D8, 0x927C, 0x93F9, 0x9415,
+	0x28BFA, 0x958B, 0x4995, 0x95B7, 0x28D77, 0x49E6, 0x96C3, 0x5DB2,
+	0x9723, 0x29145, 0x2921A, 0x4A6E, 0x4A76, 0x97E0, 0x2940A, 0x4AB2,
+	0x29496, 0x9829, 0x295B6, 0x98E2, 0x4B33, 0x9929, 0x99A7, 0x99C2,
+	0x99FE, 0x4BCE, 0x29B30, 0x9C40, 0x9CFD, 0x4CCE, 0x4CED, 0x9D67,
+	0x2A0CE, 0x4CF8, 0x2A105, 0x2A20E, 0x2A291, 0x4D56, 0x9EFE, 0x9F05,
+	0x9F0F, 0x9F16, 0x2A600,
+}
+
+// compositionTable holds canonical composition pairs sorted by (a, b),
+// composition exclusions are left out. Hangul syllables are composed
+// arithmetically and not listed.
+var compositionTable = [...]compositionPair{
+	{0x003C, 0x0338, 0x226E},
+	{0x003D, 0x0338, 0x2260},
+	{0x003E, 0x0338, 0x226F},
+	{0x0041, 0x0300, 0x00C0},
+	{0x0041, 0x0301, 0x00C1},
+	{0x0041, 0x0302, 0x00C2},
+	{0x0041, 0x0303, 0x00C3},
+	{0x0041, 0x0304, 0x0100},
+	{0x0041, 0x0306, 0x0102},
+	{0x0041, 0x0307, 0x0226},
+	{0x0041, 0x0308, 0x00C4},
+	{0x0041, 0x0309, 0x1EA2},
+	{0x0041, 0x030A, 0x00C5},
+	{0x0041, 0x030C, 0x01CD},
+	{0x0041, 0x030F, 0x0200},
+	{0x0041, 0x0311, 0x0202},
+	{0x0041, 0x0323, 0x1EA0},
+	{0x0041, 0x0325, 0x1E00},
+	{0x0041, 0x0328, 0x0104},
+	{0x0042, 0x0307, 0x1E02},
+	{0x0042, 0x0323, 0x1E04},
+	{0x0042, 0x0331, 0x1E06},
+	{0x0043, 0x0301, 0x0106},
+	{0x0043, 0x0302, 0x0108},
+	{0x0043, 0x0307, 0x010A},
+	{0x0043, 0x030C, 0x010C},
+	{0x0043, 0x0327, 0x00C7},
+	{0x0044, 0x0307, 0x1E0A},
+	{0x0044, 0x030C, 0x010E},
+	{0x0044, 0x0323, 0x1E0C},
+	{0x0044, 0x0327, 0x1E10},
+	{0x0044, 0x032D, 0x1E12},
+	{0x0044, 0x0331, 0x1E0E},
+	{0x0045, 0x0300, 0x00C8},
+	{0x0045, 0x0301, 0x00C9},
+	{0x0045, 0x0302, 0x00CA},
+	{0x0045, 0x0303, 0x1EBC},
+	{0x0045, 0x0304, 0x0112},
+	{0x0045, 0x0306, 0x0114},
+	{0x0045, 0x0307, 0x0116},
+	{0x0045, 0x0308, 0x00CB},
+	{0x0045, 0x0309, 0x1EBA},
+	{0x0045, 0x030C, 0x011A},
+	{0x0045, 0x030F, 0x0204},
+	{0x0045, 0x0311, 0x0206},
+	{0x0045, 0x0323, 0x1EB8},
+	{0x0045, 0x0327, 0x0228},
+	{0x0045, 0x0328, 0x0118},
+	{0x0045, 0x032D, 0x1E18},
+	{0x0045, 0x0330, 0x1E1A},
+	{0x0046, 0x0307, 0x1E1E},
+	{0x0047, 0x0301, 0x01F4},
+	{0x0047, 0x0302, 0x011C},
+	{0x0047, 0x0304, 0x1E20},
+	{0x0047, 0x0306, 0x011E},
+	{0x0047, 0x0307, 0x0120},
+	{0x0047, 0x030C, 0x01E6},
+	{0x0047, 0x0327, 0x0122},
+	{0x0048, 0x0302, 0x0124},
+	{0x0048, 0x0307, 0x1E22},
+	{0x0048, 0x0308, 0x1E26},
+	{0x0048, 0x030C, 0x021E},
+	{0x0048, 0x0323, 0x1E24},
+	{0x0048, 0x0327, 0x1E28},
+	{0x0048, 0x032E, 0x1E2A},
+	{0x0049, 0x0300, 0x00CC},
+	{0x0049, 0x0301, 0x00CD},
+	{0x0049, 0x0302, 0x00CE},
+	{0x0049, 0x0303, 0x0128},
+	{0x0049, 0x0304, 0x012A},
+	{0x0049, 0x0306, 0x012C},
+	{0x0049, 0x0307, 0x0130},
+	{0x0049, 0x0308, 0x00CF},
+	{0x0049, 0x0309, 0x1EC8},
+	{0x0049, 0x030C, 0x01CF},
+	{0x0049, 0x030F, 0x0208},
+	{0x0049, 0x0311, 0x020A},
+	{0x0049, 0x0323, 0x1ECA},
+	{0x0049, 0x0328, 0x012E},
+	{0x0049, 0x0330, 0x1E2C},
+	{0x004A, 0x0302, 0x0134},
+	{0x004B, 0x0301, 0x1E30},
+	{0x004B, 0x030C, 0x01E8},
+	{0x004B, 0x0323, 0x1E32},
+	{0x004B, 0x0327, 0x0136},
+	{0x004B, 0x0331, 0x1E34},
+	{0x004C, 0x0301, 0x0139},
+	{0x004C, 0x030C, 0x013D},
+	{0x004C, 0x0323, 0x1E36},
+	{0x004C, 0x0327, 0x013B},
+	{0x004C, 0x032D, 0x1E3C},
+	{0x004C, 0x0331, 0x1E3A},
+	{0x004D, 0x0301, 0x1E3E},
+	{0x004D, 0x0307, 0x1E40},
+	{0x004D, 0x0323, 0x1E42},
+	{0x004E, 0x0300, 0x01F8},
+	{0x004E, 0x0301, 0x0143},
+	{0x004E, 0x0303, 0x00D1},
+	{0x004E, 0x0307, 0x1E44},
+	{0x004E, 0x030C, 0x0147},
+	{0x004E, 0x0323, 0x1E46},
+	{0x004E, 0x0327, 0x0145},
+	{0x004E, 0x032D, 0x1E4A},
+	{0x004E, 0x0331, 0x1E48},
+	{0x004F, 0x0300, 0x00D2},
+	{0x004F, 0x0301, 0x00D3},
+	{0x004F, 0x0302, 0x00D4},
+	{0x004F, 0x0303, 0x00D5},
+	{0x004F, 0x0304, 0x014C},
+	{0x004F, 0x0306, 0x014E},
+	{0x004F, 0x0307, 0x022E},
+	{0x004F, 0x0308, 0x00D6},
+	{0x004F, 0x0309, 0x1ECE},
+	{0x004F, 0x030B, 0x0150},
+	{0x004F, 0x030C, 0x01D1},
+	{0x004F, 0x030F, 0x020C},
+	{0x004F, 0x0311, 0x020E},
+	{0x004F, 0x031B, 0x01A0},
+	{0x004F, 0x0323, 0x1ECC},
+	{0x004F, 0x0328, 0x01EA},
+	{0x0050, 0x0301, 0x1E54},
+	{0x0050, 0x0307, 0x1E56},
+	{0x0052, 0x0301, 0x0154},
+	{0x0052, 0x0307, 0x1E58},
+	{0x0052, 0x030C, 0x0158},
+	{0x0052, 0x030F, 0x0210},
+	{0x0052, 0x0311, 0x0212},
+	{0x0052, 0x0323, 0x1E5A},
+	{0x0052, 0x0327, 0x0156},
+	{0x0052, 0x0331, 0x1E5E},
+	{0x0053, 0x0301, 0x015A},
+	{0x0053, 0x0302, 0x015C},
+	{0x0053, 0x0307, 0x1E60},
+	{0x0053, 0x030C, 0x0160},
+	{0x0053, 0x0323, 0x1E62},
+	{0x0053, 0x0326, 0x0218},
+	{0x0053, 0x0327, 0x015E},
+	{0x0054, 0x0307, 0x1E6A},
+	{0x0054, 0x030C, 0x0164},
+	{0x0054, 0x0323, 0x1E6C},
+	{0x0054, 0x0326, 0x021A},
+	{0x0054, 0x0327, 0x0162},
+	{0x0054, 0x032D, 0x1E70},
+	{0x0054, 0x0331, 0x1E6E},
+	{0x0055, 0x0300, 0x00D9},
+	{0x0055, 0x0301, 0x00DA},
+	{0x0055, 0x0302, 0x00DB},
+	{0x0055, 0x0303, 0x0168},
+	{0x0055, 0x0304, 0x016A},
+	{0x0055, 0x0306, 0x016C},
+	{0x0055, 0x0308, 0x00DC},
+	{0x0055, 0x0309, 0x1EE6},
+	{0x0055, 0x030A, 0x016E},
+	{0x0055, 0x030B, 0x0170},
+	{0x0055, 0x030C, 0x01D3},
+	{0x0055, 0x030F, 0x0214},
+	{0x0055, 0x0311, 0x0216},
+	{0x0055, 0x031B, 0x01AF},
+	{0x0055, 0x0323, 0x1EE4},
+	{0x0055, 0x0324, 0x1E72},
+	{0x0055, 0x0328, 0x0172},
+	{0x0055, 0x032D, 0x1E76},
+	{0x0055, 0x0330, 0x1E74},
+	{0x0056, 0x0303, 0x1E7C},
+	{0x0056, 0x0323, 0x1E7E},
+	{0x0057, 0x0300, 0x1E80},
+	{0x0057, 0x0301, 0x1E82},
+	{0x0057, 0x0302, 0x0174},
+	{0x0057, 0x0307, 0x1E86},
+	{0x0057, 0x0308, 0x1E84},
+	{0x0057, 0x0323, 0x1E88},
+	{0x0058, 0x0307, 0x1E8A},
+	{0x0058, 0x0308, 0x1E8C},
+	{0x0059, 0x0300, 0x1EF2},
+	{0x0059, 0x0301, 0x00DD},
+	{0x0059, 0x0302, 0x0176},
+	{0x0059, 0x0303, 0x1EF8},
+	{0x0059, 0x0304, 0x0232},
+	{0x0059, 0x0307, 0x1E8E},
+	{0x0059, 0x0308, 0x0178},
+	{0x0059, 0x0309, 0x1EF6},
+	{0x0059, 0x0323, 0x1EF4},
+	{0x005A, 0x0301, 0x0179},
+	{0x005A, 0x0302, 0x1E90},
+	{0x005A, 0x0307, 0x017B},
+	{0x005A, 0x030C, 0x017D},
+	{0x005A, 0x0323, 0x1E92},
+	{0x005A, 0x0331, 0x1E94},
+	{0x0061, 0x0300, 0x00E0},
+	{0x0061, 0x0301, 0x00E1},
+	{0x0061, 0x0302, 0x00E2},
+	{0x0061, 0x0303, 0x00E3},
+	{0x0061, 0x0304, 0x0101},
+	{0x0061, 0x0306, 0x0103},
+	{0x0061, 0x0307, 0x0227},
+	{0x0061, 0x0308, 0x00E4},
+	{0x0061, 0x0309, 0x1EA3},
+	{0x0061, 0x030A, 0x00E5},
+	{0x0061, 0x030C, 0x01CE},
+	{0x0061, 0x030F, 0x0201},
+	{0x0061, 0x0311, 0x0203},
+	{0x0061, 0x0323, 0x1EA1},
+	{0x0061, 0x0325, 0x1E01},
+	{0x0061, 0x0328, 0x0105},
+	{0x0062, 0x0307, 0x1E03},
+	{0x0062, 0x0323, 0x1E05},
+	{0x0062, 0x0331, 0x1E07},
+	{0x0063, 0x0301, 0x0107},
+	{0x0063, 0x0302, 0x0109},
+	{0x0063, 0x0307, 0x010B},
+	{0x0063, 0x030C, 0x010D},
+	{0x0063, 0x0327, 0x00E7},
+	{0x0064, 0x0307, 0x1E0B},
+	{0x0064, 0x030C, 0x010F},
+	{0x0064, 0x0323, 0x1E0D},
+	{0x0064, 0x0327, 0x1E11},
+	{0x0064, 0x032D, 0x1E13},
+	{0x0064, 0x0331, 0x1E0F},
+	{0x0065, 0x0300, 0x00E8},
+	{0x0065, 0x0301, 0x00E9},
+	{0x0065, 0x0302, 0x00EA},
+	{0x0065, 0x0303, 0x1EBD},
+	{0x0065, 0x0304, 0x0113},
+	{0x0065, 0x0306, 0x0115},
+	{0x0065, 0x0307, 0x0117},
+	{0x0065, 0x0308, 0x00EB},
+	{0x0065, 0x0309, 0x1EBB},
+	{0x0065, 0x030C, 0x011B},
+	{0x0065, 0x030F, 0x0205},
+	{0x0065, 0x0311, 0x0207},
+	{0x0065, 0x0323, 0x1EB9},
+	{0x0065, 0x0327, 0x0229},
+	{0x0065, 0x0328, 0x0119},
+	{0x0065, 0x032D, 0x1E19},
+	{0x0065, 0x0330, 0x1E1B},
+	{0x0066, 0x0307, 0x1E1F},
+	{0x0067, 0x0301, 0x01F5},
+	{0x0067, 0x0302, 0x011D},
+	{0x0067, 0x0304, 0x1E21},
+	{0x0067, 0x0306, 0x011F},
+	{0x0067, 0x0307, 0x0121},
+	{0x0067, 0x030C, 0x01E7},
+	{0x0067, 0x0327, 0x0123},
+	{0x0068, 0x0302, 0x0125},
+	{0x0068, 0x0307, 0x1E23},
+	{0x0068, 0x0308, 0x1E27},
+	{0x0068, 0x030C, 0x021F},
+	{0x0068, 0x0323, 0x1E25},
+	{0x0068, 0x0327, 0x1E29},
+	{0x0068, 0x032E, 0x1E2B},
+	{0x0068, 0x0331, 0x1E96},
+	{0x0069, 0x0300, 0x00EC},
+	{0x0069, 0x0301, 0x00ED},
+	{0x0069, 0x0302, 0x00EE},
+	{0x0069, 0x0303, 0x0129},
+	{0x0069, 0x0304, 0x012B},
+	{0x0069, 0x0306, 0x012D},
+	{0x0069, 0x0308, 0x00EF},
+	{0x0069, 0x0309, 0x1EC9},
+	{0x0069, 0x030C, 0x01D0},
+	{0x0069, 0x030F, 0x0209},
+	{0x0069, 0x0311, 0x020B},
+	{0x0069, 0x0323, 0x1ECB},
+	{0x0069, 0x0328, 0x012F},
+	{0x0069, 0x0330, 0x1E2D},
+	{0x006A, 0x0302, 0x0135},
+	{0x006A, 0x030C, 0x01F0},
+	{0x006B, 0x0301, 0x1E31},
+	{0x006B, 0x030C, 0x01E9},
+	{0x006B, 0x0323, 0x1E33},
+	{0x006B, 0x0327, 0x0137},
+	{0x006B, 0x0331, 0x1E35},
+	{0x006C, 0x0301, 0x013A},
+	{0x006C, 0x030C, 0x013E},
+	{0x006C, 0x0323, 0x1E37},
+	{0x006C, 0x0327, 0x013C},
+	{0x006C, 0x032D, 0x1E3D},
+	{0x006C, 0x0331, 0x1E3B},
+	{0x006D, 0x0301, 0x1E3F},
+	{0x006D, 0x0307, 0x1E41},
+	{0x006D, 0x0323, 0x1E43},
+	{0x006E, 0x0300, 0x01F9},
+	{0x006E, 0x0301, 0x0144},
+	{0x006E, 0x0303, 0x00F1},
+	{0x006E, 0x0307, 0x1E45},
+	{0x006E, 0x030C, 0x0148},
+	{0x006E, 0x0323, 0x1E47},
+	{0x006E, 0x0327, 0x0146},
+	{0x006E, 0x032D, 0x1E4B},
+	{0x006E, 0x0331, 0x1E49},
+	{0x006F, 0x0300, 0x00F2},
+	{0x006F, 0x0301, 0x00F3},
+	{0x006F, 0x0302, 0x00F4},
+	{0x006F, 0x0303, 0x00F5},
+	{0x006F, 0x0304, 0x014D},
+	{0x006F, 0x0306, 0x014F},
+	{0x006F, 0x0307, 0x022F},
+	{0x006F, 0x0308, 0x00F6},
+	{0x006F, 0x0309, 0x1ECF},
+	{0x006F, 0x030B, 0x0151},
+	{0x006F, 0x030C, 0x01D2},
+	{0x006F, 0x030F, 0x020D},
+	{0x006F, 0x0311, 0x020F},
+	{0x006F, 0x031B, 0x01A1},
+	{0x006F, 0x0323, 0x1ECD},
+	{0x006F, 0x0328, 0x01EB},
+	{0x0070, 0x0301, 0x1E55},
+	{0x0070, 0x0307, 0x1E57},
+	{0x0072, 0x0301, 0x0155},
+	{0x0072, 0x0307, 0x1E59},
+	{0x0072, 0x030C, 0x0159},
+	{0x0072, 0x030F, 0x0211},
+	{0x0072, 0x0311, 0x0213},
+	{0x0072, 0x0323, 0x1E5B},
+	{0x0072, 0x0327, 0x0157},
+	{0x0072, 0x0331, 0x1E5F},
+	{0x0073, 0x0301, 0x015B},
+	{0x0073, 0x0302, 0x015D},
+	{0x0073, 0x0307, 0x1E61},
+	{0x0073, 0x030C, 0x0161},
+	{0x0073, 0x0323, 0x1E63},
+	{0x0073, 0x0326, 0x0219},
+	{0x0073, 0x0327, 0x015F},
+	{0x0074, 0x0307, 0x1E6B},
+	{0x0074, 0x0308, 0x1E97},
+	{0x0074, 0x030C, 0x0165},
+	{0x0074, 0x0323, 0x1E6D},
+	{0x0074, 0x0326, 0x021B},
+	{0x0074, 0x0327, 0x0163},
+	{0x0074, 0x032D, 0x1E71},
+	{0x0074, 0x0331, 0x1E6F},
+	{0x0075, 0x0300, 0x00F9},
+	{0x0075, 0x0301, 0x00FA},
+	{0x0075, 0x0302, 0x00FB},
+	{0x0075, 0x0303, 0x0169},
+	{0x0075, 0x0304, 0x016B},
+	{0x0075, 0x0306, 0x016D},
+	{0x0075, 0x0308, 0x00FC},
+	{0x0075, 0x0309, 0x1EE7},
+	{0x0075, 0x030A, 0x016F},
+	{0x0075, 0x030B, 0x0171},
+	{0x0075, 0x030C, 0x01D4},
+	{0x0075, 0x030F, 0x0215},
+	{0x0075, 0x0311, 0x0217},
+	{0x0075, 0x031B, 0x01B0},
+	{0x0075, 0x0323, 0x1EE5},
+	{0x0075, 0x0324, 0x1E73},
+	{0x0075, 0x0328, 0x0173},
+	{0x0075, 0x032D, 0x1E77},
+	{0x0075, 0x0330, 0x1E75},
+	{0x0076, 0x0303, 0x1E7D},
+	{0x0076, 0x0323, 0x1E7F},
+	{0x0077, 0x0300, 0x1E81},
+	{0x0077, 0x0301, 0x1E83},
+	{0x0077, 0x0302, 0x0175},
+	{0x0077, 0x0307, 0x1E87},
+	{0x0077, 0x0308, 0x1E85},
+	{0x0077, 0x030A, 0x1E98},
+	{0x0077, 0x0323, 0x1E89},
+	{0x0078, 0x0307, 0x1E8B},
+	{0x0078, 0x0308, 0x1E8D},
+	{0x0079, 0x0300, 0x1EF3},
+	{0x0079, 0x0301, 0x00FD},
+	{0x0079, 0x0302, 0x0177},
+	{0x0079, 0x0303, 0x1EF9},
+	{0x0079, 0x0304, 0x0233},
+	{0x0079, 0x0307, 0x1E8F},
+	{0x0079, 0x0308, 0x00FF},
+	{0x0079, 0x0309, 0x1EF7},
+	{0x0079, 0x030A, 0x1E99},
+	{0x0079, 0x0323, 0x1EF5},
+	{0x007A, 0x0301, 0x017A},
+	{0x007A, 0x0302, 0x1E91},
+	{0x007A, 0x0307, 0x017C},
+	{0x007A, 0x030C, 0x017E},
+	{0x007A, 0x0323, 0x1E93},
+	{0x007A, 0x0331, 0x1E95},
+	{0x00A8, 0x0300, 0x1FED},
+	{0x00A8, 0x0301, 0x0385},
+	{0x00A8, 0x0342, 0x1FC1},
+	{0x00C2, 0x0300, 0x1EA6},
+	{0x00C2, 0x0301, 0x1EA4},
+	{0x00C2, 0x0303, 0x1EAA},
+	{0x00C2, 0x0309, 0x1EA8},
+	{0x00C4, 0x0304, 0x01DE},
+	{0x00C5, 0x0301, 0x01FA},
+	{0x00C6, 0x0301, 0x01FC},
+	{0x00C6, 0x0304, 0x01E2},
+	{0x00C7, 0x0301, 0x1E08},
+	{0x00CA, 0x0300, 0x1EC0},
+	{0x00CA, 0x0301, 0x1EBE},
+	{0x00CA, 0x0303, 0x1EC4},
+	{0x00CA, 0x0309, 0x1EC2},
+	{0x00CF, 0x0301, 0x1E2E},
+	{0x00D4, 0x0300, 0x1ED2},
+	{0x00D4, 0x0301, 0x1ED0},
+	{0x00D4, 0x0303, 0x1ED6},
+	{0x00D4, 0x0309, 0x1ED4},
+	{0x00D5, 0x0301, 0x1E4C},
+	{0x00D5, 0x0304, 0x022C},
+	{0x00D5, 0x0308, 0x1E4E},
+	{0x00D6, 0x0304, 0x022A},
+	{0x00D8, 0x0301, 0x01FE},
+	{0x00DC, 0x0300, 0x01DB},
+	{0x00DC, 0x0301, 0x01D7},
+	{0x00DC, 0x0304, 0x01D5},
+	{0x00DC, 0x030C, 0x01D9},
+	{0x00E2, 0x0300, 0x1EA7},
+	{0x00E2, 0x0301, 0x1EA5},
+	{0x00E2, 0x0303, 0x1EAB},
+	{0x00E2, 0x0309, 0x1EA9},
+	{0x00E4, 0x0304, 0x01DF},
+	{0x00E5, 0x0301, 0x01FB},
+	{0x00E6, 0x0301, 0x01FD},
+	{0x00E6, 0x0304, 0x01E3},
+	{0x00E7, 0x0301, 0x1E09},
+	{0x00EA, 0x0300, 0x1EC1},
+	{0x00EA, 0x0301, 0x1EBF},
+	{0x00EA, 0x0303, 0x1EC5},
+	{0x00EA, 0x0309, 0x1EC3},
+	{0x00EF, 0x0301, 0x1E2F},
+	{0x00F4, 0x0300, 0x1ED3},
+	{0x00F4, 0x0301, 0x1ED1},
+	{0x00F4, 0x0303, 0x1ED7},
+	{0x00F4, 0x0309, 0x1ED5},
+	{0x00F5, 0x0301, 0x1E4D},
+	{0x00F5, 0x0304, 0x022D},
+	{0x00F5, 0x0308, 0x1E4F},
+	{0x00F6, 0x0304, 0x022B},
+	{0x00F8, 0x0301, 0x01FF},
+	{0x00FC, 0x0300, 0x01DC},
+	{0x00FC, 0x0301, 0x01D8},
+	{0x00FC, 0x0304, 0x01D6},
+	{0x00FC, 0x030C, 0x01DA},
+	{0x0102, 0x0300, 0x1EB0},
+	{0x0102, 0x0301, 0x1EAE},
+	{0x0102, 0x0303, 0x1EB4},
+	{0x0102, 0x0309, 0x1EB2},
+	{0x0103, 0x0300, 0x1EB1},
+	{0x0103, 0x0301, 0x1EAF},
+	{0x0103, 0x0303, 0x1EB5},
+	{0x0103, 0x0309, 0x1EB3},
+	{0x0112, 0x0300, 0x1E14},
+	{0x0112, 0x0301, 0x1E16},
+	{0x0113, 0x0300, 0x1E15},
+	{0x0113, 0x0301, 0x1E17},
+	{0x014C, 0x0300, 0x1E50},
+	{0x014C, 0x0301, 0x1E52},
+	{0x014D, 0x0300, 0x1E51},
+	{0x014D, 0x0301, 0x1E53},
+	{0x015A, 0x0307, 0x1E64},
+	{0x015B, 0x0307, 0x1E65},
+	{0x0160, 0x0307, 0x1E66},
+	{0x0161, 0x0307, 0x1E67},
+	{0x0168, 0x0301, 0x1E78},
+	{0x0169, 0x0301, 0x1E79},
+	{0x016A, 0x0308, 0x1E7A},
+	{0x016B, 0x0308, 0x1E7B},
+	{0x017F, 0x0307, 0x1E9B},
+	{0x01A0, 0x0300, 0x1EDC},
+	{0x01A0, 0x0301, 0x1EDA},
+	{0x01A0, 0x0303, 0x1EE0},
+	{0x01A0, 0x0309, 0x1EDE},
+	{0x01A0, 0x0323, 0x1EE2},
+	{0x01A1, 0x0300, 0x1EDD},
+	{0x01A1, 0x0301, 0x1EDB},
+	{0x01A1, 0x0303, 0x1EE1},
+	{0x01A1, 0x0309, 0x1EDF},
+	{0x01A1, 0x0323, 0x1EE3},
+	{0x01AF, 0x0300, 0x1EEA},
+	{0x01AF, 0x0301, 0x1EE8},
+	{0x01AF, 0x0303, 0x1EEE},
+	{0x01AF, 0x0309, 0x1EEC},
+	{0x01AF, 0x0323, 0x1EF0},
+	{0x01B0, 0x0300, 0x1EEB},
+	{0x01B0, 0x0301, 0x1EE9},
+	{0x01B0, 0x0303, 0x1EEF},
+	{0x01B0, 0x0309, 0x1EED},
+	{0x01B0, 0x0323, 0x1EF1},
+	{0x01B7, 0x030C, 0x01EE},
+	{0x01EA, 0x0304, 0x01EC},
+	{0x01EB, 0x0304, 0x01ED},
+	{0x0226, 0x0304, 0x01E0},
+	{0x0227, 0x0304, 0x01E1},
+	{0x0228, 0x0306, 0x1E1C},
+	{0x0229, 0x0306, 0x1E1D},
+	{0x022E, 0x0304, 0x0230},
+	{0x022F, 0x0304, 0x0231},
+	{0x0292, 0x030C, 0x01EF},
+	{0x0391, 0x0300, 0x1FBA},
+	{0x0391, 0x0301, 0x0386},
+	{0x0391, 0x0304, 0x1FB9},
+	{0x0391, 0x0306, 0x1FB8},
+	{0x0391, 0x0313, 0x1F08},
+	{0x0391, 0x0314, 0x1F09},
+	{0x0391, 0x0345, 0x1FBC},
+	{0x0395, 0x0300, 0x1FC8},
+	{0x0395, 0x0301, 0x0388},
+	{0x0395, 0x0313, 0x1F18},
+	{0x0395, 0x0314, 0x1F19},
+	{0x0397, 0x0300, 0x1FCA},
+	{0x0397, 0x0301, 0x0389},
+	{0x0397, 0x0313, 0x1F28},
+	{0x0397, 0x0314, 0x1F29},
+	{0x0397, 0x0345, 0x1FCC},
+	{0x0399, 0x0300, 0x1FDA},
+	{0x0399, 0x0301, 0x038A},
+	{0x0399, 0x0304, 0x1FD9},
+	{0x0399, 0x0306, 0x1FD8},
+	{0x0399, 0x0308, 0x03AA},
+	{0x0399, 0x0313, 0x1F38},
+	{0x0399, 0x0314, 0x1F39},
+	{0x039F, 0x0300, 0x1FF8},
+	{0x039F, 0x0301, 0x038C},
+	{0x039F, 0x0313, 0x1F48},
+	{0x039F, 0x0314, 0x1F49},
+	{0x03A1, 0x0314, 0x1FEC},
+	{0x03A5, 0x0300, 0x1FEA},
+	{0x03A5, 0x0301, 0x038E},
+	{0x03A5, 0x0304, 0x1FE9},
+	{0x03A5, 0x0306, 0x1FE8},
+	{0x03A5, 0x0308, 0x03AB},
+	{0x03A5, 0x0314, 0x1F59},
+	{0x03A9, 0x0300, 0x1FFA},
+	{0x03A9, 0x0301, 0x038F},
+	{0x03A9, 0x0313, 0x1F68},
+	{0x03A9, 0x0314, 0x1F69},
+	{0x03A9, 0x0345, 0x1FFC},
+	{0x03AC, 0x0345, 0x1FB4},
+	{0x03AE, 0x0345, 0x1FC4},
+	{0x03B1, 0x0300, 0x1F70},
+	{0x03B1, 0x0301, 0x03AC},
+	{0x03B1, 0x0304, 0x1FB1},
+	{0x03B1, 0x0306, 0x1FB0},
+	{0x03B1, 0x0313, 0x1F00},
+	{0x03B1, 0x0314, 0x1F01},
+	{0x03B1, 0x0342, 0x1FB6},
+	{0x03B1, 0x0345, 0x1FB3},
+	{0x03B5, 0x0300, 0x1F72},
+	{0x03B5, 0x0301, 0x03AD},
+	{0x03B5, 0x0313, 0x1F10},
+	{0x03B5, 0x0314, 0x1F11},
+	{0x03B7, 0x0300, 0x1F74},
+	{0x03B7, 0x0301, 0x03AE},
+	{0x03B7, 0x0313, 0x1F20},
+	{0x03B7, 0x0314, 0x1F21},
+	{0x03B7, 0x0342, 0x1FC6},
+	{0x03B7, 0x0345, 0x1FC3},
+	{0x03B9, 0x0300, 0x1F76},
+	{0x03B9, 0x0301, 0x03AF},
+	{0x03B9, 0x0304, 0x1FD1},
+	{0x03B9, 0x0306, 0x1FD0},
+	{0x03B9, 0x0308, 0x03CA},
+	{0x03B9, 0x0313, 0x1F30},
+	{0x03B9, 0x0314, 0x1F31},
+	{0x03B9, 0x0342, 0x1FD6},
+	{0x03BF, 0x0300, 0x1F78},
+	{0x03BF, 0x0301, 0x03CC},
+	{0x03BF, 0x0313, 0x1F40},
+	{0x03BF, 0x0314, 0x1F41},
+	{0x03C1, 0x0313, 0x1FE4},
+	{0x03C1, 0x0314, 0x1FE5},
+	{0x03C5, 0x0300, 0x1F7A},
+	{0x03C5, 0x0301, 0x03CD},
+	{0x03C5, 0x0304, 0x1FE1},
+	{0x03C5, 0x0306, 0x1FE0},
+	{0x03C5, 0x0308, 0x03CB},
+	{0x03C5, 0x0313, 0x1F50},
+	{0x03C5, 0x0314, 0x1F51},
+	{0x03C5, 0x0342, 0x1FE6},
+	{0x03C9, 0x0300, 0x1F7C},
+	{0x03C9, 0x0301, 0x03CE},
+	{0x03C9, 0x0313, 0x1F60},
+	{0x03C9, 0x0314, 0x1F61},
+	{0x03C9, 0x0342, 0x1FF6},
+	{0x03C9, 0x0345, 0x1FF3},
+	{0x03CA, 0x0300, 0x1FD2},
+	{0x03CA, 0x0301, 0x0390},
+	{0x03CA, 0x0342, 0x1FD7},
+	{0x03CB, 0x0300, 0x1FE2},
+	{0x03CB, 0x0301, 0x03B0},
+	{0x03CB, 0x0342, 0x1FE7},
+	{0x03CE, 0x0345, 0x1FF4},
+	{0x03D2, 0x0301, 0x03D3},
+	{0x03D2, 0x0308, 0x03D4},
+	{0x0406, 0x0308, 0x0407},
+	{0x0410, 0x0306, 0x04D0},
+	{0x0410, 0x0308, 0x04D2},
+	{0x0413, 0x0301, 0x0403},
+	{0x0415, 0x0300, 0x0400},
+	{0x0415, 0x0306, 0x04D6},
+	{0x0415, 0x0308, 0x0401},
+	{0x0416, 0x0306, 0x04C1},
+	{0x0416, 0x0308, 0x04DC},
+	{0x0417, 0x0308, 0x04DE},
+	{0x0418, 0x0300, 0x040D},
+	{0x0418, 0x0304, 0x04E2},
+	{0x0418, 0x0306, 0x0419},
+	{0x0418, 0x0308, 0x04E4},
+	{0x041A, 0x0301, 0x040C},
+	{0x041E, 0x0308, 0x04E6},
+	{0x0423, 0x0304, 0x04EE},
+	{0x0423, 0x0306, 0x040E},
+	{0x0423, 0x0308, 0x04F0},
+	{0x0423, 0x030B, 0x04F2},
+	{0x0427, 0x0308, 0x04F4},
+	{0x042B, 0x0308, 0x04F8},
+	{0x042D, 0x0308, 0x04EC},
+	{0x0430, 0x0306, 0x04D1},
+	{0x0430, 0x0308, 0x04D3},
+	{0x0433, 0x0301, 0x0453},
+	{0x0435, 0x0300, 0x0450},
+	{0x0435, 0x0306, 0x04D7},
+	{0x0435, 0x0308, 0x0451},
+	{0x0436, 0x0306, 0x04C2},
+	{0x0436, 0x0308, 0x04DD},
+	{0x0437, 0x0308, 0x04DF},
+	{0x0438, 0x0300, 0x045D},
+	{0x0438, 0x0304, 0x04E3},
+	{0x0438, 0x0306, 0x0439},
+	{0x0438, 0x0308, 0x04E5},
+	{0x043A, 0x0301, 0x045C},
+	{0x043E, 0x0308, 0x04E7},
+	{0x0443, 0x0304, 0x04EF},
+	{0x0443, 0x0306, 0x045E},
+	{0x0443, 0x0308, 0x04F1},
+	{0x0443, 0x030B, 0x04F3},
+	{0x0447, 0x0308, 0x04F5},
+	{0x044B, 0x0308, 0x04F9},
+	{0x044D, 0x0308, 0x04ED},
+	{0x0456, 0x0308, 0x0457},
+	{0x0474, 0x030F, 0x0476},
+	{0x0475, 0x030F, 0x0477},
+	{0x04D8, 0x0308, 0x04DA},
+	{0x04D9, 0x0308, 0x04DB},
+	{0x04E8, 0x0308, 0x04EA},
+	{0x04E9, 0x0308, 0x04EB},
+	{0x0627, 0x0653, 0x0622},
+	{0x0627, 0x0654, 0x0623},
+	{0x0627, 0x0655, 0x0625},
+	{0x0648, 0x0654, 0x0624},
+	{0x064A, 0x0654, 0x0626},
+	{0x06C1, 0x0654, 0x06C2},
+	{0x06D2, 0x0654, 0x06D3},
+	{0x06D5, 0x0654, 0x06C0},
+	{0x0928, 0x093C, 0x0929},
+	{0x0930, 0x093C, 0x0931},
+	{0x0933, 0x093C, 0x0934},
+	{0x09C7, 0x09BE, 0x09CB},
+	{0x09C7, 0x09D7, 0x09CC},
+	{0x0B47, 0x0B3E, 0x0B4B},
+	{0x0B47, 0x0B56, 0x0B48},
+	{0x0B47, 0x0B57, 0x0B4C},
+	{0x0B92, 0x0BD7, 0x0B94},
+	{0x0BC6, 0x0BBE, 0x0BCA},
+	{0x0BC6, 0x0BD7, 0x0BCC},
+	{0x0BC7, 0x0BBE, 0x0BCB},
+	{0x0C46, 0x0C56, 0x0C48},
+	{0x0CBF, 0x0CD5, 0x0CC0},
+	{0x0CC6, 0x0CC2, 0x0CCA},
+	{0x0CC6, 0x0CD5, 0x0CC7},
+	{0x0CC6, 0x0CD6, 0x0CC8},
+	{0x0CCA, 0x0CD5, 0x0CCB},
+	{0x0D46, 0x0D3E, 0x0D4A},
+	{0x0D46, 0x0D57, 0x0D4C},
+	{0x0D47, 0x0D3E, 0x0D4B},
+	{0x0DD9, 0x0DCA, 0x0DDA},
+	{0x0DD9, 0x0DCF, 0x0DDC},
+	{0x0DD9, 0x0DDF, 0x0DDE},
+	{0x0DDC, 0x0DCA, 0x0DDD},
+	{0x1025, 0x102E, 0x1026},
+	{0x1B05, 0x1B35, 0x1B06},
+	{0x1B07, 0x1B35, 0x1B08},
+	{0x1B09, 0x1B35, 0x1B0A},
+	{0x1B0B, 0x1B35, 0x1B0C},
+	{0x1B0D, 0x1B35, 0x1B0E},
+	{0x1B11, 0x1B35, 0x1B12},
+	{0x1B3A, 0x1B35, 0x1B3B},
+	{0x1B3C, 0x1B35, 0x1B3D},
+	{0x1B3E, 0x1B35, 0x1B40},
+	{0x1B3F, 0x1B35, 0x1B41},
+	{0x1B42, 0x1B35, 0x1B43},
+	{0x1E36, 0x0304, 0x1E38},
+	{0x1E37, 0x0304, 0x1E39},
+	{0x1E5A, 0x0304, 0x1E5C},
+	{0x1E5B, 0x0304, 0x1E5D},
+	{0x1E62, 0x0307, 0x1E68},
+	{0x1E63, 0x0307, 0x1E69},
+	{0x1EA0, 0x0302, 0x1EAC},
+	{0x1EA0, 0x0306, 0x1EB6},
+	{0x1EA1, 0x0302, 0x1EAD},
+	{0x1EA1, 0x0306, 0x1EB7},
+	{0x1EB8, 0x0302, 0x1EC6},
+	{0x1EB9, 0x0302, 0x1EC7},
+	{0x1ECC, 0x0302, 0x1ED8},
+	{0x1ECD, 0x0302, 0x1ED9},
+	{0x1F00, 0x0300, 0x1F02},
+	{0x1F00, 0x0301, 0x1F04},
+	{0x1F00, 0x0342, 0x1F06},
+	{0x1F00, 0x0345, 0x1F80},
+	{0x1F01, 0x0300, 0x1F03},
+	{0x1F01, 0x0301, 0x1F05},
+	{0x1F01, 0x0342, 0x1F07},
+	{0x1F01, 0x0345, 0x1F81},
+	{0x1F02, 0x0345, 0x1F82},
+	{0x1F03, 0x0345, 0x1F83},
+	{0x1F04, 0x0345, 0x1F84},
+	{0x1F05, 0x0345, 0x1F85},
+	{0x1F06, 0x0345, 0x1F86},
+	{0x1F07, 0x0345, 0x1F87},
+	{0x1F08, 0x0300, 0x1F0A},
+	{0x1F08, 0x0301, 0x1F0C},
+	{0x1F08, 0x0342, 0x1F0E},
+	{0x1F08, 0x0345, 0x1F88},
+	{0x1F09, 0x0300, 0x1F0B},
+	{0x1F09, 0x0301, 0x1F0D},
+	{0x1F09, 0x0342, 0x1F0F},
+	{0x1F09, 0x0345, 0x1F89},
+	{0x1F0A, 0x0345, 0x1F8A},
+	{0x1F0B, 0x0345, 0x1F8B},
+	{0x1F0C, 0x0345, 0x1F8C},
+	{0x1F0D, 0x0345, 0x1F8D},
+	{0x1F0E, 0x0345, 0x1F8E},
+	{0x1F0F, 0x0345, 0x1F8F},
+	{0x1F10, 0x0300, 0x1F12},
+	{0x1F10, 0x0301, 0x1F14},
+	{0x1F11, 0x0300, 0x1F13},
+	{0x1F11, 0x0301, 0x1F15},
+	{0x1F18, 0x0300, 0x1F1A},
+	{0x1F18, 0x0301, 0x1F1C},
+	{0x1F19, 0x0300, 0x1F1B},
+	{0x1F19, 0x0301, 0x1F1D},
+	{0x1F20, 0x0300, 0x1F22},
+	{0x1F20, 0x0301, 0x1F24},
+	{0x1F20, 0x0342, 0x1F26},
+	{0x1F20, 0x0345, 0x1F90},
+	{0x1F21, 0x0300, 0x1F23},
+	{0x1F21, 0x0301, 0x1F25},
+	{0x1F21, 0x0342, 0x1F27},
+	{0x1F21, 0x0345, 0x1F91},
+	{0x1F22, 0x0345, 0x1F92},
+	{0x1F23, 0x0345, 0x1F93},
+	{0x1F24, 0x0345, 0x1F94},
+	{0x1F25, 0x0345, 0x1F95},
+	{0x1F26, 0x0345, 0x1F96},
+	{0x1F27, 0x0345, 0x1F97},
+	{0x1F28, 0x0300, 0x1F2A},
+	{0x1F28, 0x0301, 0x1F2C},
+	{0x1F28, 0x0342, 0x1F2E},
+	{0x1F28, 0x0345, 0x1F98},
+	{0x1F29, 0x0300, 0x1F2B},
+	{0x1F29, 0x0301, 0x1F2D},
+	{0x1F29, 0x0342, 0x1F2F},
+	{0x1F29, 0x0345, 0x1F99},
+	{0x1F2A, 0x0345, 0x1F9A},
+	{0x1F2B, 0x0345, 0x1F9B},
+	{0x1F2C, 0x0345, 0x1F9C},
+	{0x1F2D, 0x0345, 0x1F9D},
+	{0x1F2E, 0x0345, 0x1F9E},
+	{0x1F2F, 0x0345, 0x1F9F},
+	{0x1F30, 0x0300, 0x1F32},
+	{0x1F30, 0x0301, 0x1F34},
+	{0x1F30, 0x0342, 0x1F36},
+	{0x1F31, 0x0300, 0x1F33},
+	{0x1F31, 0x0301, 0x1F35},
+	{0x1F31, 0x0342, 0x1F37},
+	{0x1F38, 0x0300, 0x1F3A},
+	{0x1F38, 0x0301, 0x1F3C},
+	{0x1F38, 0x0342, 0x1F3E},
+	{0x1F39, 0x0300, 0x1F3B},
+	{0x1F39, 0x0301, 0x1F3D},
+	{0x1F39, 0x0342, 0x1F3F},
+	{0x1F40, 0x0300, 0x1F42},
+	{0x1F40, 0x0301, 0x1F44},
+	{0x1F41, 0x0300, 0x1F43},
+	{0x1F41, 0x0301, 0x1F45},
+	{0x1F48, 0x0300, 0x1F4A},
+	{0x1F48, 0x0301, 0x1F4C},
+	{0x1F49, 0x0300, 0x1F4B},
+	{0x1F49, 0x0301, 0x1F4D},
+	{0x1F50, 0x0300, 0x1F52},
+	{0x1F50, 0x0301, 0x1F54},
+	{0x1F50, 0x0342, 0x1F56},
+	{0x1F51, 0x0300, 0x1F53},
+	{0x1F51, 0x0301, 0x1F55},
+	{0x1F51, 0x0342, 0x1F57},
+	{0x1F59, 0x0300, 0x1F5B},
+	{0x1F59, 0x0301, 0x1F5D},
+	{0x1F59, 0x0342, 0x1F5F},
+	{0x1F60, 0x0300, 0x1F62},
+	{0x1F60, 0x0301, 0x1F64},
+	{0x1F60, 0x0342, 0x1F66},
+	{0x1F60, 0x0345, 0x1FA0},
+	{0x1F61, 0x0300, 0x1F63},
+	{0x1F61, 0x0301, 0x1F65},
+	{0x1F61, 0x0342, 0x1F67},
+	{0x1F61, 0x0345, 0x1FA1},
+	{0x1F62, 0x0345, 0x1FA2},
+	{0x1F63, 0x0345, 0x1FA3},
+	{0x1F64, 0x0345, 0x1FA4},
+	{0x1F65, 0x0345, 0x1FA5},
+	{0x1F66, 0x0345, 0x1FA6},
+	{0x1F67, 0x0345, 0x1FA7},
+	{0x1F68, 0x0300, 0x1F6A},
+	{0x1F68, 0x0301, 0x1F6C},
+	{0x1F68, 0x0342, 0x1F6E},
+	{0x1F68, 0x0345, 0x1FA8},
+	{0x1F69, 0x0300, 0x1F6B},
+	{0x1F69, 0x0301, 0x1F6D},
+	{0x1F69, 0x0342, 0x1F6F},
+	{0x1F69, 0x0345, 0x1FA9},
+	{0x1F6A, 0x0345, 0x1FAA},
+	{0x1F6B, 0x0345, 0x1FAB},
+	{0x1F6C, 0x0345, 0x1FAC},
+	{0x1F6D, 0x0345, 0x1FAD},
+	{0x1F6E, 0x0345, 0x1FAE},
+	{0x1F6F, 0x0345, 0x1FAF},
+	{0x1F70, 0x0345, 0x1FB2},
+	{0x1F74, 0x0345, 0x1FC2},
+	{0x1F7C, 0x0345, 0x1FF2},
+	{0x1FB6, 0x0345, 0x1FB7},
+	{0x1FBF, 0x0300, 0x1FCD},
+	{0x1FBF, 0x0301, 0x1FCE},
+	{0x1FBF, 0x0342, 0x1FCF},
+	{0x1FC6, 0x0345, 0x1FC7},
+	{0x1FF6, 0x0345, 0x1FF7},
+	{0x1FFE, 0x0300, 0x1FDD},
+	{0x1FFE, 0x0301, 0x1FDE},
+	{0x1FFE, 0x0342, 0x1FDF},
+	{0x2190, 0x0338, 0x219A},
+	{0x2192, 0x0338, 0x219B},
+	{0x2194, 0x0338, 0x21AE},
+	{0x21D0, 0x0338, 0x21CD},
+	{0x21D2, 0x0338, 0x21CF},
+	{0x21D4, 0x0338, 0x21CE},
+	{0x2203, 0x0338, 0x2204},
+	{0x2208, 0x0338, 0x2209},
+	{0x220B, 0x0338, 0x220C},
+	{0x2223, 0x0338, 0x2224},
+	{0x2225, 0x0338, 0x2226},
+	{0x223C, 0x0338, 0x2241},
+	{0x2243, 0x0338, 0x2244},
+	{0x2245, 0x0338, 0x2247},
+	{0x2248, 0x0338, 0x2249},
+	{0x224D, 0x0338, 0x226D},
+	{0x2261, 0x0338, 0x2262},
+	{0x2264, 0x0338, 0x2270},
+	{0x2265, 0x0338, 0x2271},
+	{0x2272, 0x0338, 0x2274},
+	{0x2273, 0x0338, 0x2275},
+	{0x2276, 0x0338, 0x2278},
+	{0x2277, 0x0338, 0x2279},
+	{0x227A, 0x0338, 0x2280},
+	{0x227B, 0x0338, 0x2281},
+	{0x227C, 0x0338, 0x22E0},
+	{0x227D, 0x0338, 0x22E1},
+	{0x2282, 0x0338, 0x2284},
+	{0x2283, 0x0338, 0x2285},
+	{0x2286, 0x0338, 0x2288},
+	{0x2287, 0x0338, 0x2289},
+	{0x2291, 0x0338, 0x22E2},
+	{0x2292, 0x0338, 0x22E3},
+	{0x22A2, 0x0338, 0x22AC},
+	{0x22A8, 0x0338, 0x22AD},
+	{0x22A9, 0x0338, 0x22AE},
+	{0x22AB, 0x0338, 0x22AF},
+	{0x22B2, 0x0338, 0x22EA},
+	{0x22B3, 0x0338, 0x22EB},
+	{0x22B4, 0x0338, 0x22EC},
+	{0x22B5, 0x0338, 0x22ED},
+	{0x3046, 0x3099, 0x3094},
+	{0x304B, 0x3099, 0x304C},
+	{0x304D, 0x3099, 0x304E},
+	{0x304F, 0x3099, 0x3050},
+	{0x3051, 0x3099, 0x3052},
+	{0x3053, 0x3099, 0x3054},
+	{0x3055, 0x3099, 0x3056},
+	{0x3057, 0x3099, 0x3058},
+	{0x3059, 0x3099, 0x305A},
+	{0x305B, 0x3099, 0x305C},
+	{0x305D, 0x3099, 0x305E},
+	{0x305F, 0x3099, 0x3060},
+	{0x3061, 0x3099, 0x3062},
+	{0x3064, 0x3099, 0x3065},
+	{0x3066, 0x3099, 0x3067},
+	{0x3068, 0x3099, 0x3069},
+	{0x306F, 0x3099, 0x3070},
+	{0x306F, 0x309A, 0x3071},
+	{0x3072, 0x3099, 0x3073},
+	{0x3072, 0x309A, 0x3074},
+	{0x3075, 0x3099, 0x3076},
+	{0x3075, 0x309A, 0x3077},
+	{0x3078, 0x3099, 0x3079},
+	{0x3078, 0x309A, 0x307A},
+	{0x307B, 0x3099, 0x307C},
+	{0x307B, 0x309A, 0x307D},
+	{0x309D, 0x3099, 0x309E},
+	{0x30A6, 0x3099, 0x30F4},
+	{0x30AB, 0x3099, 0x30AC},
+	{0x30AD, 0x3099, 0x30AE},
+	{0x30AF, 0x3099, 0x30B0},
+	{0x30B1, 0x3099, 0x30B2},
+	{0x30B3, 0x3099, 0x30B4},
+	{0x30B5, 0x3099, 0x30B6},
+	{0x30B7, 0x3099, 0x30B8},
+	{0x30B9, 0x3099, 0x30BA},
+	{0x30BB, 0x3099, 0x30BC},
+	{0x30BD, 0x3099, 0x30BE},
+	{0x30BF, 0x3099, 0x30C0},
+	{0x30C1, 0x3099, 0x30C2},
+	{0x30C4, 0x3099, 0x30C5},
+	{0x30C6, 0x3099, 0x30C7},
+	{0x30C8, 0x3099, 0x30C9},
+	{0x30CF, 0x3099, 0x30D0},
+	{0x30CF, 0x309A, 0x30D1},
+	{0x30D2, 0x3099, 0x30D3},
+	{0x30D2, 0x309A, 0x30D4},
+	{0x30D5, 0x3099, 0x30D6},
+	{0x30D5, 0x309A, 0x30D7},
+	{0x30D8, 0x3099, 0x30D9},
+	{0x30D8, 0x309A, 0x30DA},
+	{0x30DB, 0x3099, 0x30DC},
+	{0x30DB, 0x309A, 0x30DD},
+	{0x30EF, 0x3099, 0x30F7},
+	{0x30F0, 0x3099, 0x30F8},
+	{0x30F1, 0x3099, 0x30F9},
+	{0x30F2, 0x3099, 0x30FA},
+	{0x30FD, 0x3099, 0x30FE},
+	{0x105D2, 0x0307, 0x105C9},
+	{0x105DA, 0x0307, 0x105E4},
+	{0x11099, 0x110BA, 0x1109A},
+	{0x1109B, 0x110BA, 0x1109C},
+	{0x110A5, 0x110BA, 0x110AB},
+	{0x11131, 0x11127, 0x1112E},
+	{0x11132, 0x11127, 0x1112F},
+	{0x11347, 0x1133E, 0x1134B},
+	{0x11347, 0x11357, 0x1134C},
+	{0x11382, 0x113C9, 0x11383},
+	{0x11384, 0x113BB, 0x11385},
+	{0x1138B, 0x113C2, 0x1138E},
+	{0x11390, 0x113C9, 0x11391},
+	{0x113C2, 0x113B8, 0x113C7},
+	{0x113C2, 0x113C2, 0x113C5},
+	{0x113C2, 0x113C9, 0x113C8},
+	{0x114B9, 0x114B0, 0x114BC},
+	{0x114B9, 0x114BA, 0x114BB},
+	{0x114B9, 0x114BD, 0x114BE},
+	{0x115B8, 0x115AF, 0x115BA},
+	{0x115B9, 0x115AF, 0x115BB},
+	{0x11935, 0x11930, 0x11938},
+	{0x1611E, 0x1611E, 0x16121},
+	{0x1611E, 0x1611F, 0x16123},
+	{0x1611E, 0x16120, 0x16125},
+	{0x1611E, 0x16129, 0x16122},
+	{0x16121, 0x1611F, 0x16126},
+	{0x16121, 0x16120, 0x16128},
+	{0x16122, 0x1611F, 0x16127},
+	{0x16129, 0x1611F, 0x16124},
+	{0x16D63, 0x16D67, 0x16D69},
+	{0x16D67, 0x16D67, 0x16D68},
+	{0x16D69, 0x16D67, 0x16D6A},
+}
+
+// compositionSecond holds runes which may compose with a preceding rune,
+// sorted. Hangul vowels and trailing consonants are not listed.
+var compositionSecond = [...]rune{
+	0x0300, 0x0301, 0x0302, 0x0303, 0x0304, 0x0306, 0x0307, 0x0308,
+	0x0309, 0x030A, 0x030B, 0x030C, 0x030F, 0x0311, 0x0313, 0x0314,
+	0x031B, 0x0323, 0x0324, 0x0325, 0x0326, 0x0327, 0x0328, 0x032D,
+	0x032E, 0x0330, 0x0331, 0x0338, 0x0342, 0x0345, 0x0653, 0x0654,
+	0x0655, 0x093C, 0x09BE, 0x09D7, 0x0B3E, 0x0B56, 0x0B57, 0x0BBE,
+	0x0BD7, 0x0C56, 0x0CC2, 0x0CD5, 0x0CD6, 0x0D3E, 0x0D57, 0x0DCA,
+	0x0DCF, 0x0DDF, 0x102E, 0x1B35, 0x3099, 0x309A, 0x110BA, 0x11127,
+	0x1133E, 0x11357, 0x113B8, 0x113BB, 0x113C2, 0x113C9, 0x114B0, 0x114BA,
+	0x114BD, 0x115AF, 0x11930, 0x1611E, 0x1611F, 0x16120, 0x16129, 0x16D67,
+}
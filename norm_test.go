@@ -0,0 +1,61 @@
+package stringx
+
+import "testing"
+
+// normData holds source, NFC, NFD, NFKC and NFKD in order
+var normData = [][]string{
+	{"abc123", "abc123", "abc123", "abc123", "abc123"},
+	{"e\u0301", "\u00E9", "e\u0301", "\u00E9", "e\u0301"},
+	{"\u00E9", "\u00E9", "e\u0301", "\u00E9", "e\u0301"},
+	{"\u1E0B\u0323", "\u1E0D\u0307", "d\u0323\u0307", "\u1E0D\u0307", "d\u0323\u0307"},
+	{"q\u0307\u0323", "q\u0323\u0307", "q\u0323\u0307", "q\u0323\u0307", "q\u0323\u0307"},
+	{"\u212B", "\u00C5", "A\u030A", "\u00C5", "A\u030A"},
+	{"\uFB01", "\uFB01", "\uFB01", "fi", "fi"},
+	{"\u1E9B\u0323", "\u1E9B\u0323", "\u017F\u0323\u0307", "\u1E69", "s\u0323\u0307"},
+	{"\uAC01", "\uAC01", "\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8"},
+	{"\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8"},
+	{"\u2460你好", "\u2460你好", "\u2460你好", "1你好", "1你好"},
+}
+
+var normForms = []NormForm{NFC, NFD, NFKC, NFKD}
+
+func TestString_Normalize(t *testing.T) {
+	var s String
+	for _, data := range normData {
+		for i, form := range normForms {
+			s.FromString(data[0])
+			s.Normalize(form)
+			if exp := data[i+1]; !s.EqualToString(exp) {
+				t.Errorf("String: normalize %s failed: before=%+q after=%+q expect=%+q",
+					form, data[0], s.String(), exp)
+			}
+		}
+	}
+}
+
+func TestString_IsNormalized(t *testing.T) {
+	var s String
+	for _, data := range normData {
+		for i, form := range normForms {
+			s.FromString(data[0])
+			if is, exp := s.IsNormalized(form), data[0] == data[i+1]; is != exp {
+				t.Errorf("String: is normalized %s failed: string=%+q is=%t expect=%t",
+					form, data[0], is, exp)
+			}
+		}
+	}
+}
+
+func TestRunes_Normalize(t *testing.T) {
+	var s String
+	for _, data := range normData {
+		for i, form := range normForms {
+			s.FromString(data[0] + data[0])
+			runes := s.Runes().Normalize(form).Consume()
+			if exp := data[i+1] + data[i+1]; string(runes) != exp {
+				t.Errorf("Runes: normalize %s failed: string=%+q runes=%+q expect=%+q",
+					form, data[0], string(runes), exp)
+			}
+		}
+	}
+}
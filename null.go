@@ -0,0 +1,76 @@
+package stringx
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+var (
+	_ sql.Scanner      = (*NullString)(nil)
+	_ driver.Valuer    = (*NullString)(nil)
+	_ json.Marshaler   = (*NullString)(nil)
+	_ json.Unmarshaler = (*NullString)(nil)
+)
+
+// NullString is a String which may be NULL, like sql.NullString, String is
+// empty if not Valid. It is scanned from and valued to a nullable column, and
+// is marshaled as JSON null if not Valid. Like String, a NullString mustn't
+// be copied after first use.
+type NullString struct {
+	String String
+	// Valid is true if String is not NULL
+	Valid bool
+}
+
+// Scan is to implement interface sql.Scanner, NULL makes NullString not
+// Valid, other values are converted like String.Scan. NullString is reset to
+// NULL if src can't be converted.
+func (ns *NullString) Scan(src any) error {
+	if src == nil {
+		ns.String.Reset()
+		ns.Valid = false
+		return nil
+	}
+
+	if err := ns.String.Scan(src); err != nil {
+		ns.String.Reset()
+		ns.Valid = false
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
+
+// Value is to implement interface driver.Valuer, which returns nil for NULL
+// if not Valid
+func (ns *NullString) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.String.Value()
+}
+
+func (ns *NullString) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return []byte("null"), nil
+	}
+	return ns.String.MarshalJSON()
+}
+
+func (ns *NullString) UnmarshalJSON(src []byte) error {
+	if bytes.Equal(src, []byte("null")) {
+		ns.String.Reset()
+		ns.Valid = false
+		return nil
+	}
+
+	if err := ns.String.UnmarshalJSON(src); err != nil {
+		ns.String.Reset()
+		ns.Valid = false
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
@@ -0,0 +1,65 @@
+package stringx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullString_Scan(t *testing.T) {
+	var ns NullString
+	if err := ns.Scan("value"); err != nil || !ns.Valid || !ns.String.EqualToString("value") {
+		t.Errorf("NullString: Scan failed: String=%s valid=%t err=%v", ns.String.String(), ns.Valid, err)
+	}
+	if v, err := ns.Value(); err != nil || v != "value" {
+		t.Errorf("NullString: Value failed: value=%v err=%v", v, err)
+	}
+
+	if err := ns.Scan(nil); err != nil || ns.Valid || !ns.String.IsEmpty() {
+		t.Errorf("NullString: Scan NULL failed: String=%s valid=%t err=%v", ns.String.String(), ns.Valid, err)
+	}
+	if v, err := ns.Value(); err != nil || v != nil {
+		t.Errorf("NullString: Value of NULL failed: value=%v err=%v", v, err)
+	}
+
+	if err := ns.Scan(int64(7)); err != nil || !ns.Valid || !ns.String.EqualToString("7") {
+		t.Errorf("NullString: Scan int64 failed: String=%s valid=%t err=%v", ns.String.String(), ns.Valid, err)
+	}
+	if err := ns.Scan(struct{}{}); err == nil || ns.Valid || !ns.String.IsEmpty() {
+		t.Errorf("NullString: Scan of unsupported type failed: String=%s valid=%t err=%v", ns.String.String(), ns.Valid, err)
+	}
+}
+
+func TestNullString_JSON(t *testing.T) {
+	type record struct {
+		Name NullString `json:"name"`
+	}
+
+	for _, c := range []struct {
+		src   string
+		valid bool
+		value string
+	}{
+		{`{"name":null}`, false, ""},
+		{`{"name":"你好"}`, true, "你好"},
+		{`{"name":""}`, true, ""},
+	} {
+		var r record
+		if err := json.Unmarshal([]byte(c.src), &r); err != nil {
+			t.Fatalf("NullString: unmarshal failed: src=%s err=%v", c.src, err)
+		}
+		if r.Name.Valid != c.valid || !r.Name.String.EqualToString(c.value) {
+			t.Errorf("NullString: unmarshal failed: src=%s String=%s valid=%t", c.src, r.Name.String.String(), r.Name.Valid)
+		}
+
+		out, err := json.Marshal(&r)
+		if err != nil || string(out) != c.src {
+			t.Errorf("NullString: marshal failed: out=%s expect=%s err=%v", out, c.src, err)
+		}
+	}
+
+	var ns NullString
+	_ = ns.Scan("previous")
+	if err := json.Unmarshal([]byte(`123`), &ns); err == nil || ns.Valid || !ns.String.IsEmpty() {
+		t.Errorf("NullString: unmarshal of number doesn't fail: String=%s valid=%t", ns.String.String(), ns.Valid)
+	}
+}
@@ -0,0 +1,221 @@
+package stringx
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// New and Recycle share buffers by size classes, a buffer of capacity in
+// [2^k, 2^(k+1)) is pooled in class k, so a buffer taken from class k always
+// has capacity 2^k at least. Recycled Strings themselves are never reused,
+// they are poisoned and any later use of them panics.
+const (
+	// minPoolClass is the smallest class, smaller buffers are cheaper to
+	// allocate than to pool
+	minPoolClass = 6
+	maxPoolClass = 40
+
+	// DefaultMaxPooledCapacity is the default of SetMaxPooledCapacity
+	DefaultMaxPooledCapacity = 64 << 10
+)
+
+// bufferPool is the pool of a size class, which counts buffers put into it.
+// sync.Pool frees pooled buffers silently in garbage collection, which is only
+// seen once New misses a class still counting buffers, they are taken as
+// evicted then.
+type bufferPool struct {
+	sync.Pool
+	buffers atomic.Int64
+	bytes   atomic.Int64
+}
+
+var (
+	pools [maxPoolClass - minPoolClass + 1]bufferPool
+
+	maxPooledCapacity atomic.Int64
+
+	poolHits      atomic.Uint64
+	poolMisses    atomic.Uint64
+	poolDrops     atomic.Uint64
+	poolEvictions atomic.Uint64
+)
+
+func init() {
+	maxPooledCapacity.Store(DefaultMaxPooledCapacity)
+}
+
+// recycledptr is self of a recycled String, see deadcheck
+var recycledptr = unsafe.Pointer(new(byte))
+
+// pooled holds a buffer in pools
+type pooled struct {
+	mem []byte
+}
+
+// subtract takes n from v but never below zero, and returns what is taken
+func subtract(v *atomic.Int64, n int64) int64 {
+	for {
+		old := v.Load()
+		taken := n
+		if taken > old {
+			taken = old
+		}
+		if v.CompareAndSwap(old, old-taken) {
+			return taken
+		}
+	}
+}
+
+// PoolStats reports activities of buffer pools shared by New and Recycle
+type PoolStats struct {
+	// Hits counts New served by a pooled buffer
+	Hits uint64
+	// Misses counts New not served by a pooled buffer, which allocates one
+	// unless it is NewCapacity(0)
+	Misses uint64
+	// Drops counts recycled buffers which are not pooled, because they are
+	// smaller than the smallest size class or larger than max pooled capacity
+	Drops uint64
+	// Evictions counts pooled buffers freed by the garbage collector, which
+	// are found once New misses their class
+	Evictions uint64
+	// RetainedBytes is total capacity of buffers in pools now, it includes
+	// evicted buffers not found yet, see Evictions
+	RetainedBytes int64
+}
+
+// ReadPoolStats returns current statistics of buffer pools, counters are
+// cumulative since the program starts
+func ReadPoolStats() PoolStats {
+	var retained int64
+	for i := range pools {
+		retained += pools[i].bytes.Load()
+	}
+
+	return PoolStats{
+		Hits:          poolHits.Load(),
+		Misses:        poolMisses.Load(),
+		Drops:         poolDrops.Load(),
+		Evictions:     poolEvictions.Load(),
+		RetainedBytes: retained,
+	}
+}
+
+// SetMaxPooledCapacity sets the largest capacity of a buffer which Recycle
+// keeps in pools and returns the previous setting, larger buffers are left
+// to the garbage collector, so one huge String doesn't stay pinned.
+// A negative or zero capacity disables pooling.
+func SetMaxPooledCapacity(capacity int) int {
+	return int(maxPooledCapacity.Swap(int64(capacity)))
+}
+
+// poolClass returns the class whose buffers have at least n bytes
+func poolClass(n int) int {
+	if n <= 1<<minPoolClass {
+		return minPoolClass
+	}
+	return bits.Len(uint(n - 1))
+}
+
+// pooledBuffer takes a buffer from the pool of class, or returns nil
+func pooledBuffer(class int) []byte {
+	pool := &pools[class-minPoolClass]
+	if p, ok := pool.Get().(*pooled); ok {
+		poolHits.Add(1)
+		subtract(&pool.buffers, 1)
+		subtract(&pool.bytes, int64(cap(p.mem)))
+		return p.mem[:cap(p.mem)]
+	}
+
+	poolMisses.Add(1)
+	// buffers still counted are freed by the garbage collector
+	if evicted := pool.buffers.Swap(0); evicted > 0 {
+		poolEvictions.Add(uint64(evicted))
+		pool.bytes.Store(0)
+	}
+	return nil
+}
+
+func getBuffer(n int) []byte {
+	class := poolClass(n)
+	if class <= maxPoolClass && int64(1)<<class <= maxPooledCapacity.Load() {
+		if mem := pooledBuffer(class); mem != nil {
+			return mem
+		}
+		// allocate the whole class, so the buffer is pooled in the same class
+		return make([]byte, 1<<class)
+	}
+
+	poolMisses.Add(1)
+	return make([]byte, n)
+}
+
+func putBuffer(mem []byte) {
+	size := cap(mem)
+	if size == 0 {
+		return
+	}
+	if size < 1<<minPoolClass || int64(size) > maxPooledCapacity.Load() {
+		poolDrops.Add(1)
+		return
+	}
+
+	class := bits.Len(uint(size)) - 1
+	if class > maxPoolClass {
+		poolDrops.Add(1)
+		return
+	}
+
+	pool := &pools[class-minPoolClass]
+	pool.buffers.Add(1)
+	pool.bytes.Add(int64(size))
+	pool.Put(&pooled{mem: mem[:0]})
+}
+
+// New returns an empty String, whose buffer is taken from pools if there is
+// one, see NewCapacity
+func New() *String {
+	return NewCapacity(0)
+}
+
+// NewCapacity returns an empty String with capacity of n bytes at least,
+// whose buffer is taken from the pool of its size class if there is one.
+// NewCapacity(0) never allocates a buffer, it takes a pooled buffer of the
+// smallest class if any. Call Recycle to put the buffer back when String is
+// no longer used.
+func NewCapacity(n int) *String {
+	var mem []byte
+	if n > 0 {
+		mem = getBuffer(n)
+	} else if int64(1)<<minPoolClass <= maxPooledCapacity.Load() {
+		mem = pooledBuffer(minPoolClass)
+	}
+
+	s := new(String)
+	s.build(mem, 0, len(mem))
+	return s
+}
+
+// Recycle puts buffer of String back to pools for later New, and poisons
+// String, any later use of String which reads or writes its payload panics.
+// Buffers larger than max pooled capacity or shared by clones are dropped, see
+// SetMaxPooledCapacity. A frozen String can't be recycled, because the
+// string returned by Freeze still uses its buffer.
+func (s *String) Recycle() {
+	s.copycheck()
+	s.mutable()
+
+	// a shared buffer is still used by others
+	if s.shared.Load() == nil {
+		putBuffer(s.mem[:cap(s.mem)])
+	} else {
+		s.drop()
+	}
+
+	s.self = recycledptr
+	s.mem = nil
+	s.len = 0
+	s.cap = 0
+}
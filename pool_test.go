@@ -0,0 +1,145 @@
+package stringx
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestPool_Class(t *testing.T) {
+	for _, c := range []struct {
+		n, class int
+	}{
+		{0, 6}, {1, 6}, {64, 6}, {65, 7}, {128, 7}, {129, 8}, {64 << 10, 16}, {64<<10 + 1, 17},
+	} {
+		if class := poolClass(c.n); class != c.class {
+			t.Errorf("Pool: class failed: n=%d class=%d expect=%d", c.n, class, c.class)
+		}
+	}
+}
+
+func TestPool_NewCapacity(t *testing.T) {
+	for _, n := range []int{0, 1, 63, 64, 65, 1000, 4096, 100 << 10} {
+		s := NewCapacity(n)
+		if s.Capacity() < n || !s.IsEmpty() {
+			t.Errorf("Pool: NewCapacity failed: n=%d capacity=%d length=%d", n, s.Capacity(), s.Length())
+		}
+		s.PushString("reused")
+		s.Recycle()
+
+		// pooled buffer must be reset
+		s = NewCapacity(n)
+		if s.Capacity() < n || !s.IsEmpty() {
+			t.Errorf("Pool: reused NewCapacity failed: n=%d capacity=%d length=%d", n, s.Capacity(), s.Length())
+		}
+		s.Recycle()
+	}
+}
+
+func TestPool_Stats(t *testing.T) {
+	before := ReadPoolStats()
+
+	s := NewCapacity(1000)
+	s.PushString("abc")
+	s.Recycle()
+
+	after := ReadPoolStats()
+	if after.Hits+after.Misses != before.Hits+before.Misses+1 {
+		t.Errorf("Pool: New is not counted: before=%+v after=%+v", before, after)
+	}
+	// sync.Pool may drop puts randomly with race detector, so only check
+	// what is always true
+	if after.Drops != before.Drops {
+		t.Errorf("Pool: pooled buffer is dropped: before=%+v after=%+v", before, after)
+	}
+
+	// buffers larger than max pooled capacity are never retained
+	prev := SetMaxPooledCapacity(1024)
+	defer SetMaxPooledCapacity(prev)
+
+	before = ReadPoolStats()
+	huge := NewCapacity(1 << 20)
+	huge.Recycle()
+	after = ReadPoolStats()
+	if after.Misses != before.Misses+1 || after.Drops != before.Drops+1 || after.RetainedBytes != before.RetainedBytes {
+		t.Errorf("Pool: huge buffer is retained: before=%+v after=%+v", before, after)
+	}
+
+	// evicted buffers are not retained any more once New misses their class
+	before = ReadPoolStats()
+	recycled := make([]*String, 10)
+	for i := range recycled {
+		recycled[i] = NewCapacity(512)
+	}
+	for _, s := range recycled {
+		s.Recycle()
+	}
+	runtime.GC()
+	runtime.GC()
+	NewCapacity(512).Recycle()
+	after = ReadPoolStats()
+	if after.Evictions < before.Evictions+10 || after.RetainedBytes < 0 || after.RetainedBytes > before.RetainedBytes+512 {
+		t.Errorf("Pool: evicted buffers are retained: before=%+v after=%+v", before, after)
+	}
+}
+
+func TestPool_NewEmpty(t *testing.T) {
+	// empty pools, sync.Pool frees its buffers in two garbage collections
+	runtime.GC()
+	runtime.GC()
+
+	for i := 0; i < 2; i++ {
+		s := NewCapacity(0)
+		if s.Capacity() != 0 || !s.IsEmpty() {
+			t.Errorf("Pool: NewCapacity(0) allocates buffer: capacity=%d", s.Capacity())
+		}
+		s.Recycle()
+	}
+}
+
+func TestPool_RecycledPanics(t *testing.T) {
+	uses := map[string]func(s *String){
+		"Push":       func(s *String) { s.Push('!') },
+		"PushString": func(s *String) { s.PushString("!") },
+		"Reset":      func(s *String) { s.Reset() },
+		"String":     func(s *String) { _ = s.String() },
+		"Bytes":      func(s *String) { _ = s.Bytes() },
+		"Contains":   func(s *String) { _ = s.Contains("a") },
+		"View":       func(s *String) { _ = s.View() },
+		"Init":       func(s *String) { s.Init() },
+		"FromString": func(s *String) { s.FromString("again") },
+		"Recycle":    func(s *String) { s.Recycle() },
+	}
+
+	for name, use := range uses {
+		s := New()
+		s.PushString("recycled")
+		s.Recycle()
+
+		func() {
+			defer func() {
+				if msg := recover(); msg != "String: illegal use of recycled value" {
+					t.Errorf("Pool: %s on recycled String doesn't panic: msg=%v", name, msg)
+				}
+			}()
+			use(s)
+		}()
+	}
+
+	// a recycled String is never handed out again
+	s := New()
+	s.Recycle()
+	for i := 0; i < 100; i++ {
+		if other := New(); other == s {
+			t.Fatalf("Pool: recycled String is reused")
+		}
+	}
+}
+
+func BenchmarkPool_NewRecycle(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := NewCapacity(256)
+		s.PushString("benchmark")
+		s.Recycle()
+	}
+}
@@ -2,32 +2,13 @@ package stringx
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"strconv"
-	"sync"
 	"unicode"
 	"unicode/utf8"
+	"unsafe"
 )
 
-var alloc = sync.Pool{
-	New: func() any {
-		return new(String)
-	},
-}
-
-func New() *String {
-	s := alloc.Get().(*String)
-	if s.alreadyInit() {
-		s.Reset()
-	} else {
-		s.Init()
-	}
-	return s
-}
-
-func (s *String) Recycle() {
-	alloc.Put(s)
-}
-
 func (s *String) Init() {
 	s.assumeUninit()
 	s.build(nil, 0, 0)
@@ -97,12 +78,22 @@ func (s *String) FromRunes(in []rune) *String {
 	return s
 }
 
+// Bytes returns payload of String, writing to which changes String only, a
+// shared buffer is copied first, see IsShared, and Clones copy the payload
+// until String is written again. Use View to read payload without copying.
 func (s *String) Bytes() []byte {
+	// payload may be written through Bytes, but a frozen one mustn't be
+	if !s.frozen {
+		s.unshare(s.cap)
+	}
+	s.pin()
+	s.lend("Bytes")
 	return s.payload()
 }
 
 func (s *String) Runes() *Runes {
 	return &Runes{
+		b:   s.borrow("Runes"),
 		mem: s.payload(),
 		idx: 0,
 	}
@@ -126,6 +117,8 @@ func (s *String) Capacity() int {
 }
 
 func (s *String) Reset() {
+	s.mutable()
+	s.detach()
 	s.len = 0
 }
 
@@ -133,24 +126,52 @@ func (s *String) IsEmpty() bool {
 	return s.len == 0
 }
 
+// Clone returns a String with the same payload, which shares the buffer of
+// String until either of them is mutated
 func (s *String) Clone() *String {
 	var cloned String
-	mem := make([]byte, s.len)
-	copy(mem, s.payload())
-	cloned.build(mem, s.len, s.len)
+	s.shareInto(&cloned, 0, s.len)
+	cloned.growth = s.growth
 	return &cloned
 }
 
+// CloneInto makes target a clone of String, the buffer of target is released
+// and target shares the buffer of String until either of them is mutated
 func (s *String) CloneInto(target *String) {
-	target.FromString(s.UnsafeString())
+	if target == s {
+		return
+	}
+	if target.alreadyInit() {
+		target.mutable()
+	}
+	s.shareInto(target, 0, s.len)
 }
 
-func (s *String) Insert(i int, b byte) {
+// Freeze makes String permanently immutable, and returns its payload as a
+// primitive string without copying, which is safe since payload never changes
+// again. Any mutating method of a frozen String panics, Clone it to get a
+// mutable copy. Bytes of a frozen String must be kept read-only as well.
+// Freeze a frozen String just returns the same string.
+func (s *String) Freeze() string {
 	s.copycheck()
+	s.frozen = true
+	s.pin()
 
-	if s.len >= s.cap {
-		s.grow(s.cap)
+	if s.len == 0 {
+		return ""
 	}
+	return unsafe.String(unsafe.SliceData(s.payload()), s.len)
+}
+
+func (s *String) IsFrozen() bool {
+	return s.frozen
+}
+
+func (s *String) Insert(i int, b byte) {
+	s.copycheck()
+	s.mutable()
+
+	s.ensure(1)
 
 	copy(s.mem[i+1:s.len+1], s.mem[i:s.len])
 	s.mem[i] = b
@@ -159,12 +180,10 @@ func (s *String) Insert(i int, b byte) {
 
 func (s *String) InsertString(i int, str string) {
 	s.copycheck()
+	s.mutable()
 
 	l := len(str)
-
-	if s.len+l >= s.cap {
-		s.grow(s.len + l - s.cap)
-	}
+	s.ensure(l)
 
 	copy(s.mem[i+l:s.len+l], s.mem[i:s.len])
 	copy(s.mem[i:i+l], str)
@@ -173,10 +192,9 @@ func (s *String) InsertString(i int, str string) {
 
 func (s *String) Push(b byte) {
 	s.copycheck()
+	s.mutable()
 
-	if s.len >= s.cap {
-		s.grow(s.cap)
-	}
+	s.ensure(1)
 
 	s.mem[s.len] = b
 	s.len += 1
@@ -184,15 +202,14 @@ func (s *String) Push(b byte) {
 
 func (s *String) PushRune(r rune) {
 	s.copycheck()
+	s.mutable()
 
-	if r < utf8.RuneSelf {
+	if uint32(r) < utf8.RuneSelf {
 		s.Push(byte(r))
 		return
 	}
 
-	if s.len+utf8.UTFMax > s.cap {
-		s.grow(utf8.UTFMax)
-	}
+	s.ensure(utf8.UTFMax)
 
 	n := utf8.EncodeRune(s.mem[s.len:s.cap], r)
 	s.len += n
@@ -200,12 +217,10 @@ func (s *String) PushRune(r rune) {
 
 func (s *String) PushString(str string) {
 	s.copycheck()
+	s.mutable()
 
 	l := len(str)
-
-	if s.len+l >= s.cap {
-		s.grow(s.len + l - s.cap)
-	}
+	s.ensure(l)
 
 	copy(s.mem[s.len:s.cap], str)
 	s.len += l
@@ -213,12 +228,10 @@ func (s *String) PushString(str string) {
 
 func (s *String) PushBytes(bytes []byte) {
 	s.copycheck()
+	s.mutable()
 
 	l := len(bytes)
-
-	if s.len+l >= s.cap {
-		s.grow(s.len + l - s.cap)
-	}
+	s.ensure(l)
 
 	copy(s.mem[s.len:s.cap], bytes)
 	s.len += l
@@ -232,6 +245,7 @@ func (s *String) PushRunes(runes []rune) {
 
 func (s *String) Drain(l, r int) {
 	s.copycheck()
+	s.mutate()
 	copy(s.mem[l:s.len], s.mem[r:s.len])
 	s.len -= r - l
 }
@@ -241,15 +255,16 @@ func (s *String) Get(i int) byte {
 	return payload[i]
 }
 
+// Index returns payload[l:r] as a new String, which shares the buffer of
+// String until either of them is mutated
 func (s *String) Index(l, r int) *String {
-	mem := make([]byte, r-l)
-	copy(mem, s.mem[l:r])
-
 	var indexed String
-	indexed.build(mem, r-l, r-l)
+	s.shareInto(&indexed, l, r)
 	return &indexed
 }
 
+// EqualTo reports whether payloads are equal, which returns as soon as they
+// differ, compare signatures or tokens by ConstantTimeEqual instead
 func (s *String) EqualTo(other *String) bool {
 	if s.len != other.len {
 		return false
@@ -266,6 +281,18 @@ func (s *String) EqualToString(str string) bool {
 	return bytes.Equal(s.payload(), stringToBytes(str))
 }
 
+// ConstantTimeEqual is like EqualTo, but takes time independent of the
+// payloads, only of their lengths, so it doesn't leak where they differ
+func (s *String) ConstantTimeEqual(other *String) bool {
+	return subtle.ConstantTimeCompare(s.payload(), other.payload()) == 1
+}
+
+// ConstantTimeEqualToString is like EqualToString, but takes constant time,
+// see ConstantTimeEqual
+func (s *String) ConstantTimeEqualToString(str string) bool {
+	return subtle.ConstantTimeCompare(s.payload(), stringToBytes(str)) == 1
+}
+
 func (s *String) CompareTo(other *String) int {
 	return bytes.Compare(s.payload(), other.payload())
 }
@@ -298,6 +325,7 @@ func (s *String) HasSuffix(pat string) bool {
 
 func (s *String) Split(sep string) *Split {
 	return &Split{
+		b:   s.borrow("Split"),
 		mem: s.payload(),
 		idx: 0,
 		sep: stringToBytes(sep),
@@ -314,6 +342,7 @@ func (s *String) Find(pat string) int {
 
 func (s *String) Replace(from, to string) {
 	s.copycheck()
+	s.mutable()
 
 	oldsl, newsl := stringToBytes(from), stringToBytes(to)
 
@@ -339,9 +368,7 @@ func (s *String) Replace(from, to string) {
 		return
 	}
 
-	if size > 0 {
-		s.grow(size)
-	}
+	s.ensure(size)
 
 	mem := s.mem
 	var offset int
@@ -355,6 +382,11 @@ func (s *String) Replace(from, to string) {
 }
 
 func (s *String) ReplaceToNew(from, to string) *String {
+	// nothing to replace, share the buffer
+	if len(from) > 0 && !s.Contains(from) {
+		return s.Clone()
+	}
+
 	var news String
 	mem := bytes.ReplaceAll(s.payload(), stringToBytes(from), stringToBytes(to))
 	news.build(mem, len(mem), len(mem))
@@ -362,14 +394,17 @@ func (s *String) ReplaceToNew(from, to string) *String {
 }
 
 func (s *String) TrimPrefix(pat string) *String {
+	s.mutable()
 	if s.HasPrefix(pat) {
-		copy(s.mem, s.mem[len(pat):])
+		s.unshare(s.cap)
+		copy(s.mem, s.mem[len(pat):s.len])
 		s.len -= len(pat)
 	}
 	return s
 }
 
 func (s *String) TrimSuffix(pat string) *String {
+	s.mutable()
 	if s.HasSuffix(pat) {
 		s.len -= len(pat)
 	}
@@ -379,8 +414,11 @@ func (s *String) TrimSuffix(pat string) *String {
 // TrimSpaceSlow benchmark: 90.12 ns/op
 func (s *String) TrimSpaceSlow() {
 	s.copycheck()
+	s.mutable()
+
+	// tgt stays in the buffer, which is still shared if it was
 	tgt := bytes.TrimSpace(s.payload())
-	s.build(tgt, len(tgt), len(tgt))
+	s.mem, s.len, s.cap = tgt, len(tgt), len(tgt)
 }
 
 var asciiSpace = [256]uint8{'\t': 1, '\n': 1, '\v': 1, '\f': 1, '\r': 1, ' ': 1}
@@ -388,6 +426,7 @@ var asciiSpace = [256]uint8{'\t': 1, '\n': 1, '\v': 1, '\f': 1, '\r': 1, ' ': 1}
 // TrimSpace benchmark: 72.55 ns/op
 func (s *String) TrimSpace() {
 	s.copycheck()
+	s.mutable()
 
 	var start, stop int
 	for ; start < s.len; start++ {
@@ -418,8 +457,11 @@ func (s *String) TrimSpace() {
 		return
 	}
 
-	payload := s.payload()
-	copy(payload, payload[start:stop])
+	if start > 0 {
+		s.unshare(s.cap)
+		payload := s.payload()
+		copy(payload, payload[start:stop])
+	}
 	s.len = stop - start
 }
 
@@ -433,6 +475,7 @@ func (s *String) ParseTo(to FromString) error {
 
 func (s *String) Reverse() {
 	s.copycheck()
+	s.mutate()
 
 	if s.len < 2 {
 		return
@@ -446,6 +489,8 @@ func (s *String) Reverse() {
 			// NOTE: cloning (*s) is necessary, since changing the memory in (*s) would cause
 			// reverse problem, the Runes iterator shares the same memory owned by (*s)
 			cl := s.Clone()
+			// cl shares the buffer, which is about to be written
+			s.unshare(s.cap)
 
 			if max := utf8.RuneCount(payload) * utf8.UTFMax; s.cap < max {
 				cl.grow(max - s.cap)
@@ -467,6 +512,7 @@ func (s *String) Reverse() {
 
 func (s *String) ToUpper() {
 	s.copycheck()
+	s.mutable()
 
 	isASCII, hasLower := true, false
 	for i := 0; i < s.len; i++ {
@@ -478,11 +524,13 @@ func (s *String) ToUpper() {
 		hasLower = hasLower || ('a' <= c && c <= 'z')
 	}
 
-	p := s.payload()
 	if isASCII {
 		if !hasLower {
 			return
 		}
+
+		s.unshare(s.cap)
+		p := s.payload()
 		for i := 0; i < s.len; i++ {
 			if 'a' <= p[i] && p[i] <= 'z' {
 				p[i] -= 'a' - 'A'
@@ -491,20 +539,13 @@ func (s *String) ToUpper() {
 		return
 	}
 
-	if max := utf8.RuneCount(p) * utf8.UTFMax; s.cap < max {
-		s.grow(max - s.cap)
-	}
-
-	var n int
-	for runes := s.Runes(); runes.Next(); {
-		n += utf8.EncodeRune(s.mem[n:], unicode.ToUpper(runes.Value()))
-	}
-
-	return
+	// slower case: full case mapping, see CaseMapper.ToUpper
+	CaseMapper{}.ToUpper(s)
 }
 
 func (s *String) ToLower() {
 	s.copycheck()
+	s.mutable()
 
 	isASCII, hasUpper := true, false
 	for i := 0; i < s.len; i++ {
@@ -516,11 +557,13 @@ func (s *String) ToLower() {
 		hasUpper = hasUpper || ('A' <= c && c <= 'Z')
 	}
 
-	p := s.payload()
 	if isASCII {
 		if !hasUpper {
 			return
 		}
+
+		s.unshare(s.cap)
+		p := s.payload()
 		for i := 0; i < s.len; i++ {
 			if 'A' <= p[i] && p[i] <= 'Z' {
 				p[i] += 'a' - 'A'
@@ -529,20 +572,13 @@ func (s *String) ToLower() {
 		return
 	}
 
-	if max := utf8.RuneCount(p) * utf8.UTFMax; s.cap < max {
-		s.grow(max - s.cap)
-	}
-
-	var n int
-	for runes := s.Runes(); runes.Next(); {
-		n += utf8.EncodeRune(s.mem[n:], unicode.ToLower(runes.Value()))
-	}
-
-	return
+	// slower case: full case mapping, see CaseMapper.ToLower
+	CaseMapper{}.ToLower(s)
 }
 
 func (s *String) Lines() *Lines {
 	return &Lines{
+		b:   s.borrow("Lines"),
 		mem: s.payload(),
 		idx: 0,
 	}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"unicode/utf8"
+	"unsafe"
 )
 
 func testStringRunes(t *testing.T, data string) {
@@ -33,6 +35,16 @@ func TestString_Runes(t *testing.T) {
 	}
 }
 
+func TestString_PushRune(t *testing.T) {
+	s := New()
+	for _, r := range []rune{'a', 'é', '你', '💰', -1, utf8.MaxRune + 1, 0xD800} {
+		s.PushRune(r)
+	}
+	if want := "aé你💰\uFFFD\uFFFD\uFFFD"; !s.EqualToString(want) {
+		t.Errorf("String: PushRune failed: got=%q want=%q", s.String(), want)
+	}
+}
+
 func TestRuneCount(t *testing.T) {
 	var s String
 	for _, data := range runeData {
@@ -400,6 +412,75 @@ func TestString_ToLower(t *testing.T) {
 	}
 }
 
+var freezeMutators = map[string]func(s *String){
+	"Push":        func(s *String) { s.Push('!') },
+	"PushString":  func(s *String) { s.PushString("!") },
+	"Insert":      func(s *String) { s.Insert(0, '!') },
+	"Drain":       func(s *String) { s.Drain(0, 1) },
+	"Replace":     func(s *String) { s.Replace("a", "b") },
+	"ReplaceAll":  func(s *String) { s.ReplaceAll(map[string]string{"absent": "x"}) },
+	"Reset":       func(s *String) { s.Reset() },
+	"FromString":  func(s *String) { s.FromString("other") },
+	"TrimSpace":   func(s *String) { s.TrimSpace() },
+	"TrimPrefix":  func(s *String) { s.TrimPrefix("f") },
+	"Reverse":     func(s *String) { s.Reverse() },
+	"ToUpper":     func(s *String) { s.ToUpper() },
+	"FoldCase":    func(s *String) { s.FoldCase() },
+	"Normalize":   func(s *String) { s.Normalize(NFD) },
+	"SetCapacity": func(s *String) { s.SetCapacity(1024) },
+	"Write":       func(s *String) { _, _ = s.Write([]byte("!")) },
+	"TryFrom":     func(s *String) { _ = s.TryFrom(42) },
+	"Scan":        func(s *String) { _ = s.Scan(nil) },
+	"Recycle":     func(s *String) { s.Recycle() },
+}
+
+func TestString_Freeze(t *testing.T) {
+	for name, mutate := range freezeMutators {
+		var s String
+		s.FromString("frozen 你好")
+		frozen := s.Freeze()
+
+		if !s.IsFrozen() || frozen != "frozen 你好" {
+			t.Fatalf("String: freeze failed: frozen=%s", frozen)
+		}
+		if unsafe.StringData(frozen) != &s.Bytes()[0] {
+			t.Errorf("String: freeze copies payload")
+		}
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("String: %s on frozen String doesn't panic", name)
+				}
+			}()
+			mutate(&s)
+		}()
+
+		if s.String() != frozen || s.Freeze() != frozen {
+			t.Errorf("String: %s changes frozen String: after=%s", name, s.String())
+		}
+	}
+
+	var s String
+	s.FromString("abc")
+	s.Freeze()
+	if !s.Contains("b") || s.Find("c") != 2 || !s.View().EqualToString("abc") {
+		t.Errorf("String: reading frozen String failed")
+	}
+
+	cloned := s.Clone()
+	cloned.PushString("def")
+	if cloned.IsFrozen() || !cloned.EqualToString("abcdef") {
+		t.Errorf("String: clone of frozen String is not mutable: clone=%s", cloned.String())
+	}
+
+	var empty String
+	empty.Init()
+	if empty.Freeze() != "" {
+		t.Errorf("String: freeze empty String failed")
+	}
+}
+
 func BenchmarkString_ToUpper(b *testing.B) {
 	s := New()
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,194 @@
+package stringx
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+// defaultMask replaces masked runes if RedactRule has no Mask
+const defaultMask = '*'
+
+var (
+	emailPattern      = regexp.MustCompile(`([\p{L}\p{N}._%+\-]+)@[\p{L}\p{N}\-]+(?:\.[\p{L}\p{N}\-]+)+`)
+	creditCardPattern = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+)
+
+// RedactRule finds sensitive text in String by Pattern, and masks runes of
+// each match, see String.Redact. Runes are masked as a whole, a multi-byte
+// rune is replaced by a single Mask.
+type RedactRule struct {
+	Pattern *regexp.Regexp
+	// Submatch is the index of the submatch of Pattern to mask, 0 for the
+	// whole match
+	Submatch int
+	// Filter reports whether a whole match is sensitive, nil for all matches
+	Filter func(match []byte) bool
+	// Masked reports whether a rune in match is masked, nil for every rune,
+	// KeepFirst and KeepLast only count such runes
+	Masked func(r rune) bool
+	// Mask replaces masked runes, '*' if zero
+	Mask rune
+	// KeepFirst and KeepLast are numbers of runes left unmasked at both ends
+	KeepFirst int
+	KeepLast  int
+}
+
+// RedactEmails returns a RedactRule masking the local part of email
+// addresses except the first rune, e.g. "j***@example.com"
+func RedactEmails() RedactRule {
+	return RedactRule{
+		Pattern:   emailPattern,
+		Submatch:  1,
+		KeepFirst: 1,
+	}
+}
+
+// RedactCreditCards returns a RedactRule masking digits of credit card numbers
+// except the last 4, spaces and dashes between digits are kept, e.g.
+// "**** **** **** 1111". Only numbers passing the Luhn check are masked.
+func RedactCreditCards() RedactRule {
+	return RedactRule{
+		Pattern:  creditCardPattern,
+		Filter:   luhnValid,
+		Masked:   isASCIIDigit,
+		KeepLast: 4,
+	}
+}
+
+func isASCIIDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+// luhnValid reports whether digits in number pass the Luhn checksum
+func luhnValid(number []byte) bool {
+	var sum, n int
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if !isASCIIDigit(rune(c)) {
+			continue
+		}
+
+		d := int(c - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n > 0 && sum%10 == 0
+}
+
+// Mask replaces runes of String with mask in place, except the first
+// keepFirst and the last keepLast runes, e.g. Mask('*', 0, 4) on
+// "4111111111111111" results "************1111". Multi-byte runes are masked
+// as a whole, so they are never split.
+func (s *String) Mask(mask rune, keepFirst, keepLast int) {
+	s.copycheck()
+
+	if keepFirst < 0 || keepLast < 0 {
+		panic("String.Mask: negative keep")
+	}
+
+	masked := maskRunes(nil, s.payload(), mask, keepFirst, keepLast, nil)
+
+	s.Reset()
+	s.PushBytes(masked)
+}
+
+// Redact masks all text matched by rules in place, rules are applied in
+// order, so a rule sees text masked by rules before it
+func (s *String) Redact(rules ...RedactRule) {
+	s.copycheck()
+
+	text := s.payload()
+	var redacted bool
+	for i := range rules {
+		var changed bool
+		text, changed = rules[i].redact(text)
+		redacted = redacted || changed
+	}
+
+	if !redacted {
+		return
+	}
+
+	s.Reset()
+	s.PushBytes(text)
+}
+
+// redact returns text with matches of rule masked, text is returned as is if
+// nothing is masked, otherwise it is never modified
+func (rule *RedactRule) redact(text []byte) ([]byte, bool) {
+	if rule.Submatch < 0 || rule.Submatch > rule.Pattern.NumSubexp() {
+		panic("RedactRule: Submatch out of range")
+	}
+	if rule.KeepFirst < 0 || rule.KeepLast < 0 {
+		panic("RedactRule: negative keep")
+	}
+
+	mask := rule.Mask
+	if mask == 0 {
+		mask = defaultMask
+	}
+
+	var redacted []byte
+	var last int
+	for _, loc := range rule.Pattern.FindAllSubmatchIndex(text, -1) {
+		if rule.Filter != nil && !rule.Filter(text[loc[0]:loc[1]]) {
+			continue
+		}
+
+		l, r := loc[2*rule.Submatch], loc[2*rule.Submatch+1]
+		// submatch is not a part of this match
+		if l < 0 {
+			continue
+		}
+
+		if redacted == nil {
+			redacted = make([]byte, 0, len(text))
+		}
+		redacted = append(redacted, text[last:l]...)
+		redacted = maskRunes(redacted, text[l:r], mask, rule.KeepFirst, rule.KeepLast, rule.Masked)
+		last = r
+	}
+
+	if redacted == nil {
+		return text, false
+	}
+	return append(redacted, text[last:]...), true
+}
+
+// maskRunes appends text to dst with runes replaced by mask, except the first
+// keepFirst and the last keepLast of them, only runes reported by masked are
+// counted and replaced, or every rune if masked is nil
+func maskRunes(dst, text []byte, mask rune, keepFirst, keepLast int, masked func(rune) bool) []byte {
+	var total int
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		if masked == nil || masked(r) {
+			total++
+		}
+		i += size
+	}
+
+	var n int
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		if masked == nil || masked(r) {
+			n++
+			if n > keepFirst && n <= total-keepLast {
+				dst = utf8.AppendRune(dst, mask)
+				i += size
+				continue
+			}
+		}
+
+		dst = append(dst, text[i:i+size]...)
+		i += size
+	}
+
+	return dst
+}
@@ -0,0 +1,123 @@
+package stringx
+
+import (
+	"regexp"
+	"testing"
+	"unicode"
+)
+
+func TestString_ConstantTimeEqual(t *testing.T) {
+	for _, c := range []struct {
+		a, b  string
+		equal bool
+	}{
+		{"", "", true},
+		{"sha256=abcdef", "sha256=abcdef", true},
+		{"sha256=abcdef", "sha256=abcdeg", false},
+		{"sha256=abcdef", "sha256=abcde", false},
+		{"token", "", false},
+		{"中文", "中文", true},
+	} {
+		var a, b String
+		a.FromString(c.a)
+		b.FromString(c.b)
+
+		if a.ConstantTimeEqual(&b) != c.equal || a.ConstantTimeEqualToString(c.b) != c.equal || a.EqualTo(&b) != c.equal {
+			t.Errorf("String: ConstantTimeEqual failed: a=%s b=%s expect=%t", c.a, c.b, c.equal)
+		}
+		if a.View().ConstantTimeEqual(b.View()) != c.equal || a.View().ConstantTimeEqualToString(c.b) != c.equal {
+			t.Errorf("View: ConstantTimeEqual failed: a=%s b=%s expect=%t", c.a, c.b, c.equal)
+		}
+	}
+}
+
+func TestString_Mask(t *testing.T) {
+	for _, c := range []struct {
+		before              string
+		keepFirst, keepLast int
+		expect              string
+	}{
+		{"4111111111111111", 0, 4, "************1111"},
+		{"secret", 1, 1, "s****t"},
+		{"abc", 2, 2, "abc"},
+		{"", 0, 4, ""},
+		{"密码是秘密", 0, 2, "***秘密"},
+		{"👋🏽hello", 1, 0, "👋******"},
+		{"ab\xffcd", 0, 1, "****d"},
+	} {
+		var s String
+		s.FromString(c.before)
+		s.Mask('*', c.keepFirst, c.keepLast)
+		if !s.EqualToString(c.expect) {
+			t.Errorf("String: mask failed: before=%q after=%q expect=%q", c.before, s.String(), c.expect)
+		}
+	}
+
+	var s String
+	s.FromString("中文")
+	s.Mask('●', 0, 0)
+	if !s.EqualToString("●●") {
+		t.Errorf("String: mask with multi-byte mask failed: after=%s", s.String())
+	}
+}
+
+func TestString_Redact(t *testing.T) {
+	for _, c := range []struct {
+		before string
+		rules  []RedactRule
+		expect string
+	}{
+		{
+			"contact john.doe@example.com or josé@例え.jp",
+			[]RedactRule{RedactEmails()},
+			"contact j*******@example.com or j***@例え.jp",
+		},
+		{
+			"card 4111 1111 1111 1111, order 1234567890123, card 5500-0000-0000-0004",
+			[]RedactRule{RedactCreditCards()},
+			"card **** **** **** 1111, order 1234567890123, card ****-****-****-0004",
+		},
+		{
+			"user=alice token=abcdef123456 next=1",
+			[]RedactRule{{Pattern: regexp.MustCompile(`token=(\S+)`), Submatch: 1, KeepLast: 4, Mask: 'x'}},
+			"user=alice token=xxxxxxxx3456 next=1",
+		},
+		{
+			"电话 13812345678, 邮箱 li@example.cn",
+			[]RedactRule{
+				RedactEmails(),
+				{Pattern: regexp.MustCompile(`\d{11}`), KeepFirst: 3, KeepLast: 4, Masked: unicode.IsDigit},
+			},
+			"电话 138****5678, 邮箱 l*@example.cn",
+		},
+		{
+			"nothing sensitive",
+			[]RedactRule{RedactEmails(), RedactCreditCards()},
+			"nothing sensitive",
+		},
+	} {
+		var s String
+		s.FromString(c.before)
+		s.Redact(c.rules...)
+		if !s.EqualToString(c.expect) {
+			t.Errorf("String: redact failed: before=%q after=%q expect=%q", c.before, s.String(), c.expect)
+		}
+	}
+}
+
+func TestRedact_Luhn(t *testing.T) {
+	for _, c := range []struct {
+		number string
+		valid  bool
+	}{
+		{"4111111111111111", true},
+		{"4111 1111 1111 1111", true},
+		{"4111111111111112", false},
+		{"79927398713", true},
+		{"", false},
+	} {
+		if luhnValid([]byte(c.number)) != c.valid {
+			t.Errorf("Redact: luhn failed: number=%s expect=%t", c.number, c.valid)
+		}
+	}
+}
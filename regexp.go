@@ -0,0 +1,208 @@
+package stringx
+
+import "regexp"
+
+// MatchRegexp reports whether String contains any match of re
+func (s *String) MatchRegexp(re *regexp.Regexp) bool {
+	return re.Match(s.payload())
+}
+
+// FindRegexp returns the leftmost match of re in String as a new String, or
+// nil if there is no match
+func (s *String) FindRegexp(re *regexp.Regexp) *String {
+	loc := re.FindIndex(s.payload())
+	if loc == nil {
+		return nil
+	}
+	return s.Index(loc[0], loc[1])
+}
+
+// FindRegexpIndex returns the location of the leftmost match of re in String,
+// which is a two-element slice like regexp.FindIndex, or nil if no match
+func (s *String) FindRegexpIndex(re *regexp.Regexp) []int {
+	return re.FindIndex(s.payload())
+}
+
+// FindAllRegexp returns an iterator over all successive matches of re in
+// String, matches are located ahead and Strings are allocated on demand
+func (s *String) FindAllRegexp(re *regexp.Regexp) *RegexpMatches {
+	payload := s.payload()
+	return &RegexpMatches{
+		b:    s.borrow("RegexpMatches"),
+		mem:  payload,
+		locs: re.FindAllIndex(payload, -1),
+	}
+}
+
+// ReplaceRegexp replaces all matches of re in String with template in place,
+// '$' signs in template are expanded like regexp.Expand, e.g. $1 or ${name}
+// for the text of the corresponding submatch
+func (s *String) ReplaceRegexp(re *regexp.Regexp, template string) {
+	s.copycheck()
+	s.mutable()
+
+	payload := s.payload()
+	tmpl := stringToBytes(template)
+	s.replaceMatches(re.FindAllSubmatchIndex(payload, -1), func(dst []byte, match []int) []byte {
+		return re.Expand(dst, tmpl, payload, match)
+	})
+}
+
+// ReplaceRegexpLiteral replaces all matches of re in String with to in place,
+// to is used literally without '$' expansion
+func (s *String) ReplaceRegexpLiteral(re *regexp.Regexp, to string) {
+	s.copycheck()
+	s.mutable()
+
+	s.replaceMatches(re.FindAllIndex(s.payload(), -1), func(dst []byte, _ []int) []byte {
+		return append(dst, to...)
+	})
+}
+
+// replaceMatches replaces matches, which are located like regexp.FindAllIndex,
+// with what expand appends to dst for each of them. The result is written
+// right into a new buffer, which String moves to.
+func (s *String) replaceMatches(matches [][]int, expand func(dst []byte, match []int) []byte) {
+	if len(matches) == 0 {
+		return
+	}
+
+	payload := s.payload()
+	mem := getBuffer(len(payload))[:0]
+
+	var last int
+	for _, match := range matches {
+		mem = append(mem, payload[last:match[0]]...)
+		mem = expand(mem, match)
+		last = match[1]
+	}
+	mem = append(mem, payload[last:]...)
+
+	s.drop()
+	s.len = len(mem)
+	s.cap = cap(mem)
+	s.mem = mem[:s.cap]
+}
+
+// SplitRegexp returns an iterator over pieces of String separated by matches
+// of re, like Split does with a fixed separator
+func (s *String) SplitRegexp(re *regexp.Regexp) *RegexpSplit {
+	payload := s.payload()
+	return &RegexpSplit{
+		b:    s.borrow("RegexpSplit"),
+		mem:  payload,
+		idx:  0,
+		locs: re.FindAllIndex(payload, -1),
+	}
+}
+
+var _ Iterator[*String] = (*RegexpMatches)(nil)
+
+type RegexpMatches struct {
+	b    borrow
+	mem  []byte
+	locs [][]int
+	val  *String
+}
+
+func (m *RegexpMatches) Next() (hasNext bool) {
+	m.b.check()
+	hasNext = len(m.locs) > 0
+	m.val = m.value()
+	return hasNext
+}
+
+func (m *RegexpMatches) value() *String {
+	var next String
+
+	if len(m.locs) == 0 {
+		next.Init()
+		return &next
+	}
+
+	loc := m.locs[0]
+	next.FromBytes(m.mem[loc[0]:loc[1]])
+	m.locs = m.locs[1:]
+
+	return &next
+}
+
+func (m *RegexpMatches) Value() *String {
+	return m.val
+}
+
+func (m *RegexpMatches) Size() (i int) {
+	for i = 0; m.Next(); i++ {
+	}
+	return i
+}
+
+func (m *RegexpMatches) Consume() []*String {
+	slice := make([]*String, 0)
+
+	for m.Next() {
+		slice = append(slice, m.Value())
+	}
+
+	return slice
+}
+
+var _ Iterator[*String] = (*RegexpSplit)(nil)
+
+type RegexpSplit struct {
+	b    borrow
+	mem  []byte
+	idx  int
+	locs [][]int
+	val  *String
+}
+
+func (s *RegexpSplit) Next() (hasNext bool) {
+	s.b.check()
+	hasNext = s.idx < len(s.mem)
+	s.val = s.value()
+	return hasNext
+}
+
+func (s *RegexpSplit) value() *String {
+	var next String
+
+	for len(s.locs) > 0 {
+		loc := s.locs[0]
+		s.locs = s.locs[1:]
+
+		// an empty match right at the start of a piece separates nothing
+		if loc[0] == loc[1] && loc[0] == s.idx {
+			continue
+		}
+
+		next.FromBytes(s.mem[s.idx:loc[0]])
+		s.idx = loc[1]
+		return &next
+	}
+
+	next.FromBytes(s.mem[s.idx:])
+	s.idx = len(s.mem)
+
+	return &next
+}
+
+func (s *RegexpSplit) Value() *String {
+	return s.val
+}
+
+func (s *RegexpSplit) Size() (i int) {
+	for i = 0; s.Next(); i++ {
+	}
+	return i
+}
+
+func (s *RegexpSplit) Consume() []*String {
+	slice := make([]*String, 0)
+
+	for s.Next() {
+		slice = append(slice, s.Value())
+	}
+
+	return slice
+}
@@ -0,0 +1,136 @@
+package stringx
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestString_MatchRegexp(t *testing.T) {
+	var s String
+	s.FromString("order #1024 shipped")
+
+	if !s.MatchRegexp(regexp.MustCompile(`#\d+`)) {
+		t.Errorf("String: match regexp failed")
+	}
+	if s.MatchRegexp(regexp.MustCompile(`^\d`)) {
+		t.Errorf("String: match regexp reports absent match")
+	}
+
+	if m := s.FindRegexp(regexp.MustCompile(`\d+`)); m == nil || !m.EqualToString("1024") {
+		t.Errorf("String: find regexp failed")
+	}
+	if m := s.FindRegexp(regexp.MustCompile(`x+`)); m != nil {
+		t.Errorf("String: find regexp reports absent match: %s", m.String())
+	}
+	if loc := s.FindRegexpIndex(regexp.MustCompile(`\d+`)); len(loc) != 2 || loc[0] != 7 || loc[1] != 11 {
+		t.Errorf("String: find regexp index failed: %v", loc)
+	}
+}
+
+func TestString_FindAllRegexp(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		s      string
+		re     string
+		expect []string
+	}{
+		{"a1b22c333", `\d+`, []string{"1", "22", "333"}},
+		{"你好, 世界", `\p{Han}+`, []string{"你好", "世界"}},
+		{"abc", `x*`, []string{"", "", "", ""}},
+		{"", `\d`, []string{}},
+	} {
+		s.FromString(data.s)
+		re := regexp.MustCompile(data.re)
+
+		if size := s.FindAllRegexp(re).Size(); size != len(data.expect) {
+			t.Errorf("String: find all regexp size failed: string=%s re=%s size=%d expect=%d",
+				data.s, data.re, size, len(data.expect))
+		}
+
+		matches := s.FindAllRegexp(re).Consume()
+		if len(matches) != len(data.expect) {
+			t.Fatalf("String: find all regexp failed: string=%s re=%s matches=%d expect=%d",
+				data.s, data.re, len(matches), len(data.expect))
+		}
+		for i, m := range matches {
+			if !m.EqualToString(data.expect[i]) {
+				t.Errorf("String: find all regexp failed: string=%s re=%s match=%s expect=%s",
+					data.s, data.re, m.String(), data.expect[i])
+			}
+		}
+	}
+}
+
+func TestString_ReplaceRegexp(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		s        string
+		re       string
+		template string
+		expect   string
+	}{
+		{"2024-01-31", `(\d+)-(\d+)-(\d+)`, "$3/$2/$1", "31/01/2024"},
+		{"key=value", `(?P<k>\w+)=(?P<v>\w+)`, "${v}=${k}", "value=key"},
+		{"你好世界", `世界`, "World", "你好World"},
+		{"no match", `\d`, "x", "no match"},
+		{"aaa", `a`, "", ""},
+	} {
+		s.FromString(data.s)
+		s.ReplaceRegexp(regexp.MustCompile(data.re), data.template)
+		if !s.EqualToString(data.expect) {
+			t.Errorf("String: replace regexp failed: before=%s after=%s expect=%s",
+				data.s, s.String(), data.expect)
+		}
+	}
+
+	s.FromString("price: 10")
+	s.ReplaceRegexpLiteral(regexp.MustCompile(`\d+`), "$1")
+	if !s.EqualToString("price: $1") {
+		t.Errorf("String: replace regexp literal failed: after=%s", s.String())
+	}
+
+	// the result is written into a new buffer, clones keep the old one
+	s.FromString(cowText)
+	cl := s.Clone()
+	s.ReplaceRegexp(regexp.MustCompile(`(\w+) on`), "$1-on")
+	if expect := strings.ReplaceAll(cowText, "copy on", "copy-on"); !s.EqualToString(expect) || !cl.EqualToString(cowText) {
+		t.Errorf("String: replace regexp of shared buffer failed: after=%s clone=%s", s.String(), cl.String())
+	}
+}
+
+func TestString_SplitRegexp(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		s      string
+		re     string
+		expect []string
+	}{
+		{"a, b,c ,  d", `\s*,\s*`, []string{"a", "b", "c", "d"}},
+		{",a,b,", `,`, []string{"", "a", "b"}},
+		{"abc", ``, []string{"a", "b", "c"}},
+		{"你1好22世界", `\d+`, []string{"你", "好", "世界"}},
+		{"abc", `x`, []string{"abc"}},
+		{"", `,`, []string{}},
+	} {
+		s.FromString(data.s)
+		re := regexp.MustCompile(data.re)
+
+		if size := s.SplitRegexp(re).Size(); size != len(data.expect) {
+			t.Errorf("String: split regexp size failed: string=%s re=%s size=%d expect=%d",
+				data.s, data.re, size, len(data.expect))
+		}
+
+		pieces := s.SplitRegexp(re).Consume()
+		if len(pieces) != len(data.expect) {
+			t.Fatalf("String: split regexp failed: string=%s re=%s pieces=%d expect=%d",
+				data.s, data.re, len(pieces), len(data.expect))
+		}
+		for i, p := range pieces {
+			if !p.EqualToString(data.expect[i]) {
+				t.Errorf("String: split regexp failed: string=%s re=%s piece=%s expect=%s",
+					data.s, data.re, p.String(), data.expect[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,649 @@
+package stringx
+
+import (
+	"bytes"
+	"io"
+	"unicode/utf8"
+)
+
+// ropeChunk is the maximum size of a rope leaf, edits never copy more than
+// one chunk of payload, everything else is shared between tree versions
+const ropeChunk = 1024
+
+// Rope is an alternative to String for large texts. Instead of one contiguous
+// buffer, payload is stored in a height-balanced tree of immutable chunks, so
+// Insert, InsertString, Drain and Replace cost O(log n) rather than moving the
+// whole tail. Push* methods append to a small pending buffer, which is merged
+// into the tree lazily, to keep sequential appending as cheap as String does.
+//
+// The zero value of Rope is an empty rope ready to use.
+type Rope struct {
+	nocopy nocopy
+
+	root *ropeNode
+	tail []byte
+}
+
+type ropeNode struct {
+	left, right *ropeNode
+
+	// leaf is the chunk held by a leaf node, leaves must never be mutated
+	// once created, since they may be shared by several ropes
+	leaf []byte
+
+	len    int
+	height int
+}
+
+func newRopeLeaf(b []byte) *ropeNode {
+	if len(b) == 0 {
+		return nil
+	}
+	return &ropeNode{leaf: b, len: len(b), height: 1}
+}
+
+func newRopeBranch(left, right *ropeNode) *ropeNode {
+	h := left.height
+	if right.height > h {
+		h = right.height
+	}
+	return &ropeNode{
+		left:   left,
+		right:  right,
+		len:    left.len + right.len,
+		height: h + 1,
+	}
+}
+
+func (n *ropeNode) isLeaf() bool {
+	return n.left == nil && n.right == nil
+}
+
+func ropeHeight(n *ropeNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func ropeLen(n *ropeNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.len
+}
+
+// ropeBuild builds a balanced tree over b, leaves share the memory of b, so
+// caller must hand over the ownership of b
+func ropeBuild(b []byte) *ropeNode {
+	if len(b) == 0 {
+		return nil
+	}
+
+	if len(b) <= ropeChunk {
+		return newRopeLeaf(b[:len(b):len(b)])
+	}
+
+	// split on chunk boundary, so that leaves are filled as much as possible
+	mid := (len(b) / ropeChunk / 2) * ropeChunk
+	if mid == 0 {
+		mid = ropeChunk
+	}
+
+	return newRopeBranch(ropeBuild(b[:mid:mid]), ropeBuild(b[mid:]))
+}
+
+// ropeBalance joins two balanced trees whose heights differ at most by 2
+func ropeBalance(left, right *ropeNode) *ropeNode {
+	hl, hr := ropeHeight(left), ropeHeight(right)
+
+	if hl > hr+1 {
+		if ropeHeight(left.left) >= ropeHeight(left.right) {
+			return newRopeBranch(left.left, newRopeBranch(left.right, right))
+		}
+		return newRopeBranch(
+			newRopeBranch(left.left, left.right.left),
+			newRopeBranch(left.right.right, right),
+		)
+	}
+
+	if hr > hl+1 {
+		if ropeHeight(right.right) >= ropeHeight(right.left) {
+			return newRopeBranch(newRopeBranch(left, right.left), right.right)
+		}
+		return newRopeBranch(
+			newRopeBranch(left, right.left.left),
+			newRopeBranch(right.left.right, right.right),
+		)
+	}
+
+	return newRopeBranch(left, right)
+}
+
+// ropeJoin concatenates two trees and keeps the result balanced, it costs
+// O(|height(a) - height(b)|)
+func ropeJoin(a, b *ropeNode) *ropeNode {
+	if ropeLen(a) == 0 {
+		return b
+	}
+
+	if ropeLen(b) == 0 {
+		return a
+	}
+
+	if a.isLeaf() && b.isLeaf() && a.len+b.len <= ropeChunk {
+		merged := make([]byte, a.len+b.len)
+		copy(merged, a.leaf)
+		copy(merged[a.len:], b.leaf)
+		return newRopeLeaf(merged)
+	}
+
+	ha, hb := a.height, b.height
+
+	if ha > hb+1 {
+		return ropeBalance(a.left, ropeJoin(a.right, b))
+	}
+
+	if hb > ha+1 {
+		return ropeBalance(ropeJoin(a, b.left), b.right)
+	}
+
+	return newRopeBranch(a, b)
+}
+
+// ropeSplit splits tree n into two trees, the left one holds the first i bytes
+func ropeSplit(n *ropeNode, i int) (*ropeNode, *ropeNode) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if i <= 0 {
+		return nil, n
+	}
+
+	if i >= n.len {
+		return n, nil
+	}
+
+	if n.isLeaf() {
+		return newRopeLeaf(n.leaf[:i:i]), newRopeLeaf(n.leaf[i:])
+	}
+
+	if i < n.left.len {
+		l, r := ropeSplit(n.left, i)
+		return l, ropeJoin(r, n.right)
+	}
+
+	l, r := ropeSplit(n.right, i-n.left.len)
+	return ropeJoin(n.left, l), r
+}
+
+// walk visits leaves in order, stop walking if f returns false
+func (n *ropeNode) walk(f func(chunk []byte) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if n.isLeaf() {
+		return f(n.leaf)
+	}
+
+	return n.left.walk(f) && n.right.walk(f)
+}
+
+// search calls f with the index of each non-overlapping instance of p in
+// order, stop searching if f returns false. Leaves are searched one by one,
+// the last len(p)-1 bytes of visited leaves are carried over, to find
+// instances across leaf boundaries.
+func (n *ropeNode) search(p []byte, f func(i int) bool) {
+	var (
+		// window holds the carried bytes and the current chunk, base is the
+		// index of window[0]
+		window []byte
+		base   int
+	)
+
+	n.walk(func(chunk []byte) bool {
+		window = append(window, chunk...)
+		for {
+			i := bytes.Index(window, p)
+			if i < 0 {
+				break
+			}
+			if !f(base + i) {
+				return false
+			}
+			window = window[i+len(p):]
+			base += i + len(p)
+		}
+
+		if keep := len(p) - 1; len(window) > keep {
+			base += len(window) - keep
+			window = append(window[:0], window[len(window)-keep:]...)
+		}
+		return true
+	})
+}
+
+func (n *ropeNode) get(i int) byte {
+	for !n.isLeaf() {
+		if i < n.left.len {
+			n = n.left
+		} else {
+			i -= n.left.len
+			n = n.right
+		}
+	}
+	return n.leaf[i]
+}
+
+// flush merges pending bytes pushed by Push* into the tree
+func (r *Rope) flush() {
+	if len(r.tail) == 0 {
+		return
+	}
+
+	r.root = ropeJoin(r.root, ropeBuild(r.tail))
+	r.tail = nil
+}
+
+// pending returns the pending buffer with room for at least n more bytes,
+// the buffer is flushed first if it would outgrow a chunk
+func (r *Rope) pending(n int) []byte {
+	if len(r.tail)+n > ropeChunk {
+		r.flush()
+	}
+
+	if r.tail == nil {
+		size := ropeChunk
+		if n > size {
+			size = n
+		}
+		r.tail = make([]byte, 0, size)
+	}
+
+	return r.tail
+}
+
+func (r *Rope) FromString(in string) *Rope {
+	r.Reset()
+	r.root = ropeBuild(stringToBytesSlow(in))
+	return r
+}
+
+func (r *Rope) FromBytes(in []byte) *Rope {
+	r.Reset()
+	mem := make([]byte, len(in))
+	copy(mem, in)
+	r.root = ropeBuild(mem)
+	return r
+}
+
+// Rope converts String into a Rope, payload is copied once and then shared
+// by all leaves of the new rope
+func (s *String) Rope() *Rope {
+	var r Rope
+	return r.FromBytes(s.payload())
+}
+
+// ToString flattens Rope into a newly allocated String
+func (r *Rope) ToString() *String {
+	var s String
+	mem := r.Bytes()
+	s.build(mem, len(mem), len(mem))
+	return &s
+}
+
+func (r *Rope) String() string {
+	return string(r.Bytes())
+}
+
+func (r *Rope) GoString() string {
+	return "\"" + r.String() + "\""
+}
+
+// Bytes returns a flattened copy of Rope payload, mutating returned bytes
+// doesn't affect Rope
+func (r *Rope) Bytes() []byte {
+	mem := make([]byte, 0, r.Length())
+	r.root.walk(func(chunk []byte) bool {
+		mem = append(mem, chunk...)
+		return true
+	})
+	return append(mem, r.tail...)
+}
+
+func (r *Rope) Length() int {
+	return ropeLen(r.root) + len(r.tail)
+}
+
+// Len is to implement interface { Len() int }
+func (r *Rope) Len() int {
+	return r.Length()
+}
+
+func (r *Rope) IsEmpty() bool {
+	return r.Length() == 0
+}
+
+func (r *Rope) Reset() {
+	r.root = nil
+	r.tail = nil
+}
+
+// Clone returns a copy of Rope, which costs O(1) for the tree part, since
+// tree nodes are immutable and shared between both ropes
+func (r *Rope) Clone() *Rope {
+	cloned := Rope{root: r.root}
+	if len(r.tail) > 0 {
+		cloned.tail = make([]byte, len(r.tail), ropeChunk)
+		copy(cloned.tail, r.tail)
+	}
+	return &cloned
+}
+
+func (r *Rope) Push(b byte) {
+	r.tail = append(r.pending(1), b)
+}
+
+func (r *Rope) PushRune(c rune) {
+	if uint32(c) < utf8.RuneSelf {
+		r.Push(byte(c))
+		return
+	}
+
+	tail := r.pending(utf8.UTFMax)
+	n := utf8.EncodeRune(tail[len(tail):cap(tail)], c)
+	r.tail = tail[:len(tail)+n]
+}
+
+func (r *Rope) PushString(str string) {
+	if len(str) > ropeChunk {
+		r.flush()
+		r.root = ropeJoin(r.root, ropeBuild(stringToBytesSlow(str)))
+		return
+	}
+
+	r.tail = append(r.pending(len(str)), str...)
+}
+
+func (r *Rope) PushBytes(bytes []byte) {
+	if len(bytes) > ropeChunk {
+		mem := make([]byte, len(bytes))
+		copy(mem, bytes)
+		r.flush()
+		r.root = ropeJoin(r.root, ropeBuild(mem))
+		return
+	}
+
+	r.tail = append(r.pending(len(bytes)), bytes...)
+}
+
+func (r *Rope) PushRunes(runes []rune) {
+	for _, c := range runes {
+		r.PushRune(c)
+	}
+}
+
+func (r *Rope) insert(i int, mem []byte) {
+	r.flush()
+
+	if i < 0 || i > ropeLen(r.root) {
+		panic("Rope.insert: index out of range")
+	}
+
+	left, right := ropeSplit(r.root, i)
+	r.root = ropeJoin(ropeJoin(left, ropeBuild(mem)), right)
+}
+
+func (r *Rope) Insert(i int, b byte) {
+	r.insert(i, []byte{b})
+}
+
+func (r *Rope) InsertString(i int, str string) {
+	r.insert(i, stringToBytesSlow(str))
+}
+
+func (r *Rope) InsertBytes(i int, bytes []byte) {
+	mem := make([]byte, len(bytes))
+	copy(mem, bytes)
+	r.insert(i, mem)
+}
+
+func (r *Rope) Drain(left, right int) {
+	r.flush()
+
+	if left < 0 || left > right || right > ropeLen(r.root) {
+		panic("Rope.Drain: index out of range")
+	}
+
+	head, rest := ropeSplit(r.root, left)
+	_, tail := ropeSplit(rest, right-left)
+	r.root = ropeJoin(head, tail)
+}
+
+func (r *Rope) Get(i int) byte {
+	r.flush()
+
+	if i < 0 || i >= ropeLen(r.root) {
+		panic("Rope.Get: index out of range")
+	}
+
+	return r.root.get(i)
+}
+
+// Index returns bytes in [l, r) as a new String
+func (r *Rope) Index(left, right int) *String {
+	r.flush()
+
+	_, rest := ropeSplit(r.root, left)
+	sub, _ := ropeSplit(rest, right-left)
+
+	var s String
+	mem := make([]byte, 0, right-left)
+	sub.walk(func(chunk []byte) bool {
+		mem = append(mem, chunk...)
+		return true
+	})
+	s.build(mem, len(mem), len(mem))
+	return &s
+}
+
+// Find returns the index of the first instance of pat in Rope, or -1 if pat
+// is not present. Find scans leaves one by one without flattening the Rope.
+func (r *Rope) Find(pat string) int {
+	r.flush()
+
+	if len(pat) == 0 {
+		return 0
+	}
+
+	found := -1
+	r.root.search(stringToBytes(pat), func(i int) bool {
+		found = i
+		return false
+	})
+
+	return found
+}
+
+func (r *Rope) Contains(sub string) bool {
+	return r.Find(sub) >= 0
+}
+
+func (r *Rope) EqualTo(other *Rope) bool {
+	if r.Length() != other.Length() {
+		return false
+	}
+
+	return bytes.Equal(r.Bytes(), other.Bytes())
+}
+
+func (r *Rope) EqualToString(str string) bool {
+	if r.Length() != len(str) {
+		return false
+	}
+
+	return bytes.Equal(r.Bytes(), stringToBytes(str))
+}
+
+// Replace replaces all instances of from with to, instances are searched leaf
+// by leaf like Find does, and each replacement is an O(log n) splice of the
+// tree
+func (r *Rope) Replace(from, to string) {
+	if len(from) == 0 {
+		return
+	}
+
+	r.flush()
+
+	var points []int
+	r.root.search(stringToBytes(from), func(i int) bool {
+		points = append(points, i)
+		return true
+	})
+
+	// the tree of to is immutable, so it is built once and shared by every
+	// replacement
+	with := ropeBuild(stringToBytesSlow(to))
+
+	// splice from the end, so that earlier points keep valid
+	for i := len(points) - 1; i >= 0; i-- {
+		head, rest := ropeSplit(r.root, points[i])
+		_, tail := ropeSplit(rest, len(from))
+		r.root = ropeJoin(ropeJoin(head, with), tail)
+	}
+}
+
+// ropeReader reads leaves of a tree one by one, and then the pending bytes.
+// Tree nodes and pushed bytes are never written once added, so it reads a
+// snapshot of Rope, and mutating Rope meanwhile is safe.
+type ropeReader struct {
+	// stack holds the nodes whose leaves are not read yet, the next one on top
+	stack []*ropeNode
+	tail  []byte
+	chunk []byte
+}
+
+func (r *Rope) reader() *ropeReader {
+	rr := &ropeReader{tail: r.tail[:len(r.tail):len(r.tail)]}
+	if r.root != nil {
+		rr.stack = append(rr.stack, r.root)
+	}
+	return rr
+}
+
+// next returns the next leaf, or nil if all bytes are read
+func (rr *ropeReader) next() []byte {
+	for len(rr.stack) > 0 {
+		n := rr.stack[len(rr.stack)-1]
+		rr.stack = rr.stack[:len(rr.stack)-1]
+		if n.isLeaf() {
+			return n.leaf
+		}
+		rr.stack = append(rr.stack, n.right, n.left)
+	}
+
+	tail := rr.tail
+	rr.tail = nil
+	if len(tail) == 0 {
+		return nil
+	}
+	return tail
+}
+
+func (rr *ropeReader) Read(p []byte) (int, error) {
+	if len(rr.chunk) == 0 {
+		rr.chunk = rr.next()
+	}
+	if len(rr.chunk) == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(p, rr.chunk)
+	rr.chunk = rr.chunk[n:]
+	return n, nil
+}
+
+// Lines, Runes and Split iterate over Rope leaf by leaf, carrying a line,
+// rune or piece across leaf boundaries, Rope is never flattened. They iterate
+// over a snapshot of Rope, so mutating Rope during iteration is safe.
+
+// Lines returns an iterator over lines of Rope, which are split the same way
+// as Lines of String
+func (r *Rope) Lines() *ReaderLines {
+	lines := LinesFrom(r.reader())
+	lines.Buffer(nil, r.Length()+1)
+	return lines
+}
+
+// Runes returns an iterator over runes of Rope, which are decoded the same way
+// as Runes of String
+func (r *Rope) Runes() *RopeRunes {
+	return &RopeRunes{leaves: r.reader()}
+}
+
+// Split returns an iterator over pieces of Rope separated by sep, which are
+// split the same way as Split of String
+func (r *Rope) Split(sep string) *ReaderSplit {
+	if len(sep) == 0 {
+		panic("Rope.Split: empty separator")
+	}
+
+	split := SplitFrom(r.reader(), sep)
+	split.Buffer(nil, r.Length()+1)
+	return split
+}
+
+var _ Iterator[rune] = (*RopeRunes)(nil)
+
+// RopeRunes is like Runes, but decodes runes of Rope leaf by leaf
+type RopeRunes struct {
+	leaves *ropeReader
+	// chunk holds bytes of the current leaf not decoded yet
+	chunk []byte
+	val   rune
+}
+
+func (r *RopeRunes) Next() (hasNext bool) {
+	for !utf8.FullRune(r.chunk) {
+		leaf := r.leaves.next()
+		if leaf == nil {
+			break
+		}
+		if len(r.chunk) == 0 {
+			r.chunk = leaf
+			continue
+		}
+
+		// the rune spans leaves, its leading bytes are carried over
+		carried := make([]byte, 0, len(r.chunk)+len(leaf))
+		r.chunk = append(append(carried, r.chunk...), leaf...)
+	}
+
+	hasNext = len(r.chunk) > 0
+	var n int
+	r.val, n = utf8.DecodeRune(r.chunk)
+	r.chunk = r.chunk[n:]
+	return hasNext
+}
+
+func (r *RopeRunes) Value() rune {
+	return r.val
+}
+
+func (r *RopeRunes) Size() (i int) {
+	for i = 0; r.Next(); i++ {
+	}
+	return i
+}
+
+func (r *RopeRunes) Consume() []rune {
+	slice := make([]rune, 0)
+
+	for r.Next() {
+		slice = append(slice, r.Value())
+	}
+
+	return slice
+}
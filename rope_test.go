@@ -0,0 +1,178 @@
+package stringx
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRope_Edit(t *testing.T) {
+	var (
+		r   Rope
+		exp string
+	)
+	for i := 0; i < 2000; i++ {
+		str := random(rand.Intn(50))
+		switch op := rand.Intn(4); {
+		case op == 0 || len(exp) == 0:
+			r.PushString(str)
+			exp += str
+		case op == 1:
+			at := rand.Intn(len(exp) + 1)
+			r.InsertString(at, str)
+			exp = exp[:at] + str + exp[at:]
+		case op == 2:
+			l := rand.Intn(len(exp))
+			rr := l + rand.Intn(len(exp)-l+1)
+			r.Drain(l, rr)
+			exp = exp[:l] + exp[rr:]
+		default:
+			at := rand.Intn(len(exp) + 1)
+			r.Insert(at, 'x')
+			exp = exp[:at] + "x" + exp[at:]
+		}
+		if r.Length() != len(exp) {
+			t.Fatalf("Rope: length mismatch after edit: rope=%d expect=%d",
+				r.Length(), len(exp))
+		}
+	}
+	if !r.EqualToString(exp) {
+		t.Errorf("Rope: content mismatch after random edits")
+	}
+	if h := ropeHeight(r.root); h > 2*ropeHeight(ropeBuild(make([]byte, r.Length())))+2 {
+		t.Errorf("Rope: tree is not balanced: height=%d length=%d", h, r.Length())
+	}
+}
+
+func TestRope_Find(t *testing.T) {
+	var r Rope
+	str := strings.Repeat("a", 3*ropeChunk-2) + "needle" + strings.Repeat("b", ropeChunk)
+	r.FromString(str)
+	if i, exp := r.Find("needle"), strings.Index(str, "needle"); i != exp {
+		t.Errorf("Rope: find across leaves failed: found=%d expect=%d", i, exp)
+	}
+	if r.Contains("needles") {
+		t.Errorf("Rope: contains reports absent pattern")
+	}
+}
+
+func TestRope_Replace(t *testing.T) {
+	for _, data := range replaceData {
+		var r Rope
+		r.FromString(data[0])
+		r.Replace(data[1], data[2])
+		if !r.EqualToString(data[3]) {
+			t.Errorf("Rope: replacing pattern failed: before=%s after=%s expect=%s",
+				data[0], r.String(), data[3])
+		}
+	}
+}
+
+func TestRope_ReplaceAcrossLeaves(t *testing.T) {
+	var r Rope
+	// instances start right before, at and right after a leaf boundary
+	str := strings.Repeat("a", ropeChunk-1) + "xyz" + strings.Repeat("b", ropeChunk-3) + "xyzxyz" + "b"
+	r.FromString(str)
+	r.PushString("xy")
+	r.PushString("z")
+	str += "xyz"
+
+	r.Replace("xyz", "-")
+	if expect := strings.ReplaceAll(str, "xyz", "-"); !r.EqualToString(expect) {
+		t.Errorf("Rope: replace across leaves failed: after=%q expect=%q", r.String(), expect)
+	}
+}
+
+func TestRope_Iterators(t *testing.T) {
+	// runes, lines and pieces are cut by leaf boundaries
+	var sb strings.Builder
+	for sb.Len() < 5*ropeChunk {
+		sb.WriteString("你好, world💰\r\n")
+		sb.WriteString(random(rand.Intn(40)))
+		sb.WriteString(",,\n")
+	}
+	str := sb.String()
+
+	var (
+		r Rope
+		s String
+	)
+	r.FromString(str[:len(str)/2])
+	for _, c := range str[len(str)/2:] {
+		r.PushRune(c)
+	}
+	s.FromString(str)
+
+	lines, runes, split := r.Lines(), r.Runes(), r.Split(",,")
+	// iterators read a snapshot of Rope
+	r.InsertString(0, "\n,,")
+	r.PushString("💰,,\n")
+
+	if got, expect := lines.Consume(), s.Lines(); !stringsEqual(got, expect) {
+		t.Errorf("Rope: Lines across leaves failed")
+	}
+	if got, expect := runes.Consume(), s.Runes().Consume(); string(got) != string(expect) {
+		t.Errorf("Rope: Runes across leaves failed")
+	}
+	if got, expect := split.Consume(), s.Split(",,"); !stringsEqual(got, expect) {
+		t.Errorf("Rope: Split across leaves failed")
+	}
+}
+
+// stringsEqual reports whether got yields the same Strings as expect
+func stringsEqual(got []*String, expect Iterator[*String]) bool {
+	for _, s := range got {
+		if !expect.Next() || !expect.Value().EqualTo(s) {
+			return false
+		}
+	}
+	return !expect.Next()
+}
+
+func TestRope_PushRune(t *testing.T) {
+	var r Rope
+	for _, c := range []rune{'a', '你', -1, utf8.MaxRune + 1} {
+		r.PushRune(c)
+	}
+	if expect := "a你\uFFFD\uFFFD"; !r.EqualToString(expect) {
+		t.Errorf("Rope: PushRune failed: rope=%q expect=%q", r.String(), expect)
+	}
+}
+
+func TestRope_String(t *testing.T) {
+	var s String
+	for i := 0; i < 10; i++ {
+		str := random(i*500) + "\r\n" + random(i*300)
+		s.FromString(str)
+		r := s.Rope()
+		r.PushRune('💰')
+		s.PushRune('💰')
+		if !r.ToString().EqualTo(&s) {
+			t.Errorf("Rope: converting from and to String failed: rope=%s string=%s",
+				r.String(), s.String())
+		}
+		if r.Split("\n").Size() != s.Split("\n").Size() {
+			t.Errorf("Rope: line count mismatch")
+		}
+		if r.Runes().Size() != s.Runes().Size() {
+			t.Errorf("Rope: rune count mismatch")
+		}
+	}
+}
+
+func BenchmarkRope_InsertString(b *testing.B) {
+	var r Rope
+	r.FromString(strings.Repeat(random(100), 1<<14))
+	for i := 0; i < b.N; i++ {
+		r.InsertString(r.Length()/2, "inserted")
+	}
+}
+
+func BenchmarkString_InsertString(b *testing.B) {
+	var s String
+	s.FromString(strings.Repeat(random(100), 1<<14))
+	for i := 0; i < b.N; i++ {
+		s.InsertString(s.Length()/2, "inserted")
+	}
+}
@@ -0,0 +1,227 @@
+package stringx
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"unicode/utf8"
+)
+
+var (
+	_ io.Writer      = (*SecretString)(nil)
+	_ fmt.Formatter  = (*SecretString)(nil)
+	_ json.Marshaler = (*SecretString)(nil)
+)
+
+// redactedSecret is printed in place of the payload of a SecretString
+const redactedSecret = "[REDACTED]"
+
+var errMarshalSecret = errors.New("stringx: SecretString can't be marshaled, Unwrap it first")
+
+// SecretString holds a secret, like a password or an API token. Unlike
+// String, memory of SecretString is wiped whenever it is released: the old
+// buffer is wiped each time SecretString grows, and Zeroize wipes the secret
+// once it is no longer used. The zero value is an empty SecretString ready
+// to use, and a SecretString mustn't be copied.
+//
+// The payload is never printed by fmt, nor marshaled as JSON or text, it is
+// only read by Unwrap or Use explicitly. Equal and EqualToString compare in
+// constant time, which only depends on the lengths.
+type SecretString struct {
+	nocopy nocopy
+
+	mem []byte
+	len int
+	// locked is true if mem is locked in RAM, see NewLockedSecretString
+	locked bool
+}
+
+// NewLockedSecretString returns a SecretString whose memory is locked in RAM
+// by mlock, so it is never swapped to disk, with at least capacity bytes.
+// Locked memory is only supported on Linux, and is limited by RLIMIT_MEMLOCK,
+// so capacity should fit the secret, since growing beyond the limit panics.
+func NewLockedSecretString(capacity int) (*SecretString, error) {
+	if capacity < 0 {
+		panic("NewLockedSecretString: negative capacity")
+	}
+
+	ss := &SecretString{locked: true}
+	// allocate ahead, so an unsupported platform or a low limit fails here
+	if err := ss.grow(maxInt(capacity, 1)); err != nil {
+		return nil, err
+	}
+	// locked pages are never collected, a SecretString dropped without
+	// Zeroize wipes and frees them once it is gone
+	runtime.SetFinalizer(ss, (*SecretString).release)
+	return ss, nil
+}
+
+// wipe zeroes mem, which is kept alive until then, so clearing is never
+// dropped as dead stores
+func wipe(mem []byte) {
+	for i := range mem {
+		mem[i] = 0
+	}
+	runtime.KeepAlive(mem)
+}
+
+// grow makes room for n more bytes, the old buffer is wiped after its payload
+// is copied
+func (ss *SecretString) grow(n int) error {
+	need := ss.len + n
+	if need < ss.len {
+		panic("SecretString: length overflows")
+	}
+	if need <= len(ss.mem) {
+		return nil
+	}
+
+	size := maxInt(maxInt(2*len(ss.mem), need), 32)
+
+	var mem []byte
+	if ss.locked {
+		var err error
+		if mem, err = lockedAlloc(size); err != nil {
+			return err
+		}
+	} else {
+		mem = make([]byte, size)
+	}
+
+	copy(mem, ss.mem[:ss.len])
+	ss.release()
+	ss.mem = mem
+	return nil
+}
+
+// release wipes and frees the buffer, len is left as is
+func (ss *SecretString) release() {
+	if ss.mem == nil {
+		return
+	}
+
+	wipe(ss.mem)
+	if ss.locked {
+		if err := lockedFree(ss.mem); err != nil {
+			panic("SecretString: cannot free locked memory: " + err.Error())
+		}
+	}
+	ss.mem = nil
+}
+
+// mustGrow is grow for Push methods, which panic if locked memory runs out
+func (ss *SecretString) mustGrow(n int) {
+	if err := ss.grow(n); err != nil {
+		panic("SecretString: " + err.Error())
+	}
+}
+
+func (ss *SecretString) Push(b byte) {
+	ss.mustGrow(1)
+	ss.mem[ss.len] = b
+	ss.len++
+}
+
+func (ss *SecretString) PushRune(r rune) {
+	ss.mustGrow(utf8.UTFMax)
+	ss.len += utf8.EncodeRune(ss.mem[ss.len:], r)
+}
+
+// PushString appends str to SecretString, notice that str itself is
+// immutable and can't be wiped, prefer PushBytes and wipe the source
+func (ss *SecretString) PushString(str string) {
+	ss.mustGrow(len(str))
+	ss.len += copy(ss.mem[ss.len:], str)
+}
+
+// PushBytes appends bytes to SecretString, the caller may wipe bytes after
+// return, SecretString keeps a copy of its own
+func (ss *SecretString) PushBytes(bytes []byte) {
+	ss.mustGrow(len(bytes))
+	ss.len += copy(ss.mem[ss.len:], bytes)
+}
+
+// Write is to implement interface io.Writer, which returns the error instead
+// of panicking if locked memory runs out
+func (ss *SecretString) Write(p []byte) (n int, err error) {
+	if err := ss.grow(len(p)); err != nil {
+		return 0, err
+	}
+	ss.len += copy(ss.mem[ss.len:], p)
+	return len(p), nil
+}
+
+func (ss *SecretString) Len() int {
+	return ss.len
+}
+
+// Zeroize wipes the secret and releases its memory, SecretString is empty and
+// can be reused afterwards. Zeroize it as soon as the secret is no longer
+// used, memory of SecretString isn't wiped by the garbage collector, except
+// the locked memory of a SecretString from NewLockedSecretString, which is
+// wiped and freed once the SecretString is collected.
+func (ss *SecretString) Zeroize() {
+	ss.release()
+	ss.len = 0
+}
+
+// Unwrap returns a copy of the secret as a string, which can't be wiped,
+// prefer Use if the secret is consumed as bytes
+func (ss *SecretString) Unwrap() string {
+	return string(ss.mem[:ss.len])
+}
+
+// Use calls f with the secret without copying it, f mustn't modify or keep
+// secret after return
+func (ss *SecretString) Use(f func(secret []byte)) {
+	f(ss.mem[:ss.len:ss.len])
+}
+
+// Equal reports whether both secrets are equal in constant time
+func (ss *SecretString) Equal(other *SecretString) bool {
+	return subtle.ConstantTimeCompare(ss.mem[:ss.len], other.mem[:other.len]) == 1
+}
+
+// EqualToString reports whether secret equals to str in constant time
+func (ss *SecretString) EqualToString(str string) bool {
+	return subtle.ConstantTimeCompare(ss.mem[:ss.len], stringToBytes(str)) == 1
+}
+
+// EqualToBytes reports whether secret equals to bytes in constant time
+func (ss *SecretString) EqualToBytes(bytes []byte) bool {
+	return subtle.ConstantTimeCompare(ss.mem[:ss.len], bytes) == 1
+}
+
+// String is to implement interface fmt.Stringer, which never returns the
+// secret, see Unwrap
+func (ss *SecretString) String() string {
+	return redactedSecret
+}
+
+func (ss *SecretString) GoString() string {
+	return "stringx.SecretString(" + redactedSecret + ")"
+}
+
+// Format is to implement interface fmt.Formatter, so every verb, like %x or
+// %q, prints the redacted placeholder instead of the secret
+func (ss *SecretString) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, ss.GoString())
+		return
+	}
+	_, _ = io.WriteString(f, redactedSecret)
+}
+
+// MarshalJSON always fails, so secrets never leak into JSON by accident,
+// marshal Unwrap explicitly instead
+func (ss *SecretString) MarshalJSON() ([]byte, error) {
+	return nil, errMarshalSecret
+}
+
+// MarshalText always fails, like MarshalJSON
+func (ss *SecretString) MarshalText() ([]byte, error) {
+	return nil, errMarshalSecret
+}
@@ -0,0 +1,31 @@
+//go:build linux
+
+package stringx
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// lockedAlloc maps anonymous memory of at least n bytes and locks it in RAM,
+// the size is rounded up to pages
+func lockedAlloc(n int) ([]byte, error) {
+	page := os.Getpagesize()
+	size := (n + page - 1) / page * page
+
+	mem, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, fmt.Errorf("stringx: cannot allocate locked memory: %w", err)
+	}
+	if err := syscall.Mlock(mem); err != nil {
+		_ = syscall.Munmap(mem)
+		return nil, fmt.Errorf("stringx: cannot lock memory: %w", err)
+	}
+	return mem, nil
+}
+
+// lockedFree unmaps memory from lockedAlloc, which unlocks it as well
+func lockedFree(mem []byte) error {
+	return syscall.Munmap(mem)
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+
+package stringx
+
+import "errors"
+
+// lockedAlloc always fails, since locking memory is only supported on Linux
+func lockedAlloc(n int) ([]byte, error) {
+	return nil, errors.New("stringx: locked memory is only supported on Linux")
+}
+
+func lockedFree(mem []byte) error {
+	return nil
+}
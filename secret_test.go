@@ -0,0 +1,180 @@
+package stringx
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSecretString_Push(t *testing.T) {
+	var ss SecretString
+	ss.PushString("tok")
+	ss.Push('-')
+	ss.PushRune('é')
+	ss.PushBytes([]byte("123"))
+	_, _ = ss.Write([]byte("!"))
+
+	if ss.Unwrap() != "tok-é123!" || ss.Len() != len("tok-é123!") {
+		t.Errorf("SecretString: push failed: secret=%s", ss.Unwrap())
+	}
+
+	ss.Use(func(secret []byte) {
+		if string(secret) != "tok-é123!" || cap(secret) != len(secret) {
+			t.Errorf("SecretString: Use failed: secret=%s", secret)
+		}
+	})
+}
+
+func TestSecretString_Wipe(t *testing.T) {
+	var ss SecretString
+	ss.PushString("first secret")
+
+	// the old buffer is wiped once SecretString grows
+	old := ss.mem
+	ss.PushString(strings.Repeat("x", len(old)))
+	if !isWiped(old) || !strings.HasPrefix(ss.Unwrap(), "first secret") {
+		t.Errorf("SecretString: grow doesn't wipe old buffer: old=%q", old)
+	}
+
+	mem := ss.mem
+	ss.Zeroize()
+	if !isWiped(mem) || ss.Len() != 0 || ss.Unwrap() != "" {
+		t.Errorf("SecretString: Zeroize failed: mem=%q len=%d", mem, ss.Len())
+	}
+
+	// reusable after Zeroize
+	ss.PushString("again")
+	if ss.Unwrap() != "again" {
+		t.Errorf("SecretString: reuse after Zeroize failed: secret=%s", ss.Unwrap())
+	}
+}
+
+func isWiped(mem []byte) bool {
+	for _, c := range mem {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSecretString_Redacted(t *testing.T) {
+	var ss SecretString
+	ss.PushString("hunter2")
+
+	for _, format := range []string{"%s", "%v", "%+v", "%q", "%x", "%X", "%d", "%10s"} {
+		if out := fmt.Sprintf(format, &ss); strings.Contains(out, "hunter2") || !strings.Contains(out, redactedSecret) {
+			t.Errorf("SecretString: format leaks secret: format=%s out=%s", format, out)
+		}
+	}
+	if out := fmt.Sprintf("%#v", &ss); out != ss.GoString() || strings.Contains(out, "hunter2") {
+		t.Errorf("SecretString: GoString leaks secret: out=%s", out)
+	}
+	if ss.String() != redactedSecret {
+		t.Errorf("SecretString: String leaks secret: String=%s", ss.String())
+	}
+
+	config := struct {
+		Token *SecretString `json:"token"`
+	}{&ss}
+	if out, err := json.Marshal(config); err == nil {
+		t.Errorf("SecretString: marshal doesn't fail: out=%s", out)
+	}
+	if out, err := json.Marshal(map[string]string{"token": ss.Unwrap()}); err != nil || !strings.Contains(string(out), "hunter2") {
+		t.Errorf("SecretString: marshal Unwrap failed: out=%s err=%v", out, err)
+	}
+}
+
+func TestSecretString_Equal(t *testing.T) {
+	var a, b SecretString
+	a.PushString("secret")
+	b.PushString("secret")
+
+	for _, c := range []struct {
+		str   string
+		equal bool
+	}{
+		{"secret", true}, {"Secret", false}, {"secre", false}, {"secrets", false}, {"", false},
+	} {
+		if a.EqualToString(c.str) != c.equal || a.EqualToBytes([]byte(c.str)) != c.equal {
+			t.Errorf("SecretString: compare failed: str=%s expect=%t", c.str, c.equal)
+		}
+	}
+
+	if !a.Equal(&b) {
+		t.Errorf("SecretString: Equal failed")
+	}
+	b.Push('!')
+	if a.Equal(&b) {
+		t.Errorf("SecretString: Equal of different secrets")
+	}
+}
+
+func TestSecretString_Locked(t *testing.T) {
+	ss, err := NewLockedSecretString(16)
+	if err != nil {
+		t.Skipf("SecretString: locked memory is not available: err=%v", err)
+	}
+	defer ss.Zeroize()
+
+	ss.PushString("locked")
+	// grows beyond the first page
+	large := strings.Repeat("k", 8192)
+	if _, err := ss.Write([]byte(large)); err != nil {
+		t.Skipf("SecretString: locked memory is limited: err=%v", err)
+	}
+	if ss.Unwrap() != "locked"+large {
+		t.Errorf("SecretString: locked grow failed: length=%d", ss.Len())
+	}
+}
+
+// lockedMemory returns the locked memory of the process in kB, which is VmLck
+// of /proc/self/status, ok is false if it is unknown
+func lockedMemory() (kb int, ok bool) {
+	status, err := os.ReadFile("/proc/self/status")
+	if err != nil {
+		return 0, false
+	}
+	for _, line := range strings.Split(string(status), "\n") {
+		if value, found := strings.CutPrefix(line, "VmLck:"); found {
+			kb, err = strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(value, "kB")))
+			return kb, err == nil
+		}
+	}
+	return 0, false
+}
+
+func TestSecretString_LockedDrop(t *testing.T) {
+	before, ok := lockedMemory()
+	if !ok {
+		t.Skip("SecretString: locked memory of the process is unknown")
+	}
+
+	// dropped without Zeroize
+	func() {
+		ss, err := NewLockedSecretString(16)
+		if err != nil {
+			t.Skipf("SecretString: locked memory is not available: err=%v", err)
+		}
+		ss.PushString("dropped")
+	}()
+	if locked, _ := lockedMemory(); locked <= before {
+		t.Fatalf("SecretString: memory isn't locked: before=%dkB after=%dkB", before, locked)
+	}
+
+	locked := -1
+	for deadline := time.Now().Add(5 * time.Second); locked != before && time.Now().Before(deadline); {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+		locked, _ = lockedMemory()
+	}
+
+	if locked != before {
+		t.Errorf("SecretString: dropped locked memory isn't freed: before=%dkB after=%dkB", before, locked)
+	}
+}
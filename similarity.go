@@ -0,0 +1,357 @@
+package stringx
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// similarity measures work on runes rather than bytes, so '你好' and '你们'
+// differ by one edit, not by three
+
+// trimCommon strips the common prefix and suffix of a and b, which never
+// changes an edit distance
+func trimCommon(a, b []rune) ([]rune, []rune) {
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	return a, b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// levenshtein counts insertions, deletions and substitutions
+func levenshtein(a, b []rune) int {
+	a, b = trimCommon(a, b)
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if len(b) == 0 {
+		return len(a)
+	}
+
+	// one row of the DP table, indexed by b
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			next := minInt(minInt(row[j]+1, row[j-1]+1), diag+cost)
+			diag = row[j]
+			row[j] = next
+		}
+	}
+
+	return row[len(b)]
+}
+
+// damerauLevenshtein counts insertions, deletions, substitutions and
+// transpositions of adjacent runes, without the restriction of optimal string
+// alignment, i.e. a substring may be edited after transposed
+func damerauLevenshtein(a, b []rune) int {
+	a, b = trimCommon(a, b)
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) + len(b)
+	}
+
+	// d is the DP table with an extra leading row and column of sentinels,
+	// d[(i+1)*w+j+1] is the distance between a[:i] and b[:j]
+	w := len(b) + 2
+	d := make([]int, (len(a)+2)*w)
+	inf := len(a) + len(b)
+
+	d[0] = inf
+	for i := 0; i <= len(a); i++ {
+		d[(i+1)*w] = inf
+		d[(i+1)*w+1] = i
+	}
+	for j := 0; j <= len(b); j++ {
+		d[j+1] = inf
+		d[w+j+1] = j
+	}
+
+	// last maps a rune to the last row of a where it appears
+	last := make(map[rune]int)
+
+	for i := 1; i <= len(a); i++ {
+		// lastCol is the last column of b matching a[i-1] in this row
+		var lastCol int
+		for j := 1; j <= len(b); j++ {
+			i1, j1 := last[b[j-1]], lastCol
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[(i+1)*w+j+1] = minInt(
+				minInt(d[i*w+j]+cost, d[(i+1)*w+j]+1),
+				minInt(d[i*w+j+1]+1, d[i1*w+j1]+(i-i1-1)+1+(j-j1-1)),
+			)
+		}
+		last[a[i-1]] = i
+	}
+
+	return d[(len(a)+1)*w+len(b)+1]
+}
+
+// jaroWinkler returns the Jaro similarity of a and b boosted by the length of
+// their common prefix, 1 means equal and 0 means nothing in common
+func jaroWinkler(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := maxInt(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+
+	var matches int
+	for i := range a {
+		lo, hi := maxInt(0, i-window), minInt(len(b), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	var transpositions, j int
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions/2))/m) / 3
+
+	var prefix int
+	for prefix < 4 && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// lcs returns the longest common subsequence of a and b
+func lcs(a, b []rune) []rune {
+	// the common prefix and suffix always belong to some longest subsequence
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// d[i*w+j] is the length of LCS of ma[i:] and mb[j:]
+	w := len(mb) + 1
+	d := make([]int, (len(ma)+1)*w)
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				d[i*w+j] = d[(i+1)*w+j+1] + 1
+			} else {
+				d[i*w+j] = maxInt(d[(i+1)*w+j], d[i*w+j+1])
+			}
+		}
+	}
+
+	runes := make([]rune, 0, prefix+d[0]+suffix)
+	runes = append(runes, a[:prefix]...)
+	for i, j := 0, 0; i < len(ma) && j < len(mb); {
+		switch {
+		case ma[i] == mb[j]:
+			runes = append(runes, ma[i])
+			i++
+			j++
+		case d[(i+1)*w+j] >= d[i*w+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	runes = append(runes, a[len(a)-suffix:]...)
+
+	return runes
+}
+
+// ngrams returns the set of n-grams of runes, runes shorter than n is a
+// single n-gram of itself
+func ngrams(runes []rune, n int) map[string]struct{} {
+	set := make(map[string]struct{})
+	if len(runes) == 0 {
+		return set
+	}
+	if len(runes) <= n {
+		set[string(runes)] = struct{}{}
+		return set
+	}
+	for i := 0; i+n <= len(runes); i++ {
+		set[string(runes[i:i+n])] = struct{}{}
+	}
+	return set
+}
+
+// ngramJaccard returns the Jaccard index of the n-gram sets of a and b
+func ngramJaccard(a, b []rune, n int) float64 {
+	if n <= 0 {
+		panic("String.NGramJaccard: n must be positive")
+	}
+
+	x, y := ngrams(a, n), ngrams(b, n)
+	if len(x) == 0 && len(y) == 0 {
+		return 1
+	}
+
+	var common int
+	for gram := range x {
+		if _, ok := y[gram]; ok {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(x)+len(y)-common)
+}
+
+// Levenshtein returns the edit distance in runes between String and other,
+// counting insertions, deletions and substitutions
+func (s *String) Levenshtein(other *String) int {
+	return levenshtein(bytes.Runes(s.payload()), bytes.Runes(other.payload()))
+}
+
+func (s *String) LevenshteinString(str string) int {
+	return levenshtein(bytes.Runes(s.payload()), []rune(str))
+}
+
+// DamerauLevenshtein returns the edit distance in runes between String and
+// other, counting insertions, deletions, substitutions and transpositions of
+// two adjacent runes
+func (s *String) DamerauLevenshtein(other *String) int {
+	return damerauLevenshtein(bytes.Runes(s.payload()), bytes.Runes(other.payload()))
+}
+
+func (s *String) DamerauLevenshteinString(str string) int {
+	return damerauLevenshtein(bytes.Runes(s.payload()), []rune(str))
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity between String and other in
+// [0, 1], which favors Strings sharing a prefix, 1 means equal
+func (s *String) JaroWinkler(other *String) float64 {
+	return jaroWinkler(bytes.Runes(s.payload()), bytes.Runes(other.payload()))
+}
+
+func (s *String) JaroWinklerString(str string) float64 {
+	return jaroWinkler(bytes.Runes(s.payload()), []rune(str))
+}
+
+// LongestCommonSubsequence returns the longest sequence of runes appearing in
+// both String and other in order, but not necessarily contiguous
+func (s *String) LongestCommonSubsequence(other *String) *String {
+	var sub String
+	return sub.FromRunes(lcs(bytes.Runes(s.payload()), bytes.Runes(other.payload())))
+}
+
+func (s *String) LongestCommonSubsequenceString(str string) *String {
+	var sub String
+	return sub.FromRunes(lcs(bytes.Runes(s.payload()), []rune(str)))
+}
+
+// NGramJaccard returns the Jaccard index of rune n-gram sets of String and
+// other in [0, 1], a String shorter than n is taken as a single n-gram
+func (s *String) NGramJaccard(other *String, n int) float64 {
+	return ngramJaccard(bytes.Runes(s.payload()), bytes.Runes(other.payload()), n)
+}
+
+func (s *String) NGramJaccardString(str string, n int) float64 {
+	return ngramJaccard(bytes.Runes(s.payload()), []rune(str), n)
+}
+
+// ClosestTo returns at most k elements of List nearest to target, ranked by
+// Levenshtein distance, and then by Jaro-Winkler similarity for equal
+// distances, elements stay in their order if both are equal
+func (l List[S]) ClosestTo(target fmt.Stringer, k int) List[S] {
+	if k <= 0 {
+		return List[S]{}
+	}
+
+	type candidate struct {
+		index    int
+		distance int
+		score    float64
+	}
+
+	t := []rune(target.String())
+	candidates := make([]candidate, len(l))
+	for i, ele := range l {
+		runes := []rune(ele.String())
+		candidates[i] = candidate{
+			index:    i,
+			distance: levenshtein(runes, t),
+			score:    jaroWinkler(runes, t),
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].score > candidates[j].score
+	})
+
+	if k > len(candidates) {
+		k = len(candidates)
+	}
+
+	closest := make(List[S], k)
+	for i := range closest {
+		closest[i] = l[candidates[i].index]
+	}
+	return closest
+}
@@ -0,0 +1,203 @@
+package stringx
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var distanceData = []struct {
+	a, b        string
+	levenshtein int
+	damerau     int
+}{
+	{"", "", 0, 0},
+	{"", "abc", 3, 3},
+	{"kitten", "sitting", 3, 3},
+	{"flaw", "lawn", 2, 2},
+	{"ab", "ba", 2, 1},
+	{"CA", "ABC", 3, 2},
+	{"你好", "你们", 1, 1},
+	{"你好世界", "好你世界", 2, 1},
+	{"héllo", "hello", 1, 1},
+	{"same", "same", 0, 0},
+}
+
+func TestString_Levenshtein(t *testing.T) {
+	var a, b String
+	for _, data := range distanceData {
+		a.FromString(data.a)
+		b.FromString(data.b)
+		if d := a.Levenshtein(&b); d != data.levenshtein {
+			t.Errorf("String: levenshtein failed: a=%s b=%s distance=%d expect=%d",
+				data.a, data.b, d, data.levenshtein)
+		}
+		if d := b.LevenshteinString(data.a); d != data.levenshtein {
+			t.Errorf("String: levenshtein is not symmetric: a=%s b=%s distance=%d expect=%d",
+				data.a, data.b, d, data.levenshtein)
+		}
+		if d := a.DamerauLevenshtein(&b); d != data.damerau {
+			t.Errorf("String: damerau levenshtein failed: a=%s b=%s distance=%d expect=%d",
+				data.a, data.b, d, data.damerau)
+		}
+		if d := b.DamerauLevenshteinString(data.a); d != data.damerau {
+			t.Errorf("String: damerau levenshtein is not symmetric: a=%s b=%s distance=%d expect=%d",
+				data.a, data.b, d, data.damerau)
+		}
+	}
+}
+
+// levenshteinNaive computes the full DP table without any shortcut
+func levenshteinNaive(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(minInt(d[i-1][j]+1, d[i][j-1]+1), d[i-1][j-1]+cost)
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func TestString_LevenshteinRandom(t *testing.T) {
+	alphabet := []rune("ab你c")
+	gen := func() []rune {
+		runes := make([]rune, rand.Intn(8))
+		for i := range runes {
+			runes[i] = alphabet[rand.Intn(len(alphabet))]
+		}
+		return runes
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := gen(), gen()
+		lev, dl := levenshtein(a, b), damerauLevenshtein(a, b)
+		if expect := levenshteinNaive(a, b); lev != expect {
+			t.Fatalf("String: levenshtein failed: a=%s b=%s distance=%d expect=%d",
+				string(a), string(b), lev, expect)
+		}
+		if dl > lev {
+			t.Fatalf("String: damerau levenshtein out of range: a=%s b=%s distance=%d levenshtein=%d",
+				string(a), string(b), dl, lev)
+		}
+		if sub := lcs(a, b); len(a)+len(b)-2*len(sub) < lev {
+			t.Fatalf("String: lcs too long: a=%s b=%s lcs=%s levenshtein=%d",
+				string(a), string(b), string(sub), lev)
+		}
+	}
+}
+
+func TestString_JaroWinkler(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		a, b   string
+		expect float64
+	}{
+		{"MARTHA", "MARHTA", 0.9611},
+		{"DWAYNE", "DUANE", 0.84},
+		{"DIXON", "DICKSONX", 0.8133},
+		{"abc", "xyz", 0},
+		{"", "", 1},
+		{"", "a", 0},
+		{"你好世界", "你好世界", 1},
+	} {
+		s.FromString(data.a)
+		if sim := s.JaroWinklerString(data.b); math.Abs(sim-data.expect) > 1e-4 {
+			t.Errorf("String: jaro winkler failed: a=%s b=%s similarity=%f expect=%f",
+				data.a, data.b, sim, data.expect)
+		}
+	}
+}
+
+func isSubsequence(sub, s string) bool {
+	runes := []rune(sub)
+	for _, r := range s {
+		if len(runes) > 0 && runes[0] == r {
+			runes = runes[1:]
+		}
+	}
+	return len(runes) == 0
+}
+
+func TestString_LongestCommonSubsequence(t *testing.T) {
+	var a, b String
+	for _, data := range []struct {
+		a, b   string
+		expect string
+	}{
+		{"ABCBDAB", "BDCABA", "BCBA"},
+		{"你好世界", "你们的世界", "你世界"},
+		{"abc", "xyz", ""},
+		{"", "abc", ""},
+		{"prefix-x-suffix", "prefix-y-suffix", "prefix--suffix"},
+	} {
+		a.FromString(data.a)
+		b.FromString(data.b)
+		// a longest common subsequence is not unique, so check its length and
+		// that it is a subsequence of both
+		sub := a.LongestCommonSubsequence(&b)
+		if sub.Runes().Size() != len([]rune(data.expect)) ||
+			!isSubsequence(sub.String(), data.a) || !isSubsequence(sub.String(), data.b) {
+			t.Errorf("String: longest common subsequence failed: a=%s b=%s lcs=%s expect=%s",
+				data.a, data.b, sub.String(), data.expect)
+		}
+	}
+}
+
+func TestString_NGramJaccard(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		a, b   string
+		n      int
+		expect float64
+	}{
+		{"night", "nacht", 2, 1.0 / 7},
+		{"你好世界", "你好", 2, 1.0 / 3},
+		{"ab", "ab", 3, 1},
+		{"", "", 2, 1},
+		{"abc", "", 2, 0},
+	} {
+		s.FromString(data.a)
+		if sim := s.NGramJaccardString(data.b, data.n); math.Abs(sim-data.expect) > 1e-9 {
+			t.Errorf("String: n-gram jaccard failed: a=%s b=%s n=%d similarity=%f expect=%f",
+				data.a, data.b, data.n, sim, data.expect)
+		}
+	}
+}
+
+func TestList_ClosestTo(t *testing.T) {
+	words := []string{"apple", "apply", "ample", "maple", "banana", "appeal"}
+	list := make(List[*String], len(words))
+	for i, w := range words {
+		list[i] = new(String).FromString(w)
+	}
+
+	closest := list.ClosestTo(Str("appel"), 3)
+	expect := []string{"appeal", "apple", "apply"}
+	if len(closest) != len(expect) {
+		t.Fatalf("List: closest to failed: size=%d expect=%d", len(closest), len(expect))
+	}
+	for i := range closest {
+		if !closest[i].EqualToString(expect[i]) {
+			t.Errorf("List: closest to failed: index=%d word=%s expect=%s",
+				i, closest[i].String(), expect[i])
+		}
+	}
+
+	if n := len(list.ClosestTo(Str("x"), 100)); n != len(words) {
+		t.Errorf("List: closest to returns %d elements, expect=%d", n, len(words))
+	}
+	if n := len(list.ClosestTo(Str("x"), 0)); n != 0 {
+		t.Errorf("List: closest to returns %d elements, expect=0", n)
+	}
+}
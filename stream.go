@@ -0,0 +1,121 @@
+package stringx
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+var (
+	_ Iterator[*String] = (*ReaderLines)(nil)
+	_ Iterator[*String] = (*ReaderSplit)(nil)
+)
+
+// readerScanner yields pieces of an io.Reader as Strings, it keeps at most
+// one piece in memory, see Buffer
+type readerScanner struct {
+	sc  *bufio.Scanner
+	val *String
+}
+
+func (r *readerScanner) Next() (hasNext bool) {
+	var next String
+
+	hasNext = r.sc.Scan()
+	if hasNext {
+		next.FromBytes(r.sc.Bytes())
+	} else {
+		next.Init()
+	}
+
+	r.val = &next
+	return hasNext
+}
+
+func (r *readerScanner) Value() *String {
+	return r.val
+}
+
+// Err returns the first error reading from io.Reader other than io.EOF,
+// which stops the iterator, check it after Next returns false.
+// bufio.ErrTooLong is returned for a piece longer than the limit of Buffer.
+func (r *readerScanner) Err() error {
+	return r.sc.Err()
+}
+
+// Buffer sets the initial buffer and the maximum size of a piece, like
+// bufio.Scanner.Buffer does, the default maximum is bufio.MaxScanTokenSize.
+// It must be called before the first Next.
+func (r *readerScanner) Buffer(buf []byte, max int) {
+	r.sc.Buffer(buf, max)
+}
+
+func (r *readerScanner) Size() (i int) {
+	for i = 0; r.Next(); i++ {
+	}
+	return i
+}
+
+func (r *readerScanner) Consume() []*String {
+	slice := make([]*String, 0)
+
+	for r.Next() {
+		slice = append(slice, r.Value())
+	}
+
+	return slice
+}
+
+// ReaderLines is like Lines, but reads lines from an io.Reader
+type ReaderLines struct {
+	readerScanner
+}
+
+// LinesFrom returns an iterator over lines read from r, lines are split the
+// same way as Lines, a trailing '\r' of each line is dropped, and there is no
+// empty line after the final '\n'. Only the current line is kept in memory,
+// so it works for inputs larger than memory.
+func LinesFrom(r io.Reader) *ReaderLines {
+	sc := bufio.NewScanner(r)
+	sc.Split(bufio.ScanLines)
+
+	return &ReaderLines{readerScanner{sc: sc}}
+}
+
+// ReaderSplit is like Split, but reads pieces from an io.Reader
+type ReaderSplit struct {
+	readerScanner
+}
+
+// SplitFrom returns an iterator over pieces read from r separated by sep,
+// pieces are split the same way as Split. Only the current piece is kept in
+// memory, so it works for inputs larger than memory.
+func SplitFrom(r io.Reader, sep string) *ReaderSplit {
+	if len(sep) == 0 {
+		panic("SplitFrom: empty separator")
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Split(scanSeparated([]byte(sep)))
+
+	return &ReaderSplit{readerScanner{sc: sc}}
+}
+
+func scanSeparated(sep []byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if loc := bytes.Index(data, sep); loc >= 0 {
+			return loc + len(sep), data[:loc], nil
+		}
+
+		if atEOF {
+			return len(data), data, nil
+		}
+
+		// request more data
+		return 0, nil, nil
+	}
+}
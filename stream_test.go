@@ -0,0 +1,96 @@
+package stringx
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var streamInputs = []string{
+	"",
+	"\n",
+	"one line",
+	"a\nb\nc",
+	"a\nb\nc\n",
+	"a\r\nb\r\n\r\nc\r",
+	"\n\nlast\n\n",
+	"a, b,, c, ",
+	", , ",
+	"中文, 分隔, 行\r\n尾",
+}
+
+func collect(it Iterator[*String]) (values []string) {
+	for it.Next() {
+		values = append(values, it.Value().String())
+	}
+	return values
+}
+
+func TestLinesFrom(t *testing.T) {
+	for _, input := range streamInputs {
+		var s String
+		s.FromString(input)
+		expect := collect(s.Lines())
+
+		for name, r := range map[string]io.Reader{
+			"strings.Reader": strings.NewReader(input),
+			"OneByteReader":  iotest.OneByteReader(strings.NewReader(input)),
+			"DataErrReader":  iotest.DataErrReader(strings.NewReader(input)),
+		} {
+			lines := LinesFrom(r)
+			if got := collect(lines); strings.Join(got, "|") != strings.Join(expect, "|") || len(got) != len(expect) {
+				t.Errorf("LinesFrom: %s failed: input=%q lines=%q expect=%q", name, input, got, expect)
+			}
+			if lines.Err() != nil || !lines.Value().IsEmpty() {
+				t.Errorf("LinesFrom: %s end failed: err=%v value=%q", name, lines.Err(), lines.Value().String())
+			}
+		}
+	}
+}
+
+func TestSplitFrom(t *testing.T) {
+	for _, sep := range []string{", ", ",", "\r\n"} {
+		for _, input := range streamInputs {
+			var s String
+			s.FromString(input)
+			expect := s.Split(sep).Consume()
+
+			split := SplitFrom(iotest.OneByteReader(strings.NewReader(input)), sep)
+			got := split.Consume()
+			if len(got) != len(expect) {
+				t.Fatalf("SplitFrom: failed: input=%q sep=%q pieces=%d expect=%d", input, sep, len(got), len(expect))
+			}
+			for i := range got {
+				if !got[i].EqualTo(expect[i]) {
+					t.Errorf("SplitFrom: failed: input=%q sep=%q piece=%q expect=%q", input, sep, got[i].String(), expect[i].String())
+				}
+			}
+			if split.Err() != nil {
+				t.Errorf("SplitFrom: unexpected error: err=%v", split.Err())
+			}
+		}
+	}
+}
+
+func TestReaderScanner_Err(t *testing.T) {
+	lines := LinesFrom(iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("a\nb\nc"))))
+	// data read before the error is still yielded
+	if got := collect(lines); len(got) != 1 || got[0] != "a" || !errors.Is(lines.Err(), iotest.ErrTimeout) {
+		t.Errorf("LinesFrom: read error is not reported: err=%v", lines.Err())
+	}
+
+	split := SplitFrom(io.MultiReader(strings.NewReader("a,b,"), iotest.ErrReader(io.ErrUnexpectedEOF)), ",")
+	if split.Size() != 2 || !errors.Is(split.Err(), io.ErrUnexpectedEOF) {
+		t.Errorf("SplitFrom: read error is not reported: err=%v", split.Err())
+	}
+
+	// the buffer is bounded
+	long := LinesFrom(strings.NewReader("short\n" + strings.Repeat("x", 100) + "\nshort"))
+	long.Buffer(make([]byte, 16), 64)
+	if long.Size() != 1 || !errors.Is(long.Err(), bufio.ErrTooLong) {
+		t.Errorf("LinesFrom: long line doesn't fail: err=%v", long.Err())
+	}
+}
@@ -1,7 +1,7 @@
 package stringx
 
 import (
-	"math"
+	"sync/atomic"
 	"unicode/utf8"
 	"unsafe"
 )
@@ -12,18 +12,37 @@ type String struct {
 	//
 	// See https://github.com/golang/go/issues/8005#issuecomment-190753527 for details.
 	// and also: https://stackoverflow.com/questions/52494458/nocopy-minimal-example
+	//
+	// stringxvet catches more misuses which `go vet` doesn't, see the
+	// stringxvet module.
 	nocopy nocopy
 
 	// self represents receiver of this String, to detect copies by value
 	// See type definition Builder in strings/builder.go
 	self unsafe.Pointer
 
+	// gen detects mutations while payload is borrowed, it is empty unless
+	// built with 'stringx_debug' tag
+	gen generation
+
+	// frozen is set by Freeze, after which String is immutable
+	frozen bool
+
+	// growth decides capacity when String grows, nil for the default policy
+	growth GrowthPolicy
+
+	// shared counts Strings sharing mem since Clone, nil if mem is owned by
+	// this String only, see 'cow.go'
+	shared atomic.Pointer[shareRef]
+
 	mem []byte
 	len int
 	cap int
 }
 
 func (s *String) build(mem []byte, len, cap int) {
+	s.mutable()
+	s.drop()
 	s.mem = mem
 	s.len = len
 	s.cap = cap
@@ -52,36 +71,104 @@ func (s *String) copycheck() {
 	if s.self == nullptr {
 		panic("String: illegal use of uninitialized value")
 	} else if s.self != unsafe.Pointer(s) {
+		s.deadcheck()
 		panic("String: illegal use of copied value")
 	}
 }
 
+// deadcheck panics if String gave its buffer away by Recycle or Close of
+// Mapped, which is marked by self
+func (s *String) deadcheck() {
+	switch s.self {
+	case recycledptr:
+		panic("String: illegal use of recycled value")
+	case unmappedptr:
+		panic("String: illegal use of unmapped value")
+	}
+}
+
+// mutate is called before String writes its payload in place, it copies a
+// shared buffer, so the change is not seen by other Strings
+func (s *String) mutate() {
+	s.mutable()
+	s.unshare(s.cap)
+}
+
+// mutable panics if String can't be mutated, and invalidates borrows, it is
+// called before String changes its length or buffer without writing bytes,
+// see unshare and detach for the writes
+func (s *String) mutable() {
+	if s.frozen {
+		panic("String: illegal mutation of frozen value")
+	} else if s.self != unsafe.Pointer(s) {
+		s.deadcheck()
+	}
+	s.gen.bump()
+	s.reclaim()
+}
+
+// grow makes room for n more bytes beyond capacity of String, the new
+// capacity is decided by GrowthPolicy
 func (s *String) grow(n int) {
 	s.copycheck()
+	s.mutable()
 
-	if n >= math.MaxInt32 {
-		panic("String.grow: n overflows")
+	// callers pass differences of capacities, which may be 0 or negative, a
+	// grow always makes room for one more byte at least
+	if n < 1 {
+		n = 1
 	}
 
-	// next power of 2
-	n--
-	n |= n >> 1
-	n |= n >> 2
-	n |= n >> 4
-	n |= n >> 8
-	n |= n >> 16
-	n++
+	need := s.cap + n
+	if need < s.cap {
+		panic("String.grow: capacity overflows")
+	}
 
-	s.mem = append(s.mem, make([]byte, n)...)
-	s.cap += n
+	capacity := s.growthPolicy()(s.cap, need)
+	if capacity < need {
+		panic("String.grow: GrowthPolicy returns capacity less than needed")
+	}
+
+	// a shared buffer is copied once, right into the new capacity
+	if s.unshare(capacity) {
+		return
+	}
+
+	s.mem = append(s.mem[:s.cap], make([]byte, capacity-s.cap)...)
+	s.cap = capacity
 }
 
 func (s *String) payload() []byte {
+	if s.self != unsafe.Pointer(s) {
+		s.deadcheck()
+	}
 	return s.mem[0:s.len]
 }
 
+// setRunes replaces payload with encoded runes, runes mustn't share memory
+// with payload
+func (s *String) setRunes(runes []rune) {
+	s.mutable()
+	s.detach()
+	var size int
+	for _, r := range runes {
+		size += utf8.RuneLen(r)
+	}
+
+	if s.cap < size {
+		s.grow(size - s.cap)
+	}
+
+	var n int
+	for _, r := range runes {
+		n += utf8.EncodeRune(s.mem[n:], r)
+	}
+	s.len = n
+}
+
 func (s *String) trim(f func(r rune) bool) {
 	s.copycheck()
+	s.mutable()
 
 	var start, stop int
 	payload := s.payload()
@@ -110,6 +197,10 @@ func (s *String) trim(f func(r rune) bool) {
 		return
 	}
 
-	copy(payload, payload[start:stop])
+	if start > 0 {
+		s.unshare(s.cap)
+		payload = s.payload()
+		copy(payload, payload[start:stop])
+	}
 	s.len = stop - start
 }
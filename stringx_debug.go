@@ -0,0 +1,147 @@
+//go:build stringx_debug
+
+package stringx
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Build with 'stringx_debug' tag to catch uses of iterators and Views after
+// the String they borrow from is mutated, e.g.
+//
+//	go test -tags stringx_debug ./...
+//
+// Every String carries a generation counter, which is bumped on each mutation,
+// and borrowers remember the generation they are created at. Both the borrow
+// and the mutation record the first call site outside of this package, so the
+// panic message names them.
+//
+// A primitive string or byte slice, which is returned by UnsafeString or
+// Bytes, can't be checked when it is used. Instead, the next mutation moves
+// String to a new buffer, and keeps a copy of the lent payload, so a stale
+// read sees the payload as it was lent rather than garbage, and a stale write
+// through Bytes is caught by the mutation or loan after it, which panics with
+// the sites of the loan and the mutation. Stale reads never panic, use a View
+// to have them checked.
+
+type generation struct {
+	n uint64
+	// site of the last mutation
+	site string
+	// loan is the payload lent by Bytes or UnsafeString since the last
+	// mutation, or before it if stale is set
+	loan *loan
+}
+
+// loan is a payload which escapes String as a byte slice or string
+type loan struct {
+	what string
+	site string
+	mem  []byte
+	// stale is a copy of mem taken when String is mutated, and moved to a new
+	// buffer, which mem must still equal to
+	stale []byte
+	// mutated is the site of the mutation which makes the loan stale
+	mutated string
+}
+
+type borrow struct {
+	gen  *generation
+	n    uint64
+	what string
+	site string
+}
+
+// packageDir is the directory of this package, to tell frames of callers
+// from frames of this package
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callSite returns the location of the first frame outside of this package,
+// tests of this package are taken as outside
+func callSite() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	var site string
+	for {
+		frame, more := frames.Next()
+		site = frame.File + ":" + strconv.Itoa(frame.Line)
+		if filepath.Dir(frame.File) != packageDir || strings.HasSuffix(frame.File, "_test.go") {
+			return site
+		}
+		if !more {
+			return site
+		}
+	}
+}
+
+func (g *generation) bump() {
+	g.n++
+	g.site = callSite()
+}
+
+func (s *String) borrow(what string) borrow {
+	return borrow{
+		gen:  &s.gen,
+		n:    s.gen.n,
+		what: what,
+		site: callSite(),
+	}
+}
+
+func (b borrow) check() {
+	if b.gen != nil && b.gen.n != b.n {
+		panic(fmt.Sprintf("stringx: %s borrowed at %s is used after String is mutated at %s",
+			b.what, b.site, b.gen.site))
+	}
+}
+
+// verify panics if a stale loan is written, it drops the stale loan
+func (g *generation) verify() {
+	l := g.loan
+	if l == nil || l.stale == nil {
+		return
+	}
+	g.loan = nil
+
+	if !bytes.Equal(l.mem, l.stale) {
+		panic(fmt.Sprintf("stringx: %s borrowed at %s is used after String is mutated at %s",
+			l.what, l.site, l.mutated))
+	}
+}
+
+func (s *String) lend(what string) {
+	s.gen.verify()
+	s.gen.loan = &loan{what: what, site: callSite()}
+}
+
+func (s *String) reclaim() {
+	s.gen.verify()
+	l := s.gen.loan
+	if l == nil {
+		return
+	}
+	s.gen.loan = nil
+
+	// mapped memory can't be written, so it is never written by loans either
+	if s.cap == 0 || s.shared.Load() == unshareable {
+		return
+	}
+	s.drop()
+
+	l.mem = s.mem[:s.len]
+	l.stale = append([]byte(nil), l.mem...)
+	l.mutated = s.gen.site
+	s.gen.loan = l
+
+	s.mem = make([]byte, s.cap)
+	copy(s.mem, l.mem)
+}
@@ -0,0 +1,151 @@
+//go:build stringx_debug
+
+package stringx
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// line returns the location of its caller
+func line() string {
+	_, file, no, _ := runtime.Caller(1)
+	return file + ":" + strconv.Itoa(no)
+}
+
+// at calls f and returns the location of its caller, which is where f is
+func at(f func()) string {
+	_, file, no, _ := runtime.Caller(1)
+	f()
+	return file + ":" + strconv.Itoa(no)
+}
+
+// expectBorrowPanic runs f, which must panic with a message naming both
+// sites
+func expectBorrowPanic(t *testing.T, what, borrowed, mutated string, f func()) {
+	t.Helper()
+
+	defer func() {
+		t.Helper()
+
+		msg, _ := recover().(string)
+		if msg == "" {
+			t.Fatalf("stringx_debug: %s used after mutation doesn't panic", what)
+		}
+		if !strings.Contains(msg, what+" borrowed at "+borrowed) || !strings.Contains(msg, "mutated at "+mutated) {
+			t.Errorf("stringx_debug: panic message doesn't name both sites: msg=%q borrowed=%s mutated=%s",
+				msg, borrowed, mutated)
+		}
+	}()
+
+	f()
+}
+
+func TestDebug_Iterators(t *testing.T) {
+	var s String
+	s.FromString("a,b\nc,d")
+
+	lines, borrowed := s.Lines(), line()
+	lines.Next()
+	mutated := at(func() { s.Push('!') })
+	expectBorrowPanic(t, "Lines", borrowed, mutated, func() { lines.Next() })
+
+	split, borrowed := s.Split(","), line()
+	mutated = at(func() { s.Replace("a", "A") })
+	expectBorrowPanic(t, "Split", borrowed, mutated, func() { split.Views().Next() })
+
+	runes, borrowed := s.Runes(), line()
+	mutated = at(func() { s.Reset() })
+	expectBorrowPanic(t, "Runes", borrowed, mutated, func() { runes.Reverse().Next() })
+
+	s.FromString("x1y2")
+	matches, borrowed := s.FindAllRegexp(regexp.MustCompile(`\d`)), line()
+	mutated = at(func() { s.TrimSpace() })
+	expectBorrowPanic(t, "RegexpMatches", borrowed, mutated, func() { matches.Consume() })
+
+	graphemes, borrowed := s.Graphemes(), line()
+	mutated = at(func() { s.ToUpper() })
+	expectBorrowPanic(t, "Graphemes", borrowed, mutated, func() { graphemes.Size() })
+
+	reader, borrowed := s.Reader(), line()
+	mutated = at(func() { _, _ = s.WriteString("3") })
+	expectBorrowPanic(t, "Reader", borrowed, mutated, func() { _, _ = reader.ReadByte() })
+}
+
+func TestDebug_View(t *testing.T) {
+	var s String
+	s.FromString("key=value")
+
+	v, borrowed := s.View(), line()
+	value := v.TrimPrefix("key=")
+	if !value.EqualToString("value") {
+		t.Fatalf("stringx_debug: view failed: view=%q", value.String())
+	}
+
+	mutated := at(func() { s.InsertString(0, "  ") })
+	expectBorrowPanic(t, "View", borrowed, mutated, func() { _ = value.Find("v") })
+
+	// a fresh View after mutation is fine
+	if !s.View().TrimSpace().EqualToString("key=value") {
+		t.Errorf("stringx_debug: view of mutated String failed: view=%q", s.View().String())
+	}
+
+	// Views of other Strings are not affected
+	clone := s.Clone()
+	cv := clone.View()
+	s.PushString("!")
+	if !cv.TrimSpace().EqualTo(ViewOf("key=value")) {
+		t.Errorf("stringx_debug: view of clone failed: view=%q", cv.String())
+	}
+}
+
+func TestDebug_Mapped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mapped")
+	if err := os.WriteFile(path, []byte("mapped content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := OpenMapped(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, borrowed := m.View(), line()
+	mutated := at(func() { _ = m.Close() })
+	expectBorrowPanic(t, "View", borrowed, mutated, func() { _ = v.Find("content") })
+}
+
+func TestDebug_Bytes(t *testing.T) {
+	var s String
+	s.FromString("secret")
+	s.Reserve(16)
+
+	b, borrowed := s.Bytes(), line()
+	b[0] = 'S'
+	mutated := at(func() { s.PushString("!") })
+	if !s.EqualToString("Secret!") || string(b) != "Secret" {
+		t.Errorf("stringx_debug: Bytes read after mutation changes: String=%s bytes=%q", s.String(), b)
+	}
+
+	// a stale write is caught by the next mutation
+	b[1] = 'E'
+	expectBorrowPanic(t, "Bytes", borrowed, mutated, func() { s.PushString("?") })
+
+	// and by the next loan
+	b, borrowed = s.Bytes(), line()
+	mutated = at(func() { s.TrimSuffix("!") })
+	b[0] = 's'
+	expectBorrowPanic(t, "Bytes", borrowed, mutated, func() { _ = s.Bytes() })
+
+	// a mutation without Bytes in between writes in place
+	ptr := &s.payload()[0]
+	s.PushString("?")
+	if &s.payload()[0] != ptr {
+		t.Errorf("stringx_debug: mutation moves String which isn't lent")
+	}
+}
@@ -0,0 +1,19 @@
+// Command stringxvet reports copies and misuses of stringx.String.
+//
+// Usage:
+//
+//	go run github.com/Boyux/stringx/stringxvet/cmd/stringxvet ./...
+//
+// or build it and pass it to go vet:
+//
+//	go vet -vettool=$(which stringxvet) ./...
+package main
+
+import (
+	"github.com/Boyux/stringx/stringxvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(stringxvet.Analyzer)
+}
@@ -0,0 +1,485 @@
+// Package stringxvet defines an Analyzer that reports misuses of
+// stringx.String, which are otherwise caught by runtime panics, or not at all:
+//
+//   - copies of String by value, which panic once the copy is used
+//   - use of a String variable before Init or From*
+//   - use of a String after Recycle, which hands it to another user
+//   - use of the result of UnsafeString after the String is mutated, which
+//     silently changes or breaks the string
+//
+// Use-based checks are intraprocedural and follow statements in source
+// order, so they are meant to catch the obvious mistakes, not to prove
+// correctness.
+package stringxvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const stringxPath = "github.com/Boyux/stringx"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "stringxvet",
+	Doc:      "report copies and misuses of stringx.String",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// initializers are methods which initialize a String declared by 'var s String'
+var initializers = map[string]bool{
+	"Init":          true,
+	"SetCapacity":   true,
+	"FromString":    true,
+	"FromBytes":     true,
+	"FromRunes":     true,
+	"From":          true,
+	"TryFrom":       true,
+	"Scan":          true,
+	"UnmarshalJSON": true,
+}
+
+// needsInit are methods which panic on a String which is not initialized,
+// methods which only read String work on the zero value
+var needsInit = map[string]bool{
+	"Write":                true,
+	"WriteString":          true,
+	"WriteByte":            true,
+	"WriteRune":            true,
+	"ReadFrom":             true,
+	"Insert":               true,
+	"InsertString":         true,
+	"Push":                 true,
+	"PushRune":             true,
+	"PushString":           true,
+	"PushBytes":            true,
+	"PushRunes":            true,
+	"Drain":                true,
+	"Replace":              true,
+	"ReplaceAll":           true,
+	"ReplaceRegexp":        true,
+	"ReplaceRegexpLiteral": true,
+	"TrimSpace":            true,
+	"TrimSpaceSlow":        true,
+	"Reverse":              true,
+	"ReverseGraphemes":     true,
+	"ToUpper":              true,
+	"ToLower":              true,
+	"FoldCase":             true,
+	"Normalize":            true,
+	"TruncateGraphemes":    true,
+	"TruncateWidth":        true,
+	"PadLeftWidth":         true,
+	"PadRightWidth":        true,
+	"CenterWidth":          true,
+	"ApplyPatch":           true,
+	"Mask":                 true,
+	"Redact":               true,
+	"Reserve":              true,
+	"ShrinkToFit":          true,
+	"Freeze":               true,
+}
+
+// mutators are methods which may change the payload of a String in place
+var mutators = map[string]bool{
+	"Init":                 true,
+	"SetCapacity":          true,
+	"FromString":           true,
+	"FromBytes":            true,
+	"FromRunes":            true,
+	"From":                 true,
+	"TryFrom":              true,
+	"Scan":                 true,
+	"UnmarshalJSON":        true,
+	"Write":                true,
+	"WriteString":          true,
+	"WriteByte":            true,
+	"WriteRune":            true,
+	"ReadFrom":             true,
+	"Reset":                true,
+	"Recycle":              true,
+	"Insert":               true,
+	"InsertString":         true,
+	"Push":                 true,
+	"PushRune":             true,
+	"PushString":           true,
+	"PushBytes":            true,
+	"PushRunes":            true,
+	"Drain":                true,
+	"Replace":              true,
+	"ReplaceAll":           true,
+	"ReplaceRegexp":        true,
+	"ReplaceRegexpLiteral": true,
+	"TrimPrefix":           true,
+	"TrimSuffix":           true,
+	"TrimSpace":            true,
+	"TrimSpaceSlow":        true,
+	"Reverse":              true,
+	"ReverseGraphemes":     true,
+	"ToUpper":              true,
+	"ToLower":              true,
+	"FoldCase":             true,
+	"Normalize":            true,
+	"TruncateGraphemes":    true,
+	"TruncateWidth":        true,
+	"PadLeftWidth":         true,
+	"PadRightWidth":        true,
+	"CenterWidth":          true,
+	"ApplyPatch":           true,
+	"Mask":                 true,
+	"Redact":               true,
+	"Reserve":              true,
+	"ShrinkToFit":          true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodes := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ValueSpec)(nil),
+		(*ast.CompositeLit)(nil),
+		(*ast.ReturnStmt)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.SendStmt)(nil),
+		(*ast.RangeStmt)(nil),
+		(*ast.FuncDecl)(nil),
+		(*ast.FuncLit)(nil),
+		(*ast.BlockStmt)(nil),
+		(*ast.CaseClause)(nil),
+		(*ast.CommClause)(nil),
+	}
+
+	insp.Preorder(nodes, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for i, x := range n.Rhs {
+				// '_ = s' only evaluates s
+				if len(n.Lhs) == len(n.Rhs) {
+					if id, ok := n.Lhs[i].(*ast.Ident); ok && id.Name == "_" {
+						continue
+					}
+				}
+				checkCopy(pass, x, "assignment")
+			}
+		case *ast.ValueSpec:
+			for _, x := range n.Values {
+				checkCopy(pass, x, "variable declaration")
+			}
+		case *ast.CompositeLit:
+			for _, x := range n.Elts {
+				if kv, ok := x.(*ast.KeyValueExpr); ok {
+					x = kv.Value
+				}
+				checkCopy(pass, x, "composite literal")
+			}
+		case *ast.ReturnStmt:
+			for _, x := range n.Results {
+				checkCopy(pass, x, "return")
+			}
+		case *ast.CallExpr:
+			for _, x := range n.Args {
+				checkCopy(pass, x, "call argument")
+			}
+		case *ast.SendStmt:
+			checkCopy(pass, n.Value, "send")
+		case *ast.RangeStmt:
+			if n.Value != nil && containsString(pass.TypesInfo.TypeOf(n.Value)) {
+				pass.Reportf(n.Value.Pos(), "range copies stringx.String by value")
+			}
+		case *ast.FuncDecl:
+			checkSignature(pass, n.Recv, n.Type)
+		case *ast.FuncLit:
+			checkSignature(pass, nil, n.Type)
+		case *ast.BlockStmt:
+			checkBlock(pass, n.List)
+		case *ast.CaseClause:
+			checkBlock(pass, n.Body)
+		case *ast.CommClause:
+			checkBlock(pass, n.Body)
+		}
+	})
+
+	return nil, nil
+}
+
+// isString reports whether t is stringx.String
+func isString(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Name() == "String" && obj.Pkg() != nil && obj.Pkg().Path() == stringxPath
+}
+
+// containsString reports whether a value of t holds a stringx.String, so
+// copying it copies the String
+func containsString(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if isString(t) {
+		return true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if containsString(u.Field(i).Type()) {
+				return true
+			}
+		}
+	case *types.Array:
+		return containsString(u.Elem())
+	}
+
+	return false
+}
+
+// checkCopy reports x if evaluating x copies an existing String
+func checkCopy(pass *analysis.Pass, x ast.Expr, what string) {
+	x = ast.Unparen(x)
+
+	switch x.(type) {
+	case *ast.CompositeLit, *ast.CallExpr, *ast.FuncLit:
+		// fresh values, function results are reported by checkSignature
+		return
+	}
+
+	if tv, ok := pass.TypesInfo.Types[x]; !ok || !tv.IsValue() || !containsString(tv.Type) {
+		return
+	}
+
+	pass.Reportf(x.Pos(), "%s copies stringx.String by value, use *stringx.String instead", what)
+}
+
+func checkSignature(pass *analysis.Pass, recv *ast.FieldList, typ *ast.FuncType) {
+	check := func(fields *ast.FieldList, what string) {
+		if fields == nil {
+			return
+		}
+		for _, field := range fields.List {
+			if containsString(pass.TypesInfo.TypeOf(field.Type)) {
+				pass.Reportf(field.Type.Pos(), "%s passes stringx.String by value, use *stringx.String instead", what)
+			}
+		}
+	}
+
+	check(recv, "receiver")
+	check(typ.Params, "parameter")
+	check(typ.Results, "result")
+}
+
+// method returns the receiver object and name of a call to a method of
+// stringx.String, the receiver must be a variable
+func method(pass *analysis.Pass, n ast.Node) (recv types.Object, name string, call *ast.CallExpr) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, "", nil
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", nil
+	}
+
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok {
+		return nil, "", nil
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil {
+		return nil, "", nil
+	}
+	rt := sig.Recv().Type()
+	if p, ok := rt.(*types.Pointer); ok {
+		rt = p.Elem()
+	}
+	if !isString(rt) {
+		return nil, "", nil
+	}
+
+	x := ast.Unparen(sel.X)
+	if u, ok := x.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		x = ast.Unparen(u.X)
+	}
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return nil, "", nil
+	}
+
+	return pass.TypesInfo.Uses[id], sel.Sel.Name, call
+}
+
+// checkBlock runs use-based checks on a list of statements, each check starts
+// at a statement, and follows the statements after it
+func checkBlock(pass *analysis.Pass, stmts []ast.Stmt) {
+	for i, stmt := range stmts {
+		rest := stmts[i+1:]
+
+		switch stmt := stmt.(type) {
+		case *ast.DeclStmt:
+			checkUninit(pass, stmt, rest)
+		case *ast.ExprStmt:
+			if obj, name, _ := method(pass, stmt.X); obj != nil && name == "Recycle" {
+				checkRecycled(pass, obj, rest)
+			}
+		case *ast.AssignStmt:
+			if len(stmt.Lhs) == 1 && len(stmt.Rhs) == 1 {
+				checkUnsafeString(pass, stmt.Lhs[0], stmt.Rhs[0], rest)
+			}
+		}
+	}
+}
+
+// walk visits nodes of stmts in source order until visit returns false
+func walk(stmts []ast.Stmt, visit func(n ast.Node) bool) {
+	stop := false
+	for _, stmt := range stmts {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if stop || n == nil {
+				return false
+			}
+			if !visit(n) {
+				stop = true
+				return false
+			}
+			return true
+		})
+		if stop {
+			return
+		}
+	}
+}
+
+// assigned reports whether n is an assignment to obj as a whole
+func assigned(pass *analysis.Pass, n ast.Node, obj types.Object) bool {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok {
+		return false
+	}
+	for _, lhs := range assign.Lhs {
+		if id, ok := ast.Unparen(lhs).(*ast.Ident); ok && pass.TypesInfo.ObjectOf(id) == obj {
+			return true
+		}
+	}
+	return false
+}
+
+// checkUninit reports calls of methods in needsInit on a String declared by
+// 'var s String' before it is initialized
+func checkUninit(pass *analysis.Pass, decl *ast.DeclStmt, rest []ast.Stmt) {
+	gen, ok := decl.Decl.(*ast.GenDecl)
+	if !ok || gen.Tok != token.VAR {
+		return
+	}
+
+	for _, spec := range gen.Specs {
+		spec := spec.(*ast.ValueSpec)
+		if len(spec.Values) > 0 {
+			continue
+		}
+
+		for _, name := range spec.Names {
+			obj := pass.TypesInfo.Defs[name]
+			if obj == nil || !isString(obj.Type()) {
+				continue
+			}
+
+			// receiver of a method which only reads String is not a use
+			reading := make(map[ast.Node]bool)
+
+			walk(rest, func(n ast.Node) bool {
+				if recv, m, call := method(pass, n); recv == obj {
+					if needsInit[m] {
+						pass.Reportf(call.Pos(), "%s used by %s before Init or From*", obj.Name(), m)
+						return false
+					}
+					if initializers[m] {
+						return false
+					}
+					ast.Inspect(call.Fun, func(n ast.Node) bool {
+						reading[n] = true
+						return true
+					})
+					return true
+				}
+				// any other use, e.g. &s passed to a function, may initialize it
+				id, ok := n.(*ast.Ident)
+				return !ok || reading[id] || pass.TypesInfo.Uses[id] != obj
+			})
+		}
+	}
+}
+
+// checkRecycled reports uses of obj after it is recycled
+func checkRecycled(pass *analysis.Pass, obj types.Object, rest []ast.Stmt) {
+	walk(rest, func(n ast.Node) bool {
+		if assigned(pass, n, obj) {
+			return false
+		}
+		if id, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[id] == obj {
+			pass.Reportf(id.Pos(), "%s used after Recycle", obj.Name())
+			return false
+		}
+		return true
+	})
+}
+
+// checkUnsafeString reports uses of a variable holding the result of
+// UnsafeString after the String is mutated
+func checkUnsafeString(pass *analysis.Pass, lhs, rhs ast.Expr, rest []ast.Stmt) {
+	recv, name, _ := method(pass, ast.Unparen(rhs))
+	if recv == nil || name != "UnsafeString" {
+		return
+	}
+	id, ok := ast.Unparen(lhs).(*ast.Ident)
+	if !ok {
+		return
+	}
+	u := pass.TypesInfo.ObjectOf(id)
+	if u == nil {
+		return
+	}
+
+	// a mutation takes effect after its arguments are evaluated, so it is
+	// pending until walk leaves the call
+	var (
+		pending   *ast.CallExpr
+		mutated   token.Pos
+		mutatedBy string
+	)
+
+	walk(rest, func(n ast.Node) bool {
+		if pending != nil && n.Pos() >= pending.End() {
+			mutated, pending = pending.Pos(), nil
+		}
+
+		if assigned(pass, n, u) {
+			return false
+		}
+
+		if r, m, call := method(pass, n); r == recv {
+			if m == "Freeze" {
+				return false
+			}
+			if mutators[m] && mutated == token.NoPos && pending == nil {
+				pending, mutatedBy = call, m
+			}
+			return true
+		}
+
+		if id, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[id] == u && mutated != token.NoPos {
+			pass.Reportf(id.Pos(), "%s holds UnsafeString of %s, which is mutated by %s at %v",
+				u.Name(), recv.Name(), mutatedBy, pass.Fset.Position(mutated))
+			return false
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,12 @@
+package stringxvet_test
+
+import (
+	"testing"
+
+	"github.com/Boyux/stringx/stringxvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), stringxvet.Analyzer, "a")
+}
@@ -0,0 +1,123 @@
+package a
+
+import "github.com/Boyux/stringx"
+
+type wrapper struct {
+	s stringx.String
+}
+
+func byValue(s stringx.String) {} // want `parameter passes stringx.String by value`
+
+func byPointer(s *stringx.String) {}
+
+func result() (s stringx.String) { return } // want `result passes stringx.String by value`
+
+func (w wrapper) get() {} // want `receiver passes stringx.String by value`
+
+func (w *wrapper) ok() {}
+
+func copies() {
+	var s stringx.String
+	s.Init()
+
+	t := s // want `assignment copies stringx.String by value`
+	_ = t
+	var u = s // want `variable declaration copies stringx.String by value`
+	_ = u
+	w := wrapper{s: s} // want `composite literal copies stringx.String by value`
+	_ = w
+	ws := []wrapper{w}     // want `composite literal copies stringx.String by value`
+	for _, v := range ws { // want `range copies stringx.String by value`
+		_ = v
+	}
+	ch := make(chan stringx.String, 1)
+	ch <- s    // want `send copies stringx.String by value`
+	byValue(s) // want `call argument copies stringx.String by value`
+	p := &s
+	q := *p // want `assignment copies stringx.String by value`
+	_ = q
+
+	// fresh values and pointers are fine
+	fresh := stringx.String{}
+	_ = fresh
+	byPointer(&s)
+	c := s.Clone()
+	_ = c
+	for i := range ws {
+		_ = &ws[i]
+	}
+}
+
+func returns(w *wrapper) wrapper { // want `result passes stringx.String by value`
+	return *w // want `return copies stringx.String by value`
+}
+
+func uninit() {
+	var s stringx.String
+	s.PushString("a") // want `s used by PushString before Init or From\*`
+
+	var t stringx.String
+	t.FromString("a")
+	t.PushString("b")
+
+	var u stringx.String
+	byPointer(&u)
+	u.Push('!')
+
+	var v stringx.String
+	_ = v.Freeze() // want `v used by Freeze before Init or From\*`
+}
+
+func recycled() {
+	var s stringx.String
+	s.Init()
+	s.Recycle()
+	_ = s.Length() // want `s used after Recycle`
+
+	p := new(stringx.String)
+	p.Init()
+	p.Recycle()
+	p = new(stringx.String)
+	p.Init()
+	_ = p.Length()
+}
+
+func deferred() int {
+	var s stringx.String
+	s.Init()
+	defer s.Recycle()
+	return s.Length()
+}
+
+func unsafeString() {
+	var s stringx.String
+	s.FromString("abc")
+
+	u := s.UnsafeString()
+	_ = u
+	s.Push('!')
+	_ = u // want `u holds UnsafeString of s, which is mutated by Push at .*`
+
+	v := s.UnsafeString()
+	s.PushString(v)
+	s.Reset()
+	v = "fine"
+	_ = v
+
+	w := s.UnsafeString()
+	_ = s.Freeze()
+	s.Push('?')
+	_ = w
+
+	x := s.UnsafeString()
+	_ = s.EqualToString(x)
+	_ = x
+}
+
+func readThenPush() {
+	var s stringx.String
+	if s.Length() == 0 {
+		s.Reset()
+	}
+	s.Push('a') // want `s used by Push before Init or From\*`
+}
@@ -0,0 +1,26 @@
+// Package stringx is a stub of github.com/Boyux/stringx for tests.
+package stringx
+
+type nocopy struct{}
+
+func (*nocopy) Lock()   {}
+func (*nocopy) Unlock() {}
+
+type String struct {
+	nocopy nocopy
+	mem    []byte
+}
+
+func (s *String) Init()                         {}
+func (s *String) FromString(in string) *String  { return s }
+func (s *String) Recycle()                      {}
+func (s *String) Reset()                        {}
+func (s *String) Push(b byte)                   {}
+func (s *String) PushString(str string)         {}
+func (s *String) Length() int                   { return len(s.mem) }
+func (s *String) String() string                { return string(s.mem) }
+func (s *String) UnsafeString() string          { return string(s.mem) }
+func (s *String) Freeze() string                { return string(s.mem) }
+func (s *String) Clone() *String                { return s }
+func (s *String) CloneInto(target *String)      {}
+func (s *String) EqualToString(str string) bool { return false }
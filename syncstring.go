@@ -0,0 +1,229 @@
+package stringx
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// syncChunkSize is the size of chunks used by SyncString.WriteLockFree
+const syncChunkSize = 64 << 10
+
+// SyncString is a String shared by goroutines, all methods are safe for
+// concurrent use. The zero value is an empty SyncString ready to use, and
+// a SyncString mustn't be copied after first use.
+//
+// Writes by Push* and Write take a mutex, WriteLockFree appends to a shared
+// chunk without locking instead, which scales better when many goroutines
+// write at the same time. Both kinds of writes can be mixed, every write is
+// appended as a whole, never interleaved with other writes.
+type SyncString struct {
+	nocopy nocopy
+
+	mu sync.RWMutex
+	s  String
+
+	// chunk buffers lock-free writes, which are flushed into s when chunk
+	// is full or a method takes mu
+	chunk atomic.Pointer[syncChunk]
+}
+
+// syncChunk is appended by reserving a range with one atomic add, so writers
+// never wait for each other. A chunk is sealed once a reservation overflows
+// it, then it is flushed as soon as all writes before the overflowing one
+// are committed.
+type syncChunk struct {
+	mem       []byte
+	reserved  atomic.Int64
+	committed atomic.Int64
+	// sealed is the length of chunk payload, or -1 if chunk is not sealed
+	sealed atomic.Int64
+}
+
+func newSyncChunk() *syncChunk {
+	c := &syncChunk{mem: getBuffer(syncChunkSize)}
+	c.sealed.Store(-1)
+	return c
+}
+
+// reserve returns where to write n bytes in chunk, ok is false if chunk
+// overflows and is sealed
+func (c *syncChunk) reserve(n int) (start int, ok bool) {
+	end := c.reserved.Add(int64(n))
+	start = int(end) - n
+
+	if end <= int64(len(c.mem)) {
+		return start, true
+	}
+
+	// exactly one reservation overflows from inside chunk, which seals it
+	if start <= len(c.mem) {
+		c.sealed.Store(int64(start))
+	}
+	return start, false
+}
+
+// wait returns payload of sealed chunk after all writes to it are committed
+func (c *syncChunk) wait() []byte {
+	for {
+		sealed := c.sealed.Load()
+		if sealed >= 0 && c.committed.Load() == sealed {
+			return c.mem[:sealed]
+		}
+		runtime.Gosched()
+	}
+}
+
+// flush seals current chunk and pushes its payload into String, mu must be
+// locked
+func (ss *SyncString) flush() {
+	c := ss.chunk.Load()
+	if c == nil {
+		return
+	}
+
+	// a reservation larger than chunk always overflows, which seals chunk
+	// unless others sealed it already
+	c.reserve(len(c.mem) + 1)
+	ss.string().PushBytes(c.wait())
+
+	ss.chunk.Store(nil)
+	// late writers holding c always overflow, so nobody writes to c.mem
+	putBuffer(c.mem)
+}
+
+// string returns String with all writes flushed, mu must be locked
+func (ss *SyncString) string() *String {
+	if !ss.s.alreadyInit() {
+		ss.s.Init()
+	}
+	return &ss.s
+}
+
+// lock locks for writing and flushes lock-free writes
+func (ss *SyncString) lock() *String {
+	ss.mu.Lock()
+	ss.flush()
+	return ss.string()
+}
+
+// rlock locks for reading, it locks for writing instead if there are
+// lock-free writes to flush, call the returned unlock when done
+func (ss *SyncString) rlock() (s *String, unlock func()) {
+	ss.mu.RLock()
+	// chunk is only installed with mu locked, so it stays nil while mu is
+	// read-locked
+	if ss.chunk.Load() == nil {
+		return &ss.s, ss.mu.RUnlock
+	}
+	ss.mu.RUnlock()
+
+	return ss.lock(), ss.mu.Unlock
+}
+
+func (ss *SyncString) Push(b byte) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.Push(b)
+}
+
+func (ss *SyncString) PushRune(r rune) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.PushRune(r)
+}
+
+func (ss *SyncString) PushString(str string) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.PushString(str)
+}
+
+func (ss *SyncString) PushBytes(bytes []byte) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.PushBytes(bytes)
+}
+
+func (ss *SyncString) PushRunes(runes []rune) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.PushRunes(runes)
+}
+
+// Write is to implement interface io.Writer, it takes the mutex, see
+// WriteLockFree
+func (ss *SyncString) Write(p []byte) (n int, err error) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	return s.Write(p)
+}
+
+func (ss *SyncString) WriteString(str string) (n int, err error) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.PushString(str)
+	return len(str), nil
+}
+
+// WriteLockFree is like Write, but appends p to a shared chunk without
+// locking, it only takes the mutex when chunk is full. Writes larger than
+// a chunk take the mutex as well.
+func (ss *SyncString) WriteLockFree(p []byte) (n int, err error) {
+	if len(p) > syncChunkSize {
+		return ss.Write(p)
+	}
+
+	for {
+		c := ss.chunk.Load()
+		if c != nil {
+			if start, ok := c.reserve(len(p)); ok {
+				copy(c.mem[start:], p)
+				c.committed.Add(int64(len(p)))
+				return len(p), nil
+			}
+		}
+
+		ss.mu.Lock()
+		// others may have replaced the full chunk already
+		if ss.chunk.Load() == c {
+			ss.flush()
+			ss.chunk.Store(newSyncChunk())
+		}
+		ss.mu.Unlock()
+	}
+}
+
+// Update calls f with the wrapped String locked, f mustn't keep String or
+// anything borrowed from it after return
+func (ss *SyncString) Update(f func(s *String)) {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	f(s)
+}
+
+// Snapshot returns a copy of current payload, which is immutable and is not
+// affected by later writes
+func (ss *SyncString) Snapshot() string {
+	s, unlock := ss.rlock()
+	defer unlock()
+	return string(s.payload())
+}
+
+// String is to implement interface fmt.Stringer, which returns Snapshot
+func (ss *SyncString) String() string {
+	return ss.Snapshot()
+}
+
+func (ss *SyncString) Len() int {
+	s, unlock := ss.rlock()
+	defer unlock()
+	return s.len
+}
+
+// Reset empties SyncString, which keeps its capacity
+func (ss *SyncString) Reset() {
+	s := ss.lock()
+	defer ss.mu.Unlock()
+	s.Reset()
+}
@@ -0,0 +1,125 @@
+package stringx
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSyncString_Push(t *testing.T) {
+	var ss SyncString
+	if ss.Len() != 0 || ss.Snapshot() != "" {
+		t.Fatalf("SyncString: zero value is not empty: snapshot=%s", ss.Snapshot())
+	}
+
+	ss.Push('a')
+	ss.PushRune('é')
+	ss.PushString("bc")
+	ss.PushBytes([]byte("de"))
+	ss.PushRunes([]rune("你好"))
+	_, _ = ss.Write([]byte("!"))
+	_, _ = ss.WriteString("?")
+	_, _ = ss.WriteLockFree([]byte("~"))
+	ss.Update(func(s *String) { s.ToUpper() })
+
+	snapshot := ss.Snapshot()
+	if snapshot != "AÉBCDE你好!?~" || ss.String() != snapshot || ss.Len() != len(snapshot) {
+		t.Errorf("SyncString: push failed: snapshot=%s", snapshot)
+	}
+
+	// snapshot is not affected by later writes
+	ss.Reset()
+	ss.PushString("reset")
+	if snapshot != "AÉBCDE你好!?~" || ss.Snapshot() != "reset" {
+		t.Errorf("SyncString: snapshot changes: snapshot=%s after=%s", snapshot, ss.Snapshot())
+	}
+}
+
+func TestSyncString_Concurrent(t *testing.T) {
+	const workers, records = 8, 2000
+
+	var ss SyncString
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < records; i++ {
+				record := "worker-" + strconv.Itoa(w) + "-record-" + strconv.Itoa(i) + "\n"
+				switch i % 4 {
+				case 0:
+					_, _ = ss.Write([]byte(record))
+				case 1:
+					ss.PushString(record)
+				default:
+					_, _ = ss.WriteLockFree([]byte(record))
+				}
+				if i%100 == 0 {
+					_ = ss.Len()
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(ss.Snapshot(), "\n"), "\n")
+	if len(lines) != workers*records {
+		t.Fatalf("SyncString: concurrent write lost records: records=%d expect=%d", len(lines), workers*records)
+	}
+
+	// records of each worker are whole and in order
+	next := make([]int, workers)
+	for _, line := range lines {
+		var w, i int
+		fields := strings.Split(line, "-")
+		if len(fields) == 4 {
+			w, _ = strconv.Atoi(fields[1])
+			i, _ = strconv.Atoi(fields[3])
+		}
+		if len(fields) != 4 || fields[0] != "worker" || w >= workers || i != next[w] {
+			t.Fatalf("SyncString: concurrent write corrupts record: line=%q", line)
+		}
+		next[w]++
+	}
+}
+
+func TestSyncString_LargeWrite(t *testing.T) {
+	var ss SyncString
+	large := strings.Repeat("x", syncChunkSize+1)
+
+	_, _ = ss.WriteLockFree([]byte("head,"))
+	_, _ = ss.WriteLockFree([]byte(large))
+	for i := 0; i < syncChunkSize/4; i++ {
+		_, _ = ss.WriteLockFree([]byte(",abc"))
+	}
+
+	expect := "head," + large + strings.Repeat(",abc", syncChunkSize/4)
+	if ss.Snapshot() != expect {
+		t.Errorf("SyncString: large write failed: length=%d expect=%d", ss.Len(), len(expect))
+	}
+}
+
+func benchmarkSyncString(b *testing.B, write func(ss *SyncString, p []byte)) {
+	var ss SyncString
+	record := []byte("2006-01-02T15:04:05Z level=info msg=benchmark\n")
+
+	b.SetBytes(int64(len(record)))
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 1; pb.Next(); i++ {
+			write(&ss, record)
+			// measure writes rather than growing a huge String
+			if i%(1<<14) == 0 {
+				ss.Reset()
+			}
+		}
+	})
+}
+
+func BenchmarkSyncString_Write(b *testing.B) {
+	benchmarkSyncString(b, func(ss *SyncString, p []byte) { _, _ = ss.Write(p) })
+}
+
+func BenchmarkSyncString_WriteLockFree(b *testing.B) {
+	benchmarkSyncString(b, func(ss *SyncString, p []byte) { _, _ = ss.WriteLockFree(p) })
+}
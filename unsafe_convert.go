@@ -2,10 +2,7 @@
 
 package stringx
 
-import (
-	"reflect"
-	"unsafe"
-)
+import "unsafe"
 
 // stringToBytesSlow benchmark: 2.716 ns/op
 func stringToBytesSlow(s string) (b []byte) {
@@ -18,36 +15,36 @@ func stringToBytesSlow(s string) (b []byte) {
 //
 // SAFETY: byte slice converted by this function is immutable, don't mutate
 // those bytes, keep readonly in mind
-func stringToBytes(s string) (b []byte) {
-	st := (*reflect.StringHeader)(unsafe.Pointer(&s))
-	sl := (*reflect.SliceHeader)(unsafe.Pointer(&b))
-	sl.Data = st.Data
-	sl.Len = st.Len
-	sl.Cap = st.Len
-	return b
+func stringToBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
+
+// bytesToString converts b to string without copying
+//
+// SAFETY: b mustn't be modified while the returned string is alive
+func bytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
 }
 
 // toStringUnsafe benchmark: 0.6994 ns/op
-func (s *String) toStringUnsafe() (dst string) {
-	src := s.payload()
-	st := (*reflect.StringHeader)(unsafe.Pointer(&dst))
-	sl := (*reflect.SliceHeader)(unsafe.Pointer(&src))
-	st.Data = sl.Data
-	st.Len = s.len
-	return dst
+func (s *String) toStringUnsafe() string {
+	return bytesToString(s.payload())
 }
 
 // UnsafeString is a faster way to convert String to primitive string by unsafe.Pointer,
 // it takes no extra cost but may cause memory issue if caller use UnsafeString incorrectly
 func (s *String) UnsafeString() string {
+	s.pin()
+	s.lend("UnsafeString")
 	return s.toStringUnsafe()
 }
 
 // toString benchmark: 3.191 ns/op
 func (s *String) toString() string {
-	if s.len == 0 {
+	payload := s.payload()
+	if len(payload) == 0 {
 		return ""
 	}
 
-	return string(s.payload())
+	return string(payload)
 }
@@ -0,0 +1,18 @@
+//go:build stringx_debug && unsafe_convert
+
+package stringx
+
+import "testing"
+
+func TestDebug_UnsafeString(t *testing.T) {
+	var s String
+	s.FromString("borrowed")
+
+	// a stale string still reads the payload it is lent
+	str := s.UnsafeString()
+	s.TrimPrefix("borrow")
+	s.PushString("!!")
+	if !s.EqualToString("ed!!") || str != "borrowed" {
+		t.Errorf("stringx_debug: UnsafeString read after mutation changes: String=%s string=%q", s.String(), str)
+	}
+}
@@ -5,6 +5,7 @@ package stringx
 import (
 	"bytes"
 	"testing"
+	"unsafe"
 )
 
 var unsafeDataStr = "abcdefghijklmnopqrst1234567890你好世界👋"
@@ -54,3 +55,13 @@ func BenchmarkSafeToString(b *testing.B) {
 		_ = unsafeDataS.toString()
 	}
 }
+
+func TestInterned_ToStringNoCopy(t *testing.T) {
+	i := NewInterner(1).InternString("héllo")
+	if s := i.ToString(); unsafe.SliceData(s.payload()) != unsafe.StringData(i.String()) {
+		t.Errorf("Interned: ToString copies payload")
+	}
+	if v := i.View(); unsafe.SliceData(v.bytes()) != unsafe.StringData(i.String()) {
+		t.Errorf("Interned: View copies payload")
+	}
+}
@@ -0,0 +1,306 @@
+package stringx
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"strconv"
+	"unicode"
+)
+
+// View is a read-only range borrowed from the payload of a String, slicing a
+// View or splitting it into Views never copies nor allocates. View is a small
+// value, it is fine to copy it around.
+//
+// A View shares memory with the String it is borrowed from, so it is only
+// valid until that String is mutated, just like UnsafeString. Call ToString
+// or String to keep the content of a View longer. Build with 'stringx_debug'
+// tag to catch Views used after the String is mutated.
+type View struct {
+	b   borrow
+	mem []byte
+}
+
+// bytes returns mem after checking the borrow, all methods of View read mem
+// by bytes
+func (v View) bytes() []byte {
+	v.b.check()
+	return v.mem
+}
+
+// View returns a View of the whole payload of String
+func (s *String) View() View {
+	return s.ViewRange(0, s.len)
+}
+
+// ViewRange returns a View of payload[l:r], it is the zero-copy counterpart
+// of Index
+func (s *String) ViewRange(l, r int) View {
+	payload := s.payload()
+	// limit capacity, so appending to Bytes never writes into String
+	return View{b: s.borrow("View"), mem: payload[l:r:r]}
+}
+
+// ViewOf returns a View of str, which shares memory with str if built with
+// 'unsafe_convert' tag
+func ViewOf(str string) View {
+	return View{mem: stringToBytes(str)}
+}
+
+// Bytes returns the borrowed bytes of View, which are read-only
+func (v View) Bytes() []byte {
+	return v.bytes()
+}
+
+func (v View) Length() int {
+	return len(v.bytes())
+}
+
+// Len is to implement interface { Len() int }
+func (v View) Len() int {
+	return len(v.bytes())
+}
+
+func (v View) IsEmpty() bool {
+	return len(v.bytes()) == 0
+}
+
+func (v View) Get(i int) byte {
+	return v.bytes()[i]
+}
+
+// Slice returns a View of View[l:r]
+func (v View) Slice(l, r int) View {
+	return View{b: v.b, mem: v.bytes()[l:r:r]}
+}
+
+func (v View) Runes() *Runes {
+	return &Runes{
+		b:   v.b,
+		mem: v.bytes(),
+		idx: 0,
+	}
+}
+
+// String copies View into a primitive string
+func (v View) String() string {
+	return string(v.bytes())
+}
+
+func (v View) GoString() string {
+	return "\"" + string(v.bytes()) + "\""
+}
+
+// ToString copies View into a new String
+func (v View) ToString() *String {
+	var s String
+	return s.FromBytes(v.bytes())
+}
+
+func (v View) EqualTo(other View) bool {
+	return bytes.Equal(v.bytes(), other.bytes())
+}
+
+func (v View) EqualToString(str string) bool {
+	return len(v.bytes()) == len(str) && bytes.Equal(v.bytes(), stringToBytes(str))
+}
+
+// ConstantTimeEqual is like EqualTo, but takes constant time, see
+// String.ConstantTimeEqual
+func (v View) ConstantTimeEqual(other View) bool {
+	return subtle.ConstantTimeCompare(v.bytes(), other.bytes()) == 1
+}
+
+func (v View) ConstantTimeEqualToString(str string) bool {
+	return subtle.ConstantTimeCompare(v.bytes(), stringToBytes(str)) == 1
+}
+
+func (v View) CompareTo(other View) int {
+	return bytes.Compare(v.bytes(), other.bytes())
+}
+
+func (v View) CompareToString(str string) int {
+	return bytes.Compare(v.bytes(), stringToBytes(str))
+}
+
+func (v View) Contains(sub string) bool {
+	return bytes.Contains(v.bytes(), stringToBytes(sub))
+}
+
+func (v View) Find(pat string) int {
+	return bytes.Index(v.bytes(), stringToBytes(pat))
+}
+
+func (v View) StartsWith(pat string) bool {
+	return bytes.HasPrefix(v.bytes(), stringToBytes(pat))
+}
+
+func (v View) HasPrefix(pat string) bool {
+	return v.StartsWith(pat)
+}
+
+func (v View) EndsWith(pat string) bool {
+	return bytes.HasSuffix(v.bytes(), stringToBytes(pat))
+}
+
+func (v View) HasSuffix(pat string) bool {
+	return v.EndsWith(pat)
+}
+
+func (v View) TrimPrefix(pat string) View {
+	if v.StartsWith(pat) {
+		return v.Slice(len(pat), len(v.bytes()))
+	}
+	return v
+}
+
+func (v View) TrimSuffix(pat string) View {
+	if v.EndsWith(pat) {
+		return v.Slice(0, len(v.bytes())-len(pat))
+	}
+	return v
+}
+
+func (v View) TrimSpace() View {
+	mem := bytes.TrimFunc(v.bytes(), unicode.IsSpace)
+	return View{b: v.b, mem: mem[:len(mem):len(mem)]}
+}
+
+func (v View) Split(sep string) *ViewSplit {
+	return &ViewSplit{
+		b:   v.b,
+		mem: v.bytes(),
+		idx: 0,
+		sep: stringToBytes(sep),
+	}
+}
+
+func (v View) SplitSlice(sep string) []View {
+	return v.Split(sep).Consume()
+}
+
+func (v View) Lines() *ViewLines {
+	return &ViewLines{
+		b:   v.b,
+		mem: v.bytes(),
+		idx: 0,
+	}
+}
+
+func (v View) ParseInt() (int64, error) {
+	return strconv.ParseInt(bytesToString(v.bytes()), 10, 64)
+}
+
+var _ Iterator[View] = (*ViewLines)(nil)
+
+// ViewLines is like Lines, but yields Views borrowed from String
+type ViewLines struct {
+	b   borrow
+	mem []byte
+	idx int
+	val View
+}
+
+// Views switches Lines to yield Views from where it is now
+func (l *Lines) Views() *ViewLines {
+	return &ViewLines{
+		b:   l.b,
+		mem: l.mem,
+		idx: l.idx,
+	}
+}
+
+func (l *ViewLines) Next() (hasNext bool) {
+	l.b.check()
+	hasNext = l.idx < len(l.mem)
+	l.val = l.value()
+	return hasNext
+}
+
+func (l *ViewLines) value() View {
+	dropCR := func(data []byte) []byte {
+		if len(data) > 0 && data[len(data)-1] == '\r' {
+			return data[0 : len(data)-1]
+		}
+		return data
+	}
+
+	loc := bytes.IndexByte(l.mem[l.idx:], '\n')
+
+	if loc < 0 {
+		line := dropCR(l.mem[l.idx:])
+		l.idx = len(l.mem)
+		return View{b: l.b, mem: line[:len(line):len(line)]}
+	}
+
+	line := dropCR(l.mem[l.idx : l.idx+loc])
+	l.idx += loc + 1
+
+	return View{b: l.b, mem: line[:len(line):len(line)]}
+}
+
+func (l *ViewLines) Value() View {
+	return l.val
+}
+
+var _ Iterator[View] = (*ViewSplit)(nil)
+
+// ViewSplit is like Split, but yields Views borrowed from String
+type ViewSplit struct {
+	b   borrow
+	mem []byte
+	idx int
+	sep []byte
+	val View
+}
+
+// Views switches Split to yield Views from where it is now
+func (s *Split) Views() *ViewSplit {
+	return &ViewSplit{
+		b:   s.b,
+		mem: s.mem,
+		idx: s.idx,
+		sep: s.sep,
+	}
+}
+
+func (s *ViewSplit) Next() (hasNext bool) {
+	s.b.check()
+	hasNext = s.idx < len(s.mem)
+	s.val = s.value()
+	return hasNext
+}
+
+func (s *ViewSplit) value() View {
+	loc := bytes.Index(s.mem[s.idx:], s.sep)
+
+	if loc < 0 {
+		piece := s.mem[s.idx:]
+		s.idx = len(s.mem)
+		return View{b: s.b, mem: piece[:len(piece):len(piece)]}
+	}
+
+	piece := s.mem[s.idx : s.idx+loc]
+	s.idx += loc + len(s.sep)
+
+	return View{b: s.b, mem: piece[:len(piece):len(piece)]}
+}
+
+func (s *ViewSplit) Value() View {
+	return s.val
+}
+
+func (s *ViewSplit) Size() (i int) {
+	for i = 0; s.Next(); i++ {
+	}
+	return i
+}
+
+func (s *ViewSplit) Consume() []View {
+	slice := make([]View, 0)
+
+	for s.Next() {
+		slice = append(slice, s.Value())
+	}
+
+	return slice
+}
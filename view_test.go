@@ -0,0 +1,151 @@
+package stringx
+
+import "testing"
+
+func TestString_View(t *testing.T) {
+	var s String
+	s.FromString("  key=你好世界  ")
+
+	v := s.View().TrimSpace()
+	if !v.EqualToString("key=你好世界") || v.Length() != 16 {
+		t.Fatalf("View: trim space failed: view=%q", v.String())
+	}
+	if !v.StartsWith("key=") || !v.EndsWith("世界") || v.StartsWith("你") {
+		t.Errorf("View: starts with / ends with failed: view=%q", v.String())
+	}
+	if i := v.Find("你好"); i != 4 {
+		t.Errorf("View: find failed: index=%d expect=4", i)
+	}
+
+	value := v.TrimPrefix("key=")
+	if !value.EqualTo(ViewOf("你好世界")) || value.CompareToString("你好") <= 0 {
+		t.Errorf("View: trim prefix failed: view=%q", value.String())
+	}
+	if runes := value.Runes().Consume(); len(runes) != 4 || runes[3] != '界' {
+		t.Errorf("View: runes failed: runes=%q", string(runes))
+	}
+
+	// appending to Bytes of a View never writes into String
+	sub := s.ViewRange(2, 5)
+	_ = append(sub.Bytes(), "XYZ"...)
+	if !s.EqualToString("  key=你好世界  ") {
+		t.Errorf("View: append to bytes changes String: after=%q", s.String())
+	}
+
+	clone := sub.ToString()
+	s.Reset()
+	s.PushString("changed")
+	if !clone.EqualToString("key") {
+		t.Errorf("View: to string shares memory: clone=%q", clone.String())
+	}
+}
+
+func TestView_ParseInt(t *testing.T) {
+	var s String
+	s.FromString("id=42,count=-7,bad=x")
+
+	var ints []int64
+	for it := s.View().Split(","); it.Next(); {
+		kv := it.Value()
+		i, err := kv.Slice(kv.Find("=")+1, kv.Len()).ParseInt()
+		if err != nil {
+			continue
+		}
+		ints = append(ints, i)
+	}
+
+	if len(ints) != 2 || ints[0] != 42 || ints[1] != -7 {
+		t.Errorf("View: parse int failed: ints=%v", ints)
+	}
+}
+
+func TestView_Iterators(t *testing.T) {
+	var s String
+	s.FromString("a\r\nbb\n\nccc")
+
+	lines := s.Lines()
+	lines.Next()
+	if !lines.Value().EqualToString("a") {
+		t.Fatalf("Lines: first line failed: line=%q", lines.Value().String())
+	}
+
+	// switch to Views in the middle of iteration
+	var views []string
+	for it := lines.Views(); it.Next(); {
+		views = append(views, it.Value().String())
+	}
+	if len(views) != 3 || views[0] != "bb" || views[1] != "" || views[2] != "ccc" {
+		t.Errorf("Lines: views failed: views=%q", views)
+	}
+
+	s.FromString("a,b,,c,")
+	split := s.Split(",")
+	expect := split.Consume()
+
+	pieces := s.Split(",").Views().Consume()
+	if len(pieces) != len(expect) {
+		t.Fatalf("Split: views failed: pieces=%d expect=%d", len(pieces), len(expect))
+	}
+	for i := range pieces {
+		if !pieces[i].EqualToString(expect[i].String()) {
+			t.Errorf("Split: views failed: piece=%q expect=%q", pieces[i].String(), expect[i].String())
+		}
+	}
+
+	if size := s.View().Split(",").Size(); size != len(expect) {
+		t.Errorf("View: split size failed: size=%d expect=%d", size, len(expect))
+	}
+}
+
+func TestView_NoAlloc(t *testing.T) {
+	var s String
+	s.Init()
+	for i := 0; i < 100; i++ {
+		s.PushString("line ")
+		s.PushString(Int(i).String())
+		s.Push('\n')
+	}
+
+	var it ViewLines
+	allocs := testing.AllocsPerRun(100, func() {
+		it = ViewLines{mem: s.payload()}
+		for it.Next() {
+			if it.Value().StartsWith("line 1") {
+				_ = it.Value().Find(" ")
+			}
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("View: iterating lines allocates: allocs=%f", allocs)
+	}
+}
+
+func BenchmarkLines(b *testing.B) {
+	var s String
+	s.Init()
+	for i := 0; i < 1000; i++ {
+		s.PushString("2024-01-01 12:00:00 INFO request served\n")
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for it := s.Lines(); it.Next(); {
+			_ = it.Value().StartsWith("2024")
+		}
+	}
+}
+
+func BenchmarkViewLines(b *testing.B) {
+	var s String
+	s.Init()
+	for i := 0; i < 1000; i++ {
+		s.PushString("2024-01-01 12:00:00 INFO request served\n")
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for it := s.View().Lines(); it.Next(); {
+			_ = it.Value().StartsWith("2024")
+		}
+	}
+}
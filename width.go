@@ -0,0 +1,177 @@
+package stringx
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run -C internal/gen . width ../../width_table.go
+
+func isWide(r rune) bool {
+	if r < wideTable[0][0] {
+		return false
+	}
+
+	lo, hi := 0, len(wideTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch g := &wideTable[m]; {
+		case r < g[0]:
+			hi = m
+		case r > g[1]:
+			lo = m + 1
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// runeWidth returns the number of columns taken by r, following UAX #11.
+// East_Asian_Width Ambiguous runes are treated as narrow.
+func runeWidth(r rune) int {
+	switch {
+	case ' ' <= r && r < 0x7F:
+		return 1
+	case r < ' ' || 0x7F <= r && r < 0xA0:
+		return 0
+	// Hangul medial vowels and final consonants are combined with the
+	// leading consonant
+	case 0x1160 <= r && r <= 0x11FF || 0xD7B0 <= r && r <= 0xD7FF:
+		return 0
+	case r == 0x00AD:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// clusterWidth returns the number of columns taken by an extended grapheme
+// cluster. A cluster takes the width of its first rune, except that emoji
+// with variation selector-16 and pairs of regional indicators (flags) are
+// always wide, combining marks and zero width joiners never add width.
+func clusterWidth(cluster []byte) int {
+	r, n := utf8.DecodeRune(cluster)
+	w := runeWidth(r)
+	if w == 2 || n == len(cluster) {
+		return w
+	}
+
+	switch graphemePropertyOf(r) {
+	case gbRegionalIndicator:
+		return 2
+	case gbExtendedPictographic:
+		for i := n; i < len(cluster); {
+			c, size := utf8.DecodeRune(cluster[i:])
+			if c == 0xFE0F {
+				return 2
+			}
+			i += size
+		}
+	}
+
+	return w
+}
+
+func displayWidth(b []byte) (w int) {
+	for i := 0; i < len(b); {
+		n := graphemeLen(b[i:])
+		w += clusterWidth(b[i : i+n])
+		i += n
+	}
+	return w
+}
+
+// DisplayWidth returns the number of columns taken by String in a monospace
+// terminal, following UAX #11 for East Asian characters and counting width
+// by extended grapheme clusters, so that emoji sequences, flags and combining
+// marks are measured as they are displayed
+func (s *String) DisplayWidth() int {
+	return displayWidth(s.payload())
+}
+
+// TruncateWidth truncates String on grapheme cluster boundary to make it fit
+// in w columns, ellipsis is appended if String is truncated, and counted in w.
+// If ellipsis itself is wider than w, String is truncated without it.
+func (s *String) TruncateWidth(w int, ellipsis string) {
+	s.copycheck()
+	s.mutable()
+
+	if s.DisplayWidth() <= w {
+		return
+	}
+
+	limit := w - displayWidth(stringToBytes(ellipsis))
+	if limit < 0 {
+		limit, ellipsis = maxInt(w, 0), ""
+	}
+
+	var size, width int
+	payload := s.payload()
+	for size < len(payload) {
+		n := graphemeLen(payload[size:])
+		cw := clusterWidth(payload[size : size+n])
+		if width+cw > limit {
+			break
+		}
+		width += cw
+		size += n
+	}
+
+	s.len = size
+	s.PushString(ellipsis)
+}
+
+// padding returns the padding to fill n columns with pad, columns which
+// can't be filled by a wide pad are filled with spaces
+func padding(n int, pad rune) string {
+	pw := runeWidth(pad)
+	if pw <= 0 {
+		pad, pw = ' ', 1
+	}
+
+	var p String
+	p.Init()
+	for ; n >= pw; n -= pw {
+		p.PushRune(pad)
+	}
+	for ; n > 0; n-- {
+		p.Push(' ')
+	}
+	return p.toString()
+}
+
+// PadLeftWidth pads String on the left with pad, until String takes w
+// columns, which aligns String to the right
+func (s *String) PadLeftWidth(w int, pad rune) {
+	s.copycheck()
+
+	if n := w - s.DisplayWidth(); n > 0 {
+		s.InsertString(0, padding(n, pad))
+	}
+}
+
+// PadRightWidth pads String on the right with pad, until String takes w
+// columns, which aligns String to the left
+func (s *String) PadRightWidth(w int, pad rune) {
+	s.copycheck()
+
+	if n := w - s.DisplayWidth(); n > 0 {
+		s.PushString(padding(n, pad))
+	}
+}
+
+// CenterWidth pads String on both sides with pad, until String takes w
+// columns, the right side gets one more column if padding is odd
+func (s *String) CenterWidth(w int, pad rune) {
+	s.copycheck()
+
+	if n := w - s.DisplayWidth(); n > 0 {
+		s.InsertString(0, padding(n/2, pad))
+		s.PushString(padding(n-n/2, pad))
+	}
+}
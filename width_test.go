@@ -0,0 +1,91 @@
+package stringx
+
+import "testing"
+
+var widthData = []struct {
+	s     string
+	width int
+}{
+	{"", 0},
+	{"abc", 3},
+	{"你好世界", 8},
+	{"ｈｅｌｌｏ", 10},
+	{"ｶﾀｶﾅ", 4},
+	{"é", 1},
+	{"한국어", 6},
+	{"💰🐱", 4},
+	{"👨‍👩‍👧‍👦", 2},
+	{"👋🏽", 2},
+	{"🇯🇵", 2},
+	{"☺", 1},
+	{"☺️", 2},
+	{"a​b", 2},
+	{"\t\n", 0},
+}
+
+func TestString_DisplayWidth(t *testing.T) {
+	var s String
+	for _, data := range widthData {
+		s.FromString(data.s)
+		if w := s.DisplayWidth(); w != data.width {
+			t.Errorf("String: display width failed: string=%+q width=%d expect=%d",
+				data.s, w, data.width)
+		}
+	}
+}
+
+func TestString_TruncateWidth(t *testing.T) {
+	var s String
+	for _, data := range []struct {
+		s        string
+		width    int
+		ellipsis string
+		expect   string
+	}{
+		{"你好世界", 5, "…", "你好…"},
+		{"你好世界", 8, "…", "你好世界"},
+		{"abc👨‍👩‍👧‍👦def", 6, "…", "abc👨‍👩‍👧‍👦…"},
+		{"abc👨‍👩‍👧‍👦def", 5, "…", "abc…"},
+		{"abcdef", 3, "...", "..."},
+		{"abcdef", 2, "...", "ab"},
+		{"你好世界", 2, "...", "你"},
+		{"你好世界", 1, "…", "…"},
+		{"abcdef", 0, "…", ""},
+		{"abcdef", -1, "…", ""},
+	} {
+		s.FromString(data.s)
+		s.TruncateWidth(data.width, data.ellipsis)
+		if !s.EqualToString(data.expect) {
+			t.Errorf("String: truncate width failed: before=%s width=%d after=%s expect=%s",
+				data.s, data.width, s.String(), data.expect)
+		}
+	}
+}
+
+func TestString_PadWidth(t *testing.T) {
+	var s String
+
+	s.FromString("你好")
+	s.PadLeftWidth(7, ' ')
+	if !s.EqualToString("   你好") {
+		t.Errorf("String: pad left width failed: after=%q", s.String())
+	}
+
+	s.FromString("你好")
+	s.PadRightWidth(7, '-')
+	if !s.EqualToString("你好---") {
+		t.Errorf("String: pad right width failed: after=%q", s.String())
+	}
+
+	s.FromString("你好")
+	s.CenterWidth(9, '＊')
+	if !s.EqualToString("＊你好＊ ") || s.DisplayWidth() != 9 {
+		t.Errorf("String: center width failed: after=%q", s.String())
+	}
+
+	s.FromString("你好世界")
+	s.CenterWidth(4, ' ')
+	if !s.EqualToString("你好世界") {
+		t.Errorf("String: center width changes wider String: after=%q", s.String())
+	}
+}